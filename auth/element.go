@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/rs/zerolog/log"
+)
+
+// rule decides whether a principal may make a call.
+type rule func(p Principal, req any) bool
+
+func adminOnly(p Principal, req any) bool {
+	return p.Role == Admin
+}
+
+// managerOf allows admins and the managers of the hotel a call changes.
+func managerOf(p Principal, req any) bool {
+	switch r := req.(type) {
+	case interface{ GetHotelId() string }:
+		return p.CanManage(r.GetHotelId())
+	case *pb.HotelRequest:
+		return p.CanManage(r.GetHotel().GetId())
+	}
+	return p.Role == Admin
+}
+
+// policy lists the methods that need a role, every other method is open.
+var policy = map[string]rule{
+	"Geo.SetLocation":             managerOf,
+	"Profile.CreateHotel":         adminOnly,
+	"Profile.UpdateHotel":         managerOf,
+	"Rate.SetRatePlans":           managerOf,
+	"Recommendation.SetHotel":     managerOf,
+	"Recommendation.ReloadHotels": adminOnly,
+	"Reservation.SetCapacity":     managerOf,
+	"User.ReloadUsers":            adminOnly,
+}
+
+// Element enforces the roles of the policy before a handler runs.
+type Element struct {
+	key []byte
+	// audit only logs denied calls instead of rejecting them
+	audit bool
+}
+
+// NewElement returns an element verifying callers with key. Setting
+// AUTHZ_MODE to "audit" logs denied calls without rejecting them.
+func NewElement(key []byte) *Element {
+	e := &Element{key: key}
+	if mode, ok := os.LookupEnv("AUTHZ_MODE"); ok {
+		switch strings.ToLower(mode) {
+		case "enforce":
+		case "audit":
+			e.audit = true
+		default:
+			log.Warn().Msgf("Invalid AUTHZ_MODE %q, enforcing", mode)
+		}
+	}
+	if len(key) == 0 {
+		log.Warn().Msg("No AuthKey configured, calls that need a role will be denied; generate one with: head -c 32 /dev/urandom | base64")
+	}
+	return e
+}
+
+// Elements returns the server elements of a service.
+func Elements(key []byte) []element.RPCElement {
+	return []element.RPCElement{NewElement(key)}
+}
+
+// ProcessRequest rejects calls the caller is not allowed to make.
+func (e *Element) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	method := req.ServiceName + "." + req.Method
+	allowed, ok := policy[method]
+	if !ok {
+		return req, ctx, nil
+	}
+
+	p, err := FromMetadata(metadata.FromIncomingContext(ctx), e.key)
+	if err == nil && allowed(p, req.Payload) {
+		return req, ctx, nil
+	}
+
+	reason := "role not allowed"
+	if err != nil {
+		reason = err.Error()
+	}
+	log.Ctx(ctx).Warn().
+		Str("audit", "denied").
+		Str("method", method).
+		Str("username", p.Username).
+		Str("role", string(p.Role)).
+		Bool("enforced", !e.audit).
+		Msgf("Denied call to %v: %v", method, reason)
+
+	if e.audit {
+		return req, ctx, nil
+	}
+	return nil, ctx, fmt.Errorf("permission denied: %v", method)
+}
+
+// ProcessResponse passes responses through.
+func (e *Element) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	return resp, ctx, nil
+}
+
+// Name returns the name of the element.
+func (e *Element) Name() string {
+	return "authz"
+}
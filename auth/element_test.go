@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+// incoming turns the metadata a caller attaches into what a server receives.
+func incoming(p Principal, key []byte) context.Context {
+	out := metadata.FromOutgoingContext(NewOutgoingContext(context.Background(), p, key))
+	return metadata.NewIncomingContext(context.Background(), out)
+}
+
+func TestElement(t *testing.T) {
+	manager := Principal{Username: "m", Role: HotelManager, Hotels: []string{"1", "2"}}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		method  string
+		payload any
+		allowed bool
+	}{
+		{"open method", context.Background(), "GetRecommendations", &pb.GetRecommendationsRequest{}, true},
+		{"anonymous", context.Background(), "SetHotel", &pb.SetHotelRequest{HotelId: "1"}, false},
+		{"guest", incoming(Principal{Username: "g", Role: Guest}, testKey), "SetHotel", &pb.SetHotelRequest{HotelId: "1"}, false},
+		{"manager of hotel", incoming(manager, testKey), "SetHotel", &pb.SetHotelRequest{HotelId: "2"}, true},
+		{"manager of other hotel", incoming(manager, testKey), "SetHotel", &pb.SetHotelRequest{HotelId: "3"}, false},
+		{"manager reload", incoming(manager, testKey), "ReloadHotels", &pb.ReloadHotelsRequest{}, false},
+		{"admin", incoming(Principal{Username: "a", Role: Admin}, testKey), "ReloadHotels", &pb.ReloadHotelsRequest{}, true},
+		{"other key", incoming(Principal{Username: "a", Role: Admin}, []byte("another key of at least 32 bytes")), "ReloadHotels", &pb.ReloadHotelsRequest{}, false},
+	}
+
+	e := &Element{key: testKey}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &element.RPCRequest{ServiceName: "Recommendation", Method: tt.method, Payload: tt.payload}
+			_, _, err := e.ProcessRequest(tt.ctx, req)
+			if allowed := err == nil; allowed != tt.allowed {
+				t.Errorf("allowed = %v, want %v (err: %v)", allowed, tt.allowed, err)
+			}
+		})
+	}
+}
+
+func TestElementForgedRole(t *testing.T) {
+	md := metadata.FromOutgoingContext(NewOutgoingContext(context.Background(), Principal{Username: "g", Role: Guest}, testKey))
+	md.Set(RoleKey, string(Admin))
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	e := &Element{key: testKey}
+	req := &element.RPCRequest{ServiceName: "User", Method: "ReloadUsers", Payload: &pb.ReloadUsersRequest{}}
+	if _, _, err := e.ProcessRequest(ctx, req); err == nil {
+		t.Fatal("forged role was allowed")
+	}
+}
+
+func TestElementAudit(t *testing.T) {
+	e := &Element{key: testKey, audit: true}
+	req := &element.RPCRequest{ServiceName: "User", Method: "ReloadUsers", Payload: &pb.ReloadUsersRequest{}}
+	if _, _, err := e.ProcessRequest(context.Background(), req); err != nil {
+		t.Fatalf("audit mode rejected the call: %v", err)
+	}
+}
+
+// geoServer accepts every location.
+type geoServer struct{}
+
+func (geoServer) NearbyGeo(ctx context.Context, req *pb.NearbyRequest) (*pb.NearbyResult, context.Context, error) {
+	return &pb.NearbyResult{}, ctx, nil
+}
+
+func (geoServer) SetLocation(ctx context.Context, req *pb.SetLocationRequest) (*pb.SetLocationResult, context.Context, error) {
+	return &pb.SetLocationResult{}, ctx, nil
+}
+
+func TestElementOverARPC(t *testing.T) {
+	var c *transport.Config
+	server, err := c.NewServer("geo", "127.0.0.1:0", Elements(testKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pb.RegisterGeoServer(server, geoServer{})
+	// the aRPC server cannot be stopped, it serves until the test binary exits
+	go server.Start()
+	client, err := c.NewClient("profile", "geo", server.GetTransport().LocalAddr().String(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	geo := pb.NewGeoClient(client)
+
+	manager := Principal{Username: "m", Role: HotelManager, Hotels: []string{"1"}}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		hotelId string
+		allowed bool
+	}{
+		{"anonymous", context.Background(), "1", false},
+		{"manager of hotel", NewOutgoingContext(context.Background(), manager, testKey), "1", true},
+		{"manager of other hotel", NewOutgoingContext(context.Background(), manager, testKey), "2", false},
+		{"forwarded admin", Forward(incoming(Principal{Username: "a", Role: Admin}, testKey)), "2", true},
+	}
+	for _, tt := range tests {
+		done := make(chan error, 1)
+		go func() {
+			_, err := geo.SetLocation(tt.ctx, &pb.SetLocationRequest{HotelId: tt.hotelId})
+			done <- err
+		}()
+		select {
+		case err := <-done:
+			if allowed := err == nil; allowed != tt.allowed {
+				t.Errorf("%v: allowed = %v, want %v (err: %v)", tt.name, allowed, tt.allowed, err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%v: call timed out", tt.name)
+		}
+	}
+}
+
+func TestElementStalePrincipal(t *testing.T) {
+	defer func() { now = time.Now }()
+	admin := Principal{Username: "a", Role: Admin}
+	signed := time.Now()
+
+	e := &Element{key: testKey}
+	for _, tt := range []struct {
+		name    string
+		at      time.Time
+		allowed bool
+	}{
+		{"fresh", signed.Add(10 * time.Second), true},
+		{"replayed later", signed.Add(maxAge + time.Second), false},
+		{"from the future", signed.Add(-maxAge - time.Second), false},
+	} {
+		now = func() time.Time { return signed }
+		ctx := incoming(admin, testKey)
+		now = func() time.Time { return tt.at }
+		req := &element.RPCRequest{ServiceName: "User", Method: "ReloadUsers", Payload: &pb.ReloadUsersRequest{}}
+		if _, _, err := e.ProcessRequest(ctx, req); (err == nil) != tt.allowed {
+			t.Errorf("%v: allowed = %v, want %v (err: %v)", tt.name, err == nil, tt.allowed, err)
+		}
+	}
+
+	// moving the issue time breaks the signature
+	now = time.Now
+	md := metadata.FromOutgoingContext(NewOutgoingContext(context.Background(), admin, testKey))
+	md.Set(IssuedKey, strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+	if _, err := FromMetadata(md, testKey); err == nil {
+		t.Error("accepted a principal with a changed issue time")
+	}
+}
@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+)
+
+// Role is what a user is allowed to do.
+type Role string
+
+const (
+	// Guest searches and books hotels. Users without a role are guests.
+	Guest Role = "guest"
+	// HotelManager additionally manages the data of the hotels they are assigned.
+	HotelManager Role = "hotel-manager"
+	// Admin manages every hotel and the services themselves.
+	Admin Role = "admin"
+)
+
+// Metadata keys carrying the caller downstream.
+const (
+	UsernameKey  = "username"
+	RoleKey      = "role"
+	HotelsKey    = "hotels"
+	IssuedKey    = "auth-issued"
+	SignatureKey = "auth-signature"
+	// ClientKey carries the address of the end user, the user service locks
+	// out password guesses per user and address.
+	ClientKey = "client-ip"
+)
+
+// maxAge is how long a signed principal is accepted after it was attached,
+// so a captured one cannot be replayed later, e.g. after the role was
+// revoked. It covers the calls of one request and small clock skews.
+var maxAge = time.Minute
+
+// now returns the current time, tests replace it.
+var now = time.Now
+
+// Principal is an authenticated caller.
+type Principal struct {
+	Username string
+	Role     Role
+	// Hotels a hotel manager manages.
+	Hotels []string
+}
+
+// ParseRole returns the role stored for a user, defaulting to guest.
+func ParseRole(role string) (Role, error) {
+	switch Role(role) {
+	case "", Guest:
+		return Guest, nil
+	case HotelManager, Admin:
+		return Role(role), nil
+	}
+	return "", fmt.Errorf("unknown role %q", role)
+}
+
+// CanManage returns whether the principal may change the data of a hotel.
+func (p Principal) CanManage(hotelId string) bool {
+	switch p.Role {
+	case Admin:
+		return true
+	case HotelManager:
+		for _, h := range p.Hotels {
+			if h == hotelId {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NewOutgoingContext attaches the principal, signed with key together with
+// the current time, to the metadata of outgoing calls.
+func NewOutgoingContext(ctx context.Context, p Principal, key []byte) context.Context {
+	hotels := strings.Join(p.Hotels, ",")
+	issued := strconv.FormatInt(now().Unix(), 10)
+	return metadata.AppendToOutgoingContext(ctx,
+		UsernameKey, p.Username,
+		RoleKey, string(p.Role),
+		HotelsKey, hotels,
+		IssuedKey, issued,
+		SignatureKey, sign(key, p.Username, string(p.Role), hotels, issued),
+	)
+}
+
+// FromMetadata returns the principal of signed metadata, unless it was
+// signed more than maxAge ago.
+func FromMetadata(md metadata.Metadata, key []byte) (Principal, error) {
+	username, role, hotels, issued := md.Get(UsernameKey), md.Get(RoleKey), md.Get(HotelsKey), md.Get(IssuedKey)
+	if username == "" {
+		return Principal{}, fmt.Errorf("no caller in metadata")
+	}
+	if len(key) == 0 {
+		return Principal{}, fmt.Errorf("no key to verify the caller with")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(md.Get(SignatureKey))
+	if err != nil {
+		return Principal{}, fmt.Errorf("malformed caller signature")
+	}
+	want, _ := base64.RawURLEncoding.DecodeString(sign(key, username, role, hotels, issued))
+	if !hmac.Equal(sig, want) {
+		return Principal{}, fmt.Errorf("invalid caller signature")
+	}
+	at, err := strconv.ParseInt(issued, 10, 64)
+	if err != nil {
+		return Principal{}, fmt.Errorf("malformed caller issue time")
+	}
+	if age := now().Sub(time.Unix(at, 0)); age > maxAge || age < -maxAge {
+		return Principal{}, fmt.Errorf("caller signed %v ago, accepted for %v", age.Round(time.Second), maxAge)
+	}
+
+	r, err := ParseRole(role)
+	if err != nil {
+		return Principal{}, err
+	}
+	p := Principal{Username: username, Role: r}
+	if hotels != "" {
+		p.Hotels = strings.Split(hotels, ",")
+	}
+	return p, nil
+}
+
+// Forward copies the caller of an incoming call to the calls it makes.
+func Forward(ctx context.Context) context.Context {
+	in := metadata.FromIncomingContext(ctx)
+	if in.Get(UsernameKey) == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx,
+		UsernameKey, in.Get(UsernameKey),
+		RoleKey, in.Get(RoleKey),
+		HotelsKey, in.Get(HotelsKey),
+		IssuedKey, in.Get(IssuedKey),
+		SignatureKey, in.Get(SignatureKey),
+	)
+}
+
+// ParseKey decodes the base64 key principals are signed with.
+func ParseKey(key string) ([]byte, error) {
+	if key == "" {
+		return nil, nil
+	}
+	k, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth key: %v", err)
+	}
+	if len(k) < 32 {
+		return nil, fmt.Errorf("auth key must be at least 32 bytes")
+	}
+	return k, nil
+}
+
+func sign(key []byte, fields ...string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strings.Join(fields, "\n")))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// breaker is the circuit breaker of a cache server. After failures
+// consecutive failures it opens and calls skip the server for cooldown. Then
+// a single call probes the server, closing the breaker if it succeeds and
+// opening it again if not.
+type breaker struct {
+	name     string
+	failures int
+	cooldown time.Duration
+	now      func() time.Time
+
+	mu       sync.Mutex
+	failed   int
+	openedAt time.Time
+	open     bool
+	probing  bool
+}
+
+func newBreaker(name string, failures int, cooldown time.Duration) *breaker {
+	return &breaker{name: name, failures: failures, cooldown: cooldown, now: time.Now}
+}
+
+// allow reports whether a call may go to the server, and whether it is the
+// probe of an open breaker. Every allowed call must be followed by done.
+func (b *breaker) allow() (allowed, probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.open {
+		return true, false
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.cooldown {
+		return false, false
+	}
+	b.probing = true
+	return true, true
+}
+
+// done records the outcome of an allowed call, failed if the server did not
+// answer.
+func (b *breaker) done(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !failed {
+		if b.open {
+			log.Info().Msgf("Cache: %v is back, using it again", b.name)
+		}
+		b.failed, b.open, b.probing = 0, false, false
+		return
+	}
+	b.failed++
+	if b.open || b.failed >= b.failures {
+		if !b.open {
+			log.Warn().Msgf("Cache: %v failed %d times in a row, skipping it for %v", b.name, b.failed, b.cooldown)
+		}
+		b.open, b.probing, b.openedAt = true, false, b.now()
+	}
+}
@@ -0,0 +1,101 @@
+// Package cache caches the data of the services in memcached, Redis or the
+// memory of the service.
+//
+// Keys are spread over the memcached or Redis servers by consistent hashing,
+// and every server has a circuit breaker, so a server that is down costs a
+// few failed calls and not a timeout per request. In bypass mode, the
+// default, calls to a server that is down act like misses, so the services
+// read from Mongo until it is back.
+//
+// Caches hold bytes; a Store encodes the values of a service with a Codec
+// and caches them for as long as its TTL says.
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrMiss is returned by Get for keys that are not cached.
+	ErrMiss = errors.New("cache: miss")
+	// ErrUnavailable is returned for keys whose server is down, unless the
+	// cache bypasses it.
+	ErrUnavailable = errors.New("cache: server unavailable")
+)
+
+// Cache is a cache of byte values.
+type Cache interface {
+	// Get returns the value of key, or ErrMiss.
+	Get(key string) ([]byte, error)
+	// GetMulti returns the values of the cached keys. Missing keys are left
+	// out.
+	GetMulti(keys []string) (map[string][]byte, error)
+	// Set caches value for key, for ttl or, if 0, as long as the cache
+	// keeps it.
+	Set(key string, value []byte, ttl time.Duration) error
+	// Delete drops key from the cache. Deleting a key that is not cached is
+	// not an error.
+	Delete(key string) error
+}
+
+// New returns the cache of spec:
+//   - host:port,... or memcached://host:port,... for memcached servers
+//   - redis://host:port,... for Redis servers
+//   - local://?size=64MB&policy=tinylfu for a cache in the memory of the
+//     service, evicting the least recently used values (policy lru) or
+//     admitting values by how often they are read (policy tinylfu, default)
+func New(spec string) (Cache, error) {
+	scheme, rest, ok := strings.Cut(spec, "://")
+	if !ok {
+		scheme, rest = "memcached", spec
+	}
+	switch scheme {
+	case "memcached":
+		return NewMemcached(rest)
+	case "redis":
+		return NewRedis(rest)
+	case "local":
+		u, err := url.Parse(spec)
+		if err != nil {
+			return nil, err
+		}
+		size, err := parseSize(u.Query().Get("size"), 64<<20)
+		if err != nil {
+			return nil, fmt.Errorf("local cache size: %w", err)
+		}
+		switch policy := u.Query().Get("policy"); policy {
+		case "", "tinylfu":
+			return NewLocal(size, true), nil
+		case "lru":
+			return NewLocal(size, false), nil
+		default:
+			return nil, fmt.Errorf("unknown local cache policy %q", policy)
+		}
+	default:
+		return nil, fmt.Errorf("unknown cache %q", scheme)
+	}
+}
+
+// parseSize parses a size like 512KB, 64MB or 1GB, def if empty.
+func parseSize(val string, def int64) (int64, error) {
+	if val == "" {
+		return def, nil
+	}
+	unit := int64(1)
+	for suffix, u := range map[string]int64{"KB": 1 << 10, "MB": 1 << 20, "GB": 1 << 30} {
+		if strings.HasSuffix(strings.ToUpper(val), suffix) {
+			val, unit = val[:len(val)-len(suffix)], u
+			break
+		}
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(val), 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%q is not a size", val)
+	}
+	return n * unit, nil
+}
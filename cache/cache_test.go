@@ -0,0 +1,211 @@
+package cache
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestCaches runs the same calls against every cache.
+func TestCaches(t *testing.T) {
+	caches := map[string]func(t *testing.T) Cache{
+		"memcached": func(t *testing.T) Cache {
+			return newTestMemcached(t, false, newFakeMemcached(t), newFakeMemcached(t))
+		},
+		"redis": func(t *testing.T) Cache {
+			c, err := New("redis://" + newFakeRedis(t).addr() + "," + newFakeRedis(t).addr())
+			if err != nil {
+				t.Fatal(err)
+			}
+			return c
+		},
+		"lru": func(t *testing.T) Cache {
+			c, err := New("local://?size=1MB&policy=lru")
+			if err != nil {
+				t.Fatal(err)
+			}
+			return c
+		},
+		"tinylfu": func(t *testing.T) Cache {
+			c, err := New("local://")
+			if err != nil {
+				t.Fatal(err)
+			}
+			return c
+		},
+	}
+	for name, newCache := range caches {
+		t.Run(name, func(t *testing.T) {
+			c := newCache(t)
+			var keys []string
+			for i := 0; i < 20; i++ {
+				key := strconv.Itoa(i)
+				keys = append(keys, key)
+				if err := c.Set(key, []byte("hotel "+key), time.Hour); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if v, err := c.Get("7"); err != nil || string(v) != "hotel 7" {
+				t.Errorf("Get(7) = %q, %v", v, err)
+			}
+			if _, err := c.Get("missing"); err != ErrMiss {
+				t.Errorf("Get(missing) error = %v, want ErrMiss", err)
+			}
+			values, err := c.GetMulti(append(keys, "missing"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(values) != len(keys) {
+				t.Errorf("GetMulti returned %d values, want %d", len(values), len(keys))
+			}
+			for _, key := range keys {
+				if string(values[key]) != "hotel "+key {
+					t.Errorf("GetMulti[%v] = %q", key, values[key])
+				}
+			}
+			for i := 0; i < 2; i++ {
+				if err := c.Delete("7"); err != nil {
+					t.Fatalf("Delete #%d: %v", i+1, err)
+				}
+			}
+			if _, err := c.Get("7"); err != ErrMiss {
+				t.Errorf("Get of deleted key error = %v, want ErrMiss", err)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, spec := range []string{"", "memcached://", "redis://no-port", "local://?size=big", "local://?policy=fifo", "mongodb://mongo"} {
+		if _, err := New(spec); err == nil {
+			t.Errorf("New(%q) succeeded", spec)
+		}
+	}
+	c, err := New("memcached-profile:11211")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cl, ok := c.(*Cluster); !ok || cl.kind != "memcached" {
+		t.Errorf("New of an address is %T, want memcached", c)
+	}
+	c, err = New("local://?size=512KB&policy=lru")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := c.(*Local); l.maxSize != 512<<10 || l.freq != nil {
+		t.Errorf("local cache of %d bytes, tinylfu %v", l.maxSize, l.freq != nil)
+	}
+}
+
+func TestStore(t *testing.T) {
+	type profile struct {
+		Id     string
+		Fields []string
+	}
+	for name, codec := range codecs {
+		t.Run(name, func(t *testing.T) {
+			now := time.Unix(0, 0)
+			l := NewLocal(1<<20, false)
+			l.now = func() time.Time { return now }
+			s := Store[profile]{Cache: l, Codec: codec, TTL: FixedTTL(time.Minute)}
+
+			want := profile{Id: "1", Fields: []string{"name", "address"}}
+			if err := s.Set("1", want); err != nil {
+				t.Fatal(err)
+			}
+			l.Set("bad", []byte("not encoded"), 0)
+			if got, err := s.Get("1"); err != nil || got.Id != want.Id || len(got.Fields) != 2 {
+				t.Errorf("Get(1) = %+v, %v", got, err)
+			}
+			if _, err := s.Get("bad"); err != ErrMiss {
+				t.Errorf("Get of a value not decoding error = %v, want ErrMiss", err)
+			}
+			values, err := s.GetMulti([]string{"1", "bad", "missing"})
+			if err != nil || len(values) != 1 || values["1"].Id != "1" {
+				t.Errorf("GetMulti = %+v, %v", values, err)
+			}
+
+			now = now.Add(time.Minute)
+			if _, err := s.Get("1"); err != ErrMiss {
+				t.Errorf("Get after the ttl error = %v, want ErrMiss", err)
+			}
+		})
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	t.Setenv("CACHE_CODEC", "GOB")
+	t.Setenv("CACHE_TTL", "10m")
+	s := NewStore[int](NewLocal(1<<10, false))
+	if s.Codec != Gob || s.TTL("1") != 10*time.Minute {
+		t.Errorf("store codec %T, ttl %v", s.Codec, s.TTL("1"))
+	}
+
+	t.Setenv("CACHE_CODEC", "xml")
+	t.Setenv("CACHE_TTL", "soon")
+	s = NewStore[int](NewLocal(1<<10, false))
+	if s.Codec != JSON || s.TTL("1") != 0 {
+		t.Errorf("store of invalid env codec %T, ttl %v", s.Codec, s.TTL("1"))
+	}
+}
+
+func TestExpiration(t *testing.T) {
+	for ttl, want := range map[time.Duration]int32{0: 0, time.Millisecond: 1, 90 * time.Second: 90, 30 * 24 * time.Hour: 30 * 24 * 3600} {
+		if got := expiration(ttl); got != want {
+			t.Errorf("expiration(%v) = %d, want %d", ttl, got, want)
+		}
+	}
+	if got := expiration(31 * 24 * time.Hour); int64(got) < time.Now().Unix() {
+		t.Errorf("expiration of 31 days = %d, want a unix time", got)
+	}
+}
+
+// BenchmarkCaches reads profile-sized values in batches of 10, like
+// GetProfiles of a search, from every cache with every codec. The memcached
+// and Redis servers are the in-process fakes, so their results include a
+// loopback round trip but not the costs of real servers.
+func BenchmarkCaches(b *testing.B) {
+	type image struct {
+		Url     string
+		Default bool
+	}
+	type profile struct {
+		Id, Name, PhoneNumber, Description string
+		Images                             []image
+	}
+	value := profile{Id: "1", Name: "Clift Hotel", PhoneNumber: "(415) 775-4700",
+		Description: "A 6-minute walk from Union Square and 4 minutes from a Muni Metro station, this luxury hotel designed by Philippe Starck features an artsy furniture collection in the lobby, including work by Salvador Dali.",
+		Images:      []image{{Url: "some url", Default: false}}}
+	var keys []string
+	for i := 0; i < 10; i++ {
+		keys = append(keys, strconv.Itoa(i))
+	}
+
+	redis, err := NewRedis(newFakeRedis(b).addr())
+	if err != nil {
+		b.Fatal(err)
+	}
+	caches := map[string]Cache{
+		"memcached": newTestMemcached(b, false, newFakeMemcached(b)),
+		"redis":     redis,
+		"lru":       NewLocal(64<<20, false),
+		"tinylfu":   NewLocal(64<<20, true),
+	}
+	for name, c := range caches {
+		for codecName, codec := range codecs {
+			b.Run(name+"/"+codecName, func(b *testing.B) {
+				s := Store[profile]{Cache: c, Codec: codec}
+				for _, key := range keys {
+					s.Set(key, value)
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if values, err := s.GetMulti(keys); err != nil || len(values) != len(keys) {
+						b.Fatalf("GetMulti = %d values, %v", len(values), err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	defaultBreakerFailures = 5
+	defaultBreakerCooldown = 5 * time.Second
+	defaultBypass          = true
+)
+
+// node is a server of a cluster. Its methods return ErrMiss for keys it
+// does not hold.
+type node interface {
+	get(key string) ([]byte, error)
+	getMulti(keys []string) (map[string][]byte, error)
+	set(key string, value []byte, ttl time.Duration) error
+	delete(key string) error
+	flush() error
+	// failed reports whether err means the server did not answer, as
+	// opposed to answers like a miss.
+	failed(err error) bool
+}
+
+// Cluster is a cache over a set of servers, memcached or Redis.
+type Cluster struct {
+	kind   string
+	ring   *ring
+	nodes  map[string]*member
+	bypass bool
+}
+
+type member struct {
+	node
+	breaker *breaker
+}
+
+// newCluster returns a cluster of the kind servers in servers, a comma
+// separated list of addresses, connecting to them with dial. Its breakers
+// and bypass mode are read from CACHE_BREAKER_FAILURES,
+// CACHE_BREAKER_COOLDOWN and CACHE_BYPASS.
+func newCluster(kind, servers string, dial func(addr string) (node, error)) (*Cluster, error) {
+	var addrs []string
+	seen := make(map[string]bool)
+	for _, addr := range strings.Split(servers, ",") {
+		addr = strings.TrimSpace(addr)
+		if addr == "" || seen[addr] {
+			continue
+		}
+		seen[addr] = true
+		addrs = append(addrs, addr)
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no %v servers in %q", kind, servers)
+	}
+
+	failures, cooldown, bypass := breakerFailures(), breakerCooldown(), bypassMode()
+	c := &Cluster{kind: kind, ring: newRing(addrs), nodes: make(map[string]*member, len(addrs)), bypass: bypass}
+	for _, addr := range addrs {
+		n, err := dial(addr)
+		if err != nil {
+			return nil, fmt.Errorf("%v server %q: %w", kind, addr, err)
+		}
+		c.nodes[addr] = &member{node: n, breaker: newBreaker(kind+" "+addr, failures, cooldown)}
+	}
+	log.Info().Msgf("Cache: %v servers %v, breaker after %d failures for %v, bypass %v", kind, addrs, failures, cooldown, bypass)
+	return c, nil
+}
+
+func breakerFailures() int {
+	failures := defaultBreakerFailures
+	if val, ok := os.LookupEnv("CACHE_BREAKER_FAILURES"); ok {
+		if n, err := strconv.Atoi(val); err != nil || n <= 0 {
+			log.Warn().Msgf("Invalid CACHE_BREAKER_FAILURES %q, using %v", val, failures)
+		} else {
+			failures = n
+		}
+	}
+	return failures
+}
+
+func breakerCooldown() time.Duration {
+	cooldown := defaultBreakerCooldown
+	if val, ok := os.LookupEnv("CACHE_BREAKER_COOLDOWN"); ok {
+		if d, err := time.ParseDuration(val); err != nil || d <= 0 {
+			log.Warn().Msgf("Invalid CACHE_BREAKER_COOLDOWN %q, using %v", val, cooldown)
+		} else {
+			cooldown = d
+		}
+	}
+	return cooldown
+}
+
+func bypassMode() bool {
+	bypass := defaultBypass
+	if val, ok := os.LookupEnv("CACHE_BYPASS"); ok {
+		if b, err := strconv.ParseBool(val); err != nil {
+			log.Warn().Msgf("Invalid CACHE_BYPASS %q, using %v", val, bypass)
+		} else {
+			bypass = b
+		}
+	}
+	return bypass
+}
+
+// do runs fn on the server of addr through its breaker. It returns
+// ErrUnavailable if the server is down.
+func (c *Cluster) do(addr string, fn func(node) error) error {
+	m := c.nodes[addr]
+	allowed, probe := m.breaker.allow()
+	if !allowed {
+		return ErrUnavailable
+	}
+	if probe {
+		// the server missed the writes and deletes made while it was
+		// skipped, so it starts over empty
+		err := m.flush()
+		m.breaker.done(m.failed(err))
+		if m.failed(err) {
+			return fmt.Errorf("%w: %v", ErrUnavailable, err)
+		}
+	}
+	err := fn(m.node)
+	m.breaker.done(m.failed(err))
+	if m.failed(err) {
+		log.Debug().Msgf("Cache: %v %v failed: %v", c.kind, addr, err)
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	return err
+}
+
+// call is do, except that in bypass mode a server that is down is not an
+// error.
+func (c *Cluster) call(addr string, fn func(node) error) error {
+	err := c.do(addr, fn)
+	if c.bypass && errors.Is(err, ErrUnavailable) {
+		return nil
+	}
+	return err
+}
+
+// Get returns the value of key, or ErrMiss.
+func (c *Cluster) Get(key string) ([]byte, error) {
+	var value []byte
+	err := c.call(c.ring.get(key), func(n node) error {
+		var err error
+		value, err = n.get(key)
+		return err
+	})
+	if err == nil && value == nil {
+		return nil, ErrMiss
+	}
+	return value, err
+}
+
+// GetMulti returns the values of the cached keys, asking the servers in
+// parallel. Missing keys are left out.
+func (c *Cluster) GetMulti(keys []string) (map[string][]byte, error) {
+	byAddr := make(map[string][]string)
+	for _, key := range keys {
+		addr := c.ring.get(key)
+		byAddr[addr] = append(byAddr[addr], key)
+	}
+
+	values := make(map[string][]byte, len(keys))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var firstErr error
+	for addr, keys := range byAddr {
+		wg.Add(1)
+		go func(addr string, keys []string) {
+			defer wg.Done()
+			err := c.call(addr, func(n node) error {
+				found, err := n.getMulti(keys)
+				mu.Lock()
+				defer mu.Unlock()
+				for key, value := range found {
+					values[key] = value
+				}
+				return err
+			})
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(addr, keys)
+	}
+	wg.Wait()
+	return values, firstErr
+}
+
+// Set caches value for key.
+func (c *Cluster) Set(key string, value []byte, ttl time.Duration) error {
+	return c.call(c.ring.get(key), func(n node) error {
+		return n.set(key, value, ttl)
+	})
+}
+
+// Delete drops key from the cache. It returns ErrUnavailable if the server
+// is down even in bypass mode, as the server may still hold the value.
+func (c *Cluster) Delete(key string) error {
+	err := c.do(c.ring.get(key), func(n node) error {
+		return n.delete(key)
+	})
+	if err == ErrMiss {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,46 @@
+package cache
+
+import "time"
+
+// Local is a cache in the memory of the service, holding up to a number of
+// bytes of keys and values. When full it evicts the least recently used
+// values. With TinyLFU, it only does so for a value read more often than the
+// one it would evict, so values read once do not push out the popular ones.
+// Values are not copied, so they must not be changed once cached.
+type Local struct {
+	*lru[[]byte]
+}
+
+// NewLocal returns a cache of maxBytes, admitting values with TinyLFU if
+// tinyLFU is set.
+func NewLocal(maxBytes int64, tinyLFU bool) *Local {
+	sizeOf := func(key string, value []byte) int64 { return int64(len(key) + len(value)) }
+	// assume values of about 512 bytes to size the sketch
+	return &Local{newLRU(maxBytes, sizeOf, tinyLFU, int(maxBytes/512))}
+}
+
+// Get returns the value of key, or ErrMiss.
+func (l *Local) Get(key string) ([]byte, error) {
+	if value, ok := l.get(key); ok {
+		return value, nil
+	}
+	return nil, ErrMiss
+}
+
+// GetMulti returns the values of the cached keys.
+func (l *Local) GetMulti(keys []string) (map[string][]byte, error) {
+	return l.getMulti(keys), nil
+}
+
+// Set caches value for key. Values larger than the cache, or not admitted
+// by TinyLFU, are not cached.
+func (l *Local) Set(key string, value []byte, ttl time.Duration) error {
+	l.set(key, value, ttl)
+	return nil
+}
+
+// Delete drops key from the cache.
+func (l *Local) Delete(key string) error {
+	l.delete(key)
+	return nil
+}
@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestLocalLRU(t *testing.T) {
+	l := NewLocal(30, false) // three entries of a key and 9 bytes
+	for i := 0; i < 3; i++ {
+		l.Set(strconv.Itoa(i), []byte("123456789"), 0)
+	}
+	l.Get("0")
+	l.Set("3", []byte("123456789"), 0)
+	if _, err := l.Get("1"); err != ErrMiss {
+		t.Error("least recently used value was not evicted")
+	}
+	for _, key := range []string{"0", "2", "3"} {
+		if _, err := l.Get(key); err != nil {
+			t.Errorf("Get(%v): %v", key, err)
+		}
+	}
+	if l.size != 30 {
+		t.Errorf("cache holds %d bytes, want 30", l.size)
+	}
+
+	l.Set("big", make([]byte, 100), 0)
+	if _, err := l.Get("big"); err != ErrMiss {
+		t.Error("value larger than the cache was cached")
+	}
+
+	l.Set("0", []byte("12345"), 0)
+	if v, _ := l.Get("0"); string(v) != "12345" || l.size != 26 {
+		t.Errorf("replaced value is %q and cache holds %d bytes, want 26", v, l.size)
+	}
+	l.Delete("0")
+	if _, err := l.Get("0"); err != ErrMiss || l.size != 20 {
+		t.Errorf("deleted value is cached or cache holds %d bytes, want 20", l.size)
+	}
+}
+
+func TestLocalTTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := NewLocal(1<<10, false)
+	l.now = func() time.Time { return now }
+
+	l.Set("1", []byte("1"), time.Minute)
+	l.Set("2", []byte("2"), 0)
+	now = now.Add(time.Minute)
+	values, _ := l.GetMulti([]string{"1", "2"})
+	if _, ok := values["1"]; ok {
+		t.Error("expired value was returned")
+	}
+	if _, ok := values["2"]; !ok {
+		t.Error("value without ttl expired")
+	}
+	if _, ok := l.items["1"]; ok {
+		t.Error("expired value was kept")
+	}
+}
+
+func TestLocalTinyLFU(t *testing.T) {
+	fill := func(reads int) *Local {
+		l := NewLocal(100, true) // ten entries of a key and 9 bytes
+		for i := 0; i < 10; i++ {
+			key := strconv.Itoa(i)
+			l.Set(key, []byte("123456789"), 0)
+			for j := 0; j < reads; j++ {
+				l.Get(key)
+			}
+		}
+		return l
+	}
+
+	// a scan of keys read once does not evict the popular ones, whose
+	// counters are saturated so that collisions cannot beat them
+	l := fill(15)
+	for i := 10; i < 100; i++ {
+		key := strconv.Itoa(i)
+		if _, err := l.Get(key); err == ErrMiss {
+			l.Set(key, []byte("123456789"), 0)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := l.Get(strconv.Itoa(i)); err != nil {
+			t.Errorf("popular value %d was evicted", i)
+		}
+	}
+
+	// a key read more often than the least recently used one gets in
+	l = fill(1)
+	for j := 0; j < 10; j++ {
+		l.Get("new")
+	}
+	l.Set("new", []byte("123456789"), 0)
+	if _, err := l.Get("new"); err != nil {
+		t.Error("frequently read value was not admitted")
+	}
+	if _, err := l.Get("0"); err != ErrMiss {
+		t.Error("least recently used value was not evicted for it")
+	}
+}
+
+func TestSketch(t *testing.T) {
+	s := newSketch(100)
+	for i := 0; i < 20; i++ {
+		s.add("hot")
+	}
+	s.add("cold")
+	if got := s.estimate("hot"); got != 15 {
+		t.Errorf("estimate of hot = %d, want the counter maximum 15", got)
+	}
+	if got := s.estimate("cold"); got != 1 {
+		t.Errorf("estimate of cold = %d, want 1", got)
+	}
+
+	for i := 0; s.adds != 0 && i < s.resetAt; i++ {
+		s.add(strconv.Itoa(i))
+	}
+	if got := s.estimate("hot"); got > 8 {
+		t.Errorf("estimate of hot after the reset = %d, want it halved", got)
+	}
+}
@@ -0,0 +1,133 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// lru holds values up to a total size. When full it evicts the least
+// recently used values. With TinyLFU, it only does so for a value read more
+// often than the one it would evict, so values read once do not push out
+// the popular ones.
+type lru[V any] struct {
+	maxSize int64
+	sizeOf  func(key string, value V) int64
+	now     func() time.Time
+
+	mu        sync.Mutex
+	size      int64
+	items     map[string]*list.Element
+	order     *list.List // front is the most recently used
+	freq      *sketch    // nil without TinyLFU
+	evictions int64
+}
+
+type lruEntry[V any] struct {
+	key     string
+	value   V
+	size    int64
+	expires time.Time // zero if the value does not expire
+}
+
+// newLRU returns an lru of maxSize, measuring values with sizeOf. With
+// tinyLFU, its sketch is sized for keys.
+func newLRU[V any](maxSize int64, sizeOf func(string, V) int64, tinyLFU bool, keys int) *lru[V] {
+	l := &lru[V]{maxSize: maxSize, sizeOf: sizeOf, now: time.Now, items: make(map[string]*list.Element), order: list.New()}
+	if tinyLFU {
+		l.freq = newSketch(keys)
+	}
+	return l
+}
+
+// get returns the value of key, if cached and not expired.
+func (l *lru[V]) get(key string) (V, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lookup(key)
+}
+
+// getMulti returns the values of the cached keys.
+func (l *lru[V]) getMulti(keys []string) map[string]V {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	values := make(map[string]V, len(keys))
+	for _, key := range keys {
+		if value, ok := l.lookup(key); ok {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+// lookup returns the value of key. l.mu must be held.
+func (l *lru[V]) lookup(key string) (V, bool) {
+	if l.freq != nil {
+		l.freq.add(key)
+	}
+	var zero V
+	e, ok := l.items[key]
+	if !ok {
+		return zero, false
+	}
+	entry := e.Value.(*lruEntry[V])
+	if !entry.expires.IsZero() && !l.now().Before(entry.expires) {
+		l.remove(e)
+		return zero, false
+	}
+	l.order.MoveToFront(e)
+	return entry.value, true
+}
+
+// set caches value for key, for ttl or, if 0, until evicted. Values larger
+// than the lru, or not admitted by TinyLFU, are not cached.
+func (l *lru[V]) set(key string, value V, ttl time.Duration) {
+	entry := &lruEntry[V]{key: key, value: value, size: l.sizeOf(key, value)}
+	if entry.size > l.maxSize {
+		l.delete(key)
+		return
+	}
+	if ttl > 0 {
+		entry.expires = l.now().Add(ttl)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e, cached := l.items[key]
+	if cached {
+		l.remove(e)
+	}
+	for l.size+entry.size > l.maxSize {
+		victim := l.order.Back()
+		if l.freq != nil && !cached && l.freq.estimate(key) <= l.freq.estimate(victim.Value.(*lruEntry[V]).key) {
+			return
+		}
+		l.remove(victim)
+		l.evictions++
+	}
+	l.items[key] = l.order.PushFront(entry)
+	l.size += entry.size
+}
+
+// delete drops key.
+func (l *lru[V]) delete(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e, ok := l.items[key]; ok {
+		l.remove(e)
+	}
+}
+
+// stats returns the number of cached values and of evictions so far.
+func (l *lru[V]) stats() (entries int, evictions int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.items), l.evictions
+}
+
+// remove drops the entry of e. l.mu must be held.
+func (l *lru[V]) remove(e *list.Element) {
+	entry := l.order.Remove(e).(*lruEntry[V])
+	delete(l.items, entry.key)
+	l.size -= entry.size
+}
@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/appnetorg/hotel-reservation-arpc/tune"
+	"github.com/bradfitz/gomemcache/memcache"
+)
+
+// NewMemcached returns a cache over servers, a comma separated list of
+// memcached addresses.
+func NewMemcached(servers string) (*Cluster, error) {
+	return newCluster("memcached", servers, func(addr string) (node, error) {
+		client, err := tune.NewMemCClient(addr)
+		if err != nil {
+			return nil, err
+		}
+		return memcachedNode{client}, nil
+	})
+}
+
+type memcachedNode struct {
+	client *tune.MemCClient
+}
+
+func (n memcachedNode) get(key string) ([]byte, error) {
+	item, err := n.client.Client().Get(key)
+	if err == memcache.ErrCacheMiss {
+		return nil, ErrMiss
+	}
+	if err != nil {
+		return nil, err
+	}
+	return item.Value, nil
+}
+
+func (n memcachedNode) getMulti(keys []string) (map[string][]byte, error) {
+	items, err := n.client.Client().GetMulti(keys)
+	values := make(map[string][]byte, len(items))
+	for key, item := range items {
+		values[key] = item.Value
+	}
+	return values, err
+}
+
+func (n memcachedNode) set(key string, value []byte, ttl time.Duration) error {
+	return n.client.Client().Set(&memcache.Item{Key: key, Value: value, Expiration: expiration(ttl)})
+}
+
+func (n memcachedNode) delete(key string) error {
+	if err := n.client.Client().Delete(key); err != memcache.ErrCacheMiss {
+		return err
+	}
+	return ErrMiss
+}
+
+func (n memcachedNode) flush() error {
+	return n.client.Client().FlushAll()
+}
+
+func (n memcachedNode) failed(err error) bool {
+	switch err {
+	case nil, ErrMiss, memcache.ErrCASConflict, memcache.ErrNotStored, memcache.ErrMalformedKey:
+		return false
+	}
+	return true
+}
+
+// maxRelativeExpiration is the longest expiration memcached takes in
+// seconds, longer ones are unix times.
+const maxRelativeExpiration = 30 * 24 * time.Hour
+
+// expiration returns the memcached expiration of ttl, rounded up to seconds.
+func expiration(ttl time.Duration) int32 {
+	switch {
+	case ttl <= 0:
+		return 0
+	case ttl > maxRelativeExpiration:
+		return int32(time.Now().Add(ttl).Unix())
+	default:
+		return int32((ttl + time.Second - 1) / time.Second)
+	}
+}
@@ -0,0 +1,378 @@
+package cache
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/appnetorg/hotel-reservation-arpc/tune"
+)
+
+// fakeMemcached serves the get, gets, set, delete and flush_all commands of
+// the memcached text protocol from a map.
+type fakeMemcached struct {
+	ln net.Listener
+
+	mu    sync.Mutex
+	items map[string][]byte
+	conns map[net.Conn]bool
+}
+
+func newFakeMemcached(t testing.TB) *fakeMemcached {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeMemcached{ln: ln, items: make(map[string][]byte), conns: make(map[net.Conn]bool)}
+	go f.serve()
+	t.Cleanup(f.stop)
+	return f
+}
+
+func (f *fakeMemcached) addr() string { return f.ln.Addr().String() }
+
+// stop closes the listener and all connections, like a node going down.
+func (f *fakeMemcached) stop() {
+	f.ln.Close()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for c := range f.conns {
+		c.Close()
+	}
+}
+
+func (f *fakeMemcached) serve() {
+	for {
+		c, err := f.ln.Accept()
+		if err != nil {
+			return
+		}
+		f.mu.Lock()
+		f.conns[c] = true
+		f.mu.Unlock()
+		go f.handle(c)
+	}
+}
+
+func (f *fakeMemcached) handle(c net.Conn) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		args := strings.Fields(line)
+		if len(args) == 0 {
+			continue
+		}
+
+		var out strings.Builder
+		switch args[0] {
+		case "get", "gets":
+			f.mu.Lock()
+			for _, key := range args[1:] {
+				if v, ok := f.items[key]; ok {
+					fmt.Fprintf(&out, "VALUE %s 0 %d 1\r\n%s\r\n", key, len(v), v)
+				}
+			}
+			f.mu.Unlock()
+			out.WriteString("END\r\n")
+		case "set":
+			size, _ := strconv.Atoi(args[4])
+			data := make([]byte, size+2)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return
+			}
+			f.mu.Lock()
+			f.items[args[1]] = data[:size]
+			f.mu.Unlock()
+			out.WriteString("STORED\r\n")
+		case "flush_all":
+			f.mu.Lock()
+			f.items = make(map[string][]byte)
+			f.mu.Unlock()
+			out.WriteString("OK\r\n")
+		case "delete":
+			f.mu.Lock()
+			_, ok := f.items[args[1]]
+			delete(f.items, args[1])
+			f.mu.Unlock()
+			if ok {
+				out.WriteString("DELETED\r\n")
+			} else {
+				out.WriteString("NOT_FOUND\r\n")
+			}
+		default:
+			out.WriteString("ERROR\r\n")
+		}
+		if _, err := io.WriteString(c, out.String()); err != nil {
+			return
+		}
+	}
+}
+
+func newTestMemcached(t testing.TB, bypass bool, fakes ...*fakeMemcached) *Cluster {
+	t.Helper()
+	var addrs []string
+	for _, f := range fakes {
+		addrs = append(addrs, f.addr())
+	}
+	t.Setenv("MEMC_TIMEOUT", "200ms")
+	t.Setenv("CACHE_BREAKER_FAILURES", "2")
+	t.Setenv("CACHE_BREAKER_COOLDOWN", "1h")
+	t.Setenv("CACHE_BYPASS", strconv.FormatBool(bypass))
+	m, err := NewMemcached(strings.Join(addrs, ","))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestMemcached(t *testing.T) {
+	a, b := newFakeMemcached(t), newFakeMemcached(t)
+	m := newTestMemcached(t, true, a, b)
+
+	var keys []string
+	for i := 0; i < 50; i++ {
+		key := strconv.Itoa(i)
+		keys = append(keys, key)
+		if err := m.Set(key, []byte("hotel "+key), 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(a.items) == 0 || len(b.items) == 0 {
+		t.Errorf("keys not spread over the nodes: %d and %d", len(a.items), len(b.items))
+	}
+
+	if v, err := m.Get("7"); err != nil || string(v) != "hotel 7" {
+		t.Errorf("Get(7) = %q, %v", v, err)
+	}
+	if _, err := m.Get("missing"); err != ErrMiss {
+		t.Errorf("Get(missing) error = %v, want ErrMiss", err)
+	}
+
+	values, err := m.GetMulti(append(keys, "missing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != len(keys) {
+		t.Errorf("GetMulti returned %d values, want %d", len(values), len(keys))
+	}
+	for _, key := range keys {
+		if string(values[key]) != "hotel "+key {
+			t.Errorf("GetMulti[%v] = %q", key, values[key])
+		}
+	}
+
+	if err := m.Delete("7"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Delete("7"); err != nil {
+		t.Errorf("deleting a missing key: %v", err)
+	}
+	if _, err := m.Get("7"); err != ErrMiss {
+		t.Errorf("Get of deleted key error = %v, want ErrMiss", err)
+	}
+}
+
+// TestMemcachedTuning changes the memcached settings while calls are made,
+// for go test -race.
+func TestMemcachedTuning(t *testing.T) {
+	m := newTestMemcached(t, true, newFakeMemcached(t))
+	defer tune.SetMemCMaxIdleConns(tune.Current().MemCMaxIdleConns)
+	defer tune.SetMemCTimeout(tune.GetMemCTimeout())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				key := strconv.Itoa(i*1000 + j)
+				if err := m.Set(key, []byte("hotel "+key), 0); err != nil {
+					t.Error(err)
+					return
+				}
+				if v, err := m.Get(key); err != nil || string(v) != "hotel "+key {
+					t.Errorf("Get(%v) = %q, %v", key, v, err)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 50; i++ {
+		tune.SetMemCTimeout(time.Duration(200+i) * time.Millisecond)
+		tune.SetMemCMaxIdleConns(1 + i%4)
+	}
+	wg.Wait()
+}
+
+func TestMemcachedBypass(t *testing.T) {
+	a, b := newFakeMemcached(t), newFakeMemcached(t)
+	m := newTestMemcached(t, true, a, b)
+
+	var keys []string
+	for i := 0; i < 50; i++ {
+		key := strconv.Itoa(i)
+		keys = append(keys, key)
+		m.Set(key, []byte(key), 0)
+	}
+	onB := len(b.items)
+	b.stop()
+
+	for i := 0; i < 3; i++ {
+		values, err := m.GetMulti(keys)
+		if err != nil {
+			t.Fatalf("GetMulti with a node down: %v", err)
+		}
+		if len(values) != len(keys)-onB {
+			t.Errorf("GetMulti returned %d values, want the %d of the node up", len(values), len(keys)-onB)
+		}
+	}
+	for _, key := range keys {
+		if m.ring.get(key) != b.addr() {
+			continue
+		}
+		if _, err := m.Get(key); err != ErrMiss {
+			t.Errorf("Get of key on the node down error = %v, want ErrMiss", err)
+		}
+		if err := m.Set(key, []byte(key), 0); err != nil {
+			t.Errorf("Set of key on the node down: %v", err)
+		}
+		if err := m.Delete(key); !errors.Is(err, ErrUnavailable) {
+			t.Errorf("Delete of key on the node down error = %v, want ErrUnavailable", err)
+		}
+		break
+	}
+	if !m.nodes[b.addr()].breaker.open {
+		t.Error("breaker of the node down is closed")
+	}
+}
+
+func TestMemcachedRecovery(t *testing.T) {
+	a := newFakeMemcached(t)
+	m := newTestMemcached(t, true, a)
+	m.Set("1", []byte("1"), 0)
+
+	// a node skipped for a while holds values that may be stale
+	b := m.nodes[a.addr()].breaker
+	b.open, b.openedAt = true, time.Now().Add(-2*time.Hour)
+	if _, err := m.Get("1"); err != ErrMiss {
+		t.Errorf("Get of a recovered node error = %v, want ErrMiss", err)
+	}
+	if b.open {
+		t.Error("breaker open after a successful probe")
+	}
+	m.Set("1", []byte("1"), 0)
+	if v, err := m.Get("1"); err != nil || string(v) != "1" {
+		t.Errorf("Get(1) = %q, %v", v, err)
+	}
+}
+
+func TestMemcachedNoBypass(t *testing.T) {
+	a := newFakeMemcached(t)
+	m := newTestMemcached(t, false, a)
+	a.stop()
+
+	if _, err := m.Get("1"); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("Get error = %v, want ErrUnavailable", err)
+	}
+	if _, err := m.GetMulti([]string{"1", "2"}); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("GetMulti error = %v, want ErrUnavailable", err)
+	}
+	if err := m.Set("1", []byte("1"), 0); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("Set error = %v, want ErrUnavailable", err)
+	}
+}
+
+func TestNewMemcached(t *testing.T) {
+	for _, servers := range []string{"", " , ", "no-port"} {
+		if _, err := NewMemcached(servers); err == nil {
+			t.Errorf("NewMemcached(%q) succeeded", servers)
+		}
+	}
+	m, err := NewMemcached("memcached-1:11211, memcached-2:11211,memcached-1:11211")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.nodes) != 2 {
+		t.Errorf("got %d nodes, want 2", len(m.nodes))
+	}
+}
+
+func TestBreaker(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := newBreaker("memcached:11211", 3, time.Second)
+	b.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		b.allow()
+		b.done(true)
+	}
+	b.allow()
+	b.done(false)
+	for i := 0; i < 3; i++ {
+		if allowed, _ := b.allow(); !allowed {
+			t.Fatalf("breaker opened after a success and %d failures", i)
+		}
+		b.done(true)
+	}
+	if allowed, _ := b.allow(); allowed {
+		t.Fatal("breaker closed after 3 failures")
+	}
+
+	now = now.Add(time.Second)
+	if allowed, probe := b.allow(); !allowed || !probe {
+		t.Fatal("breaker allows no probe after the cooldown")
+	}
+	if allowed, _ := b.allow(); allowed {
+		t.Error("breaker allows a second call while probing")
+	}
+	b.done(true)
+	if allowed, _ := b.allow(); allowed {
+		t.Error("breaker closed after a failed probe")
+	}
+
+	now = now.Add(time.Second)
+	b.allow()
+	b.done(false)
+	if allowed, probe := b.allow(); !allowed || probe {
+		t.Error("breaker open after a successful probe")
+	}
+}
+
+func TestRing(t *testing.T) {
+	three := newRing([]string{"a:11211", "b:11211", "c:11211"})
+	four := newRing([]string{"a:11211", "b:11211", "c:11211", "d:11211"})
+
+	counts := make(map[string]int)
+	moved := 0
+	const keys = 10000
+	for i := 0; i < keys; i++ {
+		key := strconv.Itoa(i)
+		addr := three.get(key)
+		counts[addr]++
+		if to := four.get(key); to != addr && to != "d:11211" {
+			t.Fatalf("key %v moved from %v to %v, not to the new node", key, addr, to)
+		} else if to != addr {
+			moved++
+		}
+	}
+	for addr, n := range counts {
+		if n < keys/5 || n > keys/2 {
+			t.Errorf("%v holds %d of %d keys", addr, n, keys)
+		}
+	}
+	if moved < keys/8 || moved > keys/3 {
+		t.Errorf("adding a fourth node moved %d of %d keys", moved, keys)
+	}
+}
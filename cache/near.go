@@ -0,0 +1,238 @@
+package cache
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	defaultNearSize = 10000
+	defaultNearTTL  = time.Minute
+)
+
+// Near is a store with two tiers: a near one in the memory of the service,
+// holding decoded values, in front of a far Store. Reads that hit the near
+// tier neither call the far cache nor decode. Writes and deletes go to both
+// tiers, but other replicas of the service keep their near values until
+// they expire or are invalidated.
+//
+// Values are shared by all the reads hitting them, so they must not be
+// changed once cached.
+type Near[T any] struct {
+	ttl  time.Duration
+	near *lru[T] // nil if the near tier is disabled
+	far  Store[T]
+
+	nearHits, nearMisses         atomic.Int64
+	farHits, farMisses, farFails atomic.Int64 // farFails counts failed calls
+	invalidations                atomic.Int64
+}
+
+// TierStats counts the reads of a tier of a Near store.
+type TierStats struct {
+	Hits      int64 `json:"hits"`
+	Misses    int64 `json:"misses"`
+	Errors    int64 `json:"errors,omitempty"`
+	Entries   int   `json:"entries,omitempty"`
+	Evictions int64 `json:"evictions,omitempty"`
+}
+
+// NearStats counts the reads of both tiers of a Near store, and the values
+// invalidated in its near tier.
+type NearStats struct {
+	Near          TierStats `json:"near"`
+	Far           TierStats `json:"far"`
+	Invalidations int64     `json:"invalidations"`
+}
+
+// NewNear returns a Near store named name in front of far. Its near tier
+// holds up to NEAR_CACHE_SIZE values (10000, 0 disables it) for
+// NEAR_CACHE_TTL (1m). The store is listed by Handler under its name.
+func NewNear[T any](name string, far Store[T]) *Near[T] {
+	size := defaultNearSize
+	if val, ok := os.LookupEnv("NEAR_CACHE_SIZE"); ok {
+		if n, err := strconv.Atoi(val); err != nil || n < 0 {
+			log.Warn().Msgf("Invalid NEAR_CACHE_SIZE %q, using %v", val, size)
+		} else {
+			size = n
+		}
+	}
+	ttl := defaultNearTTL
+	if val, ok := os.LookupEnv("NEAR_CACHE_TTL"); ok {
+		if d, err := time.ParseDuration(val); err != nil || d < 0 {
+			log.Warn().Msgf("Invalid NEAR_CACHE_TTL %q, using %v", val, ttl)
+		} else {
+			ttl = d
+		}
+	}
+	n := newNear(far, size, ttl)
+	log.Info().Msgf("Cache: near tier of %v holds %d values for %v", name, size, ttl)
+	register(name, n)
+	return n
+}
+
+func newNear[T any](far Store[T], size int, ttl time.Duration) *Near[T] {
+	n := &Near[T]{ttl: ttl, far: far}
+	if size > 0 {
+		n.near = newLRU(int64(size), func(string, T) int64 { return 1 }, false, size)
+	}
+	return n
+}
+
+// Get returns the value of key, or ErrMiss.
+func (n *Near[T]) Get(key string) (T, error) {
+	if n.near != nil {
+		if v, ok := n.near.get(key); ok {
+			n.nearHits.Add(1)
+			return v, nil
+		}
+		n.nearMisses.Add(1)
+	}
+	v, err := n.far.Get(key)
+	switch err {
+	case nil:
+		n.farHits.Add(1)
+		if n.near != nil {
+			n.near.set(key, v, n.ttl)
+		}
+	case ErrMiss:
+		n.farMisses.Add(1)
+	default:
+		n.farFails.Add(1)
+	}
+	return v, err
+}
+
+// GetMulti returns the values of the cached keys, asking the far tier for
+// the ones missing in the near one.
+func (n *Near[T]) GetMulti(keys []string) (map[string]T, error) {
+	values := make(map[string]T, len(keys))
+	missing := keys
+	if n.near != nil {
+		values = n.near.getMulti(keys)
+		n.nearHits.Add(int64(len(values)))
+		n.nearMisses.Add(int64(len(keys) - len(values)))
+		if len(values) == len(keys) {
+			return values, nil
+		}
+		missing = make([]string, 0, len(keys)-len(values))
+		for _, key := range keys {
+			if _, ok := values[key]; !ok {
+				missing = append(missing, key)
+			}
+		}
+	}
+
+	found, err := n.far.GetMulti(missing)
+	if err != nil {
+		n.farFails.Add(1)
+	}
+	n.farHits.Add(int64(len(found)))
+	n.farMisses.Add(int64(len(missing) - len(found)))
+	for key, v := range found {
+		values[key] = v
+		if n.near != nil {
+			n.near.set(key, v, n.ttl)
+		}
+	}
+	return values, err
+}
+
+// Set caches v for key in both tiers.
+func (n *Near[T]) Set(key string, v T) error {
+	if n.near != nil {
+		n.near.set(key, v, n.ttl)
+	}
+	return n.far.Set(key, v)
+}
+
+// Delete drops key from both tiers.
+func (n *Near[T]) Delete(key string) error {
+	n.Invalidate(key)
+	return n.far.Delete(key)
+}
+
+// Invalidate drops keys from the near tier only, for changes made by other
+// replicas or outside the service.
+func (n *Near[T]) Invalidate(keys ...string) {
+	if n.near == nil {
+		return
+	}
+	for _, key := range keys {
+		n.near.delete(key)
+	}
+	n.invalidations.Add(int64(len(keys)))
+}
+
+// Stats returns the counts of the store.
+func (n *Near[T]) Stats() NearStats {
+	s := NearStats{
+		Near:          TierStats{Hits: n.nearHits.Load(), Misses: n.nearMisses.Load()},
+		Far:           TierStats{Hits: n.farHits.Load(), Misses: n.farMisses.Load(), Errors: n.farFails.Load()},
+		Invalidations: n.invalidations.Load(),
+	}
+	if n.near != nil {
+		s.Near.Entries, s.Near.Evictions = n.near.stats()
+	}
+	return s
+}
+
+// nearStore is a Near store of any type.
+type nearStore interface {
+	Stats() NearStats
+	Invalidate(keys ...string)
+}
+
+var (
+	storesMu sync.Mutex
+	stores   = make(map[string]nearStore)
+)
+
+func register(name string, s nearStore) {
+	storesMu.Lock()
+	defer storesMu.Unlock()
+	stores[name] = s
+}
+
+// Handler serves the stats of the Near stores by name. A POST with store and
+// keys, comma separated, invalidates the keys in the near tier of the store.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		storesMu.Lock()
+		defer storesMu.Unlock()
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			s, ok := stores[r.FormValue("store")]
+			if !ok {
+				http.Error(w, "unknown store "+strconv.Quote(r.FormValue("store")), http.StatusBadRequest)
+				return
+			}
+			if r.FormValue("keys") == "" {
+				http.Error(w, "no keys to invalidate", http.StatusBadRequest)
+				return
+			}
+			keys := strings.Split(r.FormValue("keys"), ",")
+			s.Invalidate(keys...)
+			log.Info().Msgf("Cache: invalidated %v in the near tier of %v for %v", keys, r.FormValue("store"), r.RemoteAddr)
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		stats := make(map[string]NearStats, len(stores))
+		for name, s := range stores {
+			stats[name] = s.Stats()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(stats)
+	})
+}
@@ -0,0 +1,164 @@
+package cache
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNear(t *testing.T) {
+	far := NewLocal(1<<20, false)
+	now := time.Unix(0, 0)
+	n := newNear(Store[int]{Cache: far, Codec: JSON}, 2, time.Minute)
+	n.near.now = func() time.Time { return now }
+
+	n.far.Set("1", 1)
+	n.far.Set("2", 2)
+	values, err := n.GetMulti([]string{"1", "2", "3"})
+	if err != nil || len(values) != 2 || values["1"] != 1 || values["2"] != 2 {
+		t.Fatalf("GetMulti = %v, %v", values, err)
+	}
+	if v, err := n.Get("1"); err != nil || v != 1 {
+		t.Errorf("Get(1) = %v, %v", v, err)
+	}
+	want := NearStats{
+		Near: TierStats{Hits: 1, Misses: 3, Entries: 2},
+		Far:  TierStats{Hits: 2, Misses: 1},
+	}
+	if got := n.Stats(); got != want {
+		t.Errorf("stats after a far and a near read = %+v, want %+v", got, want)
+	}
+
+	// reads hitting the near tier do not see changes made behind it
+	far.Delete("1")
+	if v, err := n.Get("1"); err != nil || v != 1 {
+		t.Errorf("Get(1) from the near tier = %v, %v", v, err)
+	}
+	n.Invalidate("1")
+	if _, err := n.Get("1"); err != ErrMiss {
+		t.Errorf("Get of an invalidated key error = %v, want ErrMiss", err)
+	}
+
+	// writes and deletes go to both tiers
+	n.Set("3", 3)
+	if v, err := n.far.Get("3"); err != nil || v != 3 {
+		t.Errorf("far value of 3 = %v, %v", v, err)
+	}
+	n.Delete("3")
+	if _, err := n.Get("3"); err != ErrMiss {
+		t.Errorf("Get of a deleted key error = %v, want ErrMiss", err)
+	}
+
+	// near values expire, then the far tier is read again
+	n.Set("2", 20)
+	far.Set("2", []byte("200"), 0)
+	now = now.Add(time.Minute)
+	if v, err := n.Get("2"); err != nil || v != 200 {
+		t.Errorf("Get(2) after the ttl = %v, %v, want the far value 200", v, err)
+	}
+
+	// the near tier holds at most 2 values
+	n.Set("4", 4)
+	n.Set("5", 5)
+	if s := n.Stats(); s.Near.Entries != 2 || s.Near.Evictions == 0 {
+		t.Errorf("near tier holds %d values after %d evictions, want 2", s.Near.Entries, s.Near.Evictions)
+	}
+}
+
+func TestNearDisabled(t *testing.T) {
+	t.Setenv("NEAR_CACHE_SIZE", "0")
+	far := Store[int]{Cache: NewLocal(1<<20, false), Codec: JSON}
+	n := NewNear("disabled", far)
+	n.Set("1", 1)
+	n.Get("1")
+	n.GetMulti([]string{"1", "2"})
+	if s := n.Stats(); s.Near != (TierStats{}) || s.Far.Hits != 2 || s.Far.Misses != 1 {
+		t.Errorf("stats without a near tier = %+v", s)
+	}
+}
+
+func TestNearHandler(t *testing.T) {
+	n := NewNear("profile", Store[string]{Cache: NewLocal(1<<20, false), Codec: JSON})
+	n.Set("1", "Clift Hotel")
+	n.Set("2", "W San Francisco")
+	n.Get("1")
+
+	serve := func(method string, form url.Values) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/cache", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		Handler().ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve(http.MethodGet, nil)
+	var stats map[string]NearStats
+	if err := json.NewDecoder(w.Body).Decode(&stats); err != nil {
+		t.Fatal(err)
+	}
+	if got := stats["profile"].Near; got.Hits != 1 || got.Entries != 2 {
+		t.Errorf("near stats of profile = %+v", got)
+	}
+
+	w = serve(http.MethodPost, url.Values{"store": {"profile"}, "keys": {"1,2"}})
+	if w.Code != http.StatusOK {
+		t.Fatalf("invalidating returned %d: %s", w.Code, w.Body)
+	}
+	if got := n.Stats(); got.Near.Entries != 0 || got.Invalidations != 2 {
+		t.Errorf("stats after invalidating 2 keys = %+v", got)
+	}
+
+	for _, form := range []url.Values{{"store": {"rate"}, "keys": {"1"}}, {"store": {"profile"}}} {
+		if w := serve(http.MethodPost, form); w.Code != http.StatusBadRequest {
+			t.Errorf("POST %v returned %d, want 400", form, w.Code)
+		}
+	}
+	if w := serve(http.MethodDelete, nil); w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("DELETE returned %d, want 405", w.Code)
+	}
+}
+
+// BenchmarkNear reads profile-sized values in batches of 10 from memcached,
+// with and without a near tier, reporting the median and p99 latency of a
+// batch next to the mean.
+func BenchmarkNear(b *testing.B) {
+	type profile struct {
+		Id, Name, PhoneNumber, Description string
+	}
+	value := profile{Id: "1", Name: "Clift Hotel", PhoneNumber: "(415) 775-4700",
+		Description: "A 6-minute walk from Union Square and 4 minutes from a Muni Metro station, this luxury hotel designed by Philippe Starck features an artsy furniture collection in the lobby, including work by Salvador Dali."}
+	var keys []string
+	for i := 0; i < 10; i++ {
+		keys = append(keys, strconv.Itoa(i))
+	}
+	far := Store[profile]{Cache: newTestMemcached(b, false, newFakeMemcached(b)), Codec: JSON}
+	for _, key := range keys {
+		far.Set(key, value)
+	}
+
+	for name, get := range map[string]func([]string) (map[string]profile, error){
+		"far":  far.GetMulti,
+		"near": newNear(far, 1000, time.Minute).GetMulti,
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			latencies := make([]time.Duration, b.N)
+			for i := 0; i < b.N; i++ {
+				start := time.Now()
+				if values, err := get(keys); err != nil || len(values) != len(keys) {
+					b.Fatalf("GetMulti = %d values, %v", len(values), err)
+				}
+				latencies[i] = time.Since(start)
+			}
+			slices.Sort(latencies)
+			b.ReportMetric(float64(latencies[b.N/2]), "p50-ns")
+			b.ReportMetric(float64(latencies[b.N*99/100]), "p99-ns")
+		})
+	}
+}
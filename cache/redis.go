@@ -0,0 +1,211 @@
+package cache
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/appnetorg/hotel-reservation-arpc/tune"
+)
+
+// maxIdleRedisConns is the number of idle connections kept per Redis server.
+const maxIdleRedisConns = 64
+
+// NewRedis returns a cache over servers, a comma separated list of Redis
+// addresses. The memcached timeout bounds its calls too.
+func NewRedis(servers string) (*Cluster, error) {
+	return newCluster("redis", servers, func(addr string) (node, error) {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return nil, err
+		}
+		return &redisNode{addr: addr}, nil
+	})
+}
+
+// redisNode talks RESP, the protocol of Redis, to a server.
+type redisNode struct {
+	addr string
+
+	mu   sync.Mutex
+	idle []*redisConn
+}
+
+type redisConn struct {
+	nc net.Conn
+	r  *bufio.Reader
+	w  *bufio.Writer
+}
+
+// redisError is an error reply. The server answered, so it is not a failure
+// of the server.
+type redisError string
+
+func (e redisError) Error() string { return "redis: " + string(e) }
+
+func (n *redisNode) get(key string) ([]byte, error) {
+	reply, err := n.do("GET", key)
+	if err != nil {
+		return nil, err
+	}
+	value, _ := reply.([]byte)
+	if value == nil {
+		return nil, ErrMiss
+	}
+	return value, nil
+}
+
+func (n *redisNode) getMulti(keys []string) (map[string][]byte, error) {
+	reply, err := n.do(append([]string{"MGET"}, keys...)...)
+	if err != nil {
+		return nil, err
+	}
+	replies, ok := reply.([]any)
+	if !ok || len(replies) != len(keys) {
+		return nil, fmt.Errorf("redis: unexpected MGET reply %v", reply)
+	}
+	values := make(map[string][]byte, len(keys))
+	for i, r := range replies {
+		if value, _ := r.([]byte); value != nil {
+			values[keys[i]] = value
+		}
+	}
+	return values, nil
+}
+
+func (n *redisNode) set(key string, value []byte, ttl time.Duration) error {
+	args := []string{"SET", key, string(value)}
+	if ttl > 0 {
+		ms := (ttl + time.Millisecond - 1) / time.Millisecond
+		args = append(args, "PX", strconv.FormatInt(int64(ms), 10))
+	}
+	_, err := n.do(args...)
+	return err
+}
+
+func (n *redisNode) delete(key string) error {
+	reply, err := n.do("DEL", key)
+	if err != nil {
+		return err
+	}
+	if deleted, _ := reply.(int64); deleted == 0 {
+		return ErrMiss
+	}
+	return nil
+}
+
+func (n *redisNode) flush() error {
+	_, err := n.do("FLUSHDB")
+	return err
+}
+
+func (n *redisNode) failed(err error) bool {
+	if _, ok := err.(redisError); ok {
+		return false
+	}
+	return err != nil && err != ErrMiss
+}
+
+// do sends a command and reads its reply. Connections failing mid command
+// are closed, the others go back to the idle ones.
+func (n *redisNode) do(args ...string) (any, error) {
+	c, err := n.conn()
+	if err != nil {
+		return nil, err
+	}
+	c.nc.SetDeadline(time.Now().Add(tune.GetMemCTimeout()))
+	reply, err := c.roundTrip(args)
+	if _, ok := err.(redisError); err != nil && !ok {
+		c.nc.Close()
+		return nil, err
+	}
+	n.release(c)
+	return reply, err
+}
+
+func (n *redisNode) conn() (*redisConn, error) {
+	n.mu.Lock()
+	if len(n.idle) > 0 {
+		c := n.idle[len(n.idle)-1]
+		n.idle = n.idle[:len(n.idle)-1]
+		n.mu.Unlock()
+		return c, nil
+	}
+	n.mu.Unlock()
+	nc, err := net.DialTimeout("tcp", n.addr, tune.GetMemCTimeout())
+	if err != nil {
+		return nil, err
+	}
+	return &redisConn{nc: nc, r: bufio.NewReader(nc), w: bufio.NewWriter(nc)}, nil
+}
+
+func (n *redisNode) release(c *redisConn) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if len(n.idle) >= maxIdleRedisConns {
+		c.nc.Close()
+		return
+	}
+	n.idle = append(n.idle, c)
+}
+
+func (c *redisConn) roundTrip(args []string) (any, error) {
+	fmt.Fprintf(c.w, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(c.w, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if err := c.w.Flush(); err != nil {
+		return nil, err
+	}
+	return readReply(c.r)
+}
+
+// readReply reads a reply: a string, an int64, a []byte or nil for bulk
+// strings, or a []any for arrays. Error replies are returned as redisError.
+func readReply(r *bufio.Reader) (any, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+	kind, line := line[0], line[1:len(line)-2]
+	switch kind {
+	case '+':
+		return line, nil
+	case '-':
+		return nil, redisError(line)
+	case ':':
+		return strconv.ParseInt(line, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(line)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+		return data[:size], nil
+	case '*':
+		size, err := strconv.Atoi(line)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		replies := make([]any, size)
+		for i := range replies {
+			if replies[i], err = readReply(r); err != nil {
+				if _, ok := err.(redisError); !ok {
+					return nil, err
+				}
+			}
+		}
+		return replies, nil
+	default:
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+}
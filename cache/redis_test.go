@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRedis serves the GET, MGET, SET, DEL and FLUSHDB commands of RESP from
+// a map, keeping the PX expiry of SET.
+type fakeRedis struct {
+	ln net.Listener
+
+	mu    sync.Mutex
+	items map[string]string
+	ttls  map[string]time.Duration
+}
+
+func newFakeRedis(t testing.TB) *fakeRedis {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeRedis{ln: ln, items: make(map[string]string), ttls: make(map[string]time.Duration)}
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go f.handle(c)
+		}
+	}()
+	t.Cleanup(func() { ln.Close() })
+	return f
+}
+
+func (f *fakeRedis) addr() string { return f.ln.Addr().String() }
+
+func (f *fakeRedis) handle(c net.Conn) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		f.mu.Lock()
+		var out string
+		switch strings.ToUpper(args[0]) {
+		case "GET":
+			out = bulk(f.items, args[1])
+		case "MGET":
+			out = fmt.Sprintf("*%d\r\n", len(args)-1)
+			for _, key := range args[1:] {
+				out += bulk(f.items, key)
+			}
+		case "SET":
+			f.items[args[1]] = args[2]
+			delete(f.ttls, args[1])
+			if len(args) == 5 && strings.ToUpper(args[3]) == "PX" {
+				ms, _ := strconv.Atoi(args[4])
+				f.ttls[args[1]] = time.Duration(ms) * time.Millisecond
+			}
+			out = "+OK\r\n"
+		case "DEL":
+			_, ok := f.items[args[1]]
+			delete(f.items, args[1])
+			if ok {
+				out = ":1\r\n"
+			} else {
+				out = ":0\r\n"
+			}
+		case "FLUSHDB":
+			f.items = make(map[string]string)
+			out = "+OK\r\n"
+		default:
+			out = "-ERR unknown command '" + args[0] + "'\r\n"
+		}
+		f.mu.Unlock()
+		if _, err := io.WriteString(c, out); err != nil {
+			return
+		}
+	}
+}
+
+func bulk(items map[string]string, key string) string {
+	v, ok := items[key]
+	if !ok {
+		return "$-1\r\n"
+	}
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+}
+
+// readCommand reads a command, an array of bulk strings.
+func readCommand(r *bufio.Reader) ([]string, error) {
+	reply, err := readReply(r)
+	if err != nil {
+		return nil, err
+	}
+	parts, ok := reply.([]any)
+	if !ok || len(parts) == 0 {
+		return nil, fmt.Errorf("not a command: %v", reply)
+	}
+	args := make([]string, len(parts))
+	for i, p := range parts {
+		b, _ := p.([]byte)
+		args[i] = string(b)
+	}
+	return args, nil
+}
+
+func TestRedis(t *testing.T) {
+	f := newFakeRedis(t)
+	t.Setenv("CACHE_BYPASS", "false")
+	c, err := NewRedis(f.addr())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Set("1", []byte("hotel 1"), 1500*time.Microsecond); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.ttls["1"]; got != 2*time.Millisecond {
+		t.Errorf("ttl of 1.5ms sent as %v, want it rounded up to 2ms", got)
+	}
+	if err := c.Set("empty", []byte{}, 0); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := c.Get("empty"); err != nil || len(v) != 0 {
+		t.Errorf("Get(empty) = %q, %v", v, err)
+	}
+
+	n := c.nodes[f.addr()].node.(*redisNode)
+	if _, err := n.do("NOSUCH"); err == nil || n.failed(err) {
+		t.Errorf("error reply = %v, want an error not failing the server", err)
+	}
+	if len(n.idle) != 1 {
+		t.Errorf("%d idle connections after an error reply, want 1", len(n.idle))
+	}
+}
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"hash/crc32"
+	"sort"
+	"strconv"
+)
+
+// replicas is the number of points every node has on the ring. More points
+// spread the keys more evenly across the nodes.
+const replicas = 160
+
+// ring maps keys to nodes by consistent hashing, so adding or removing a node
+// only moves the keys of that node.
+type ring struct {
+	points []uint32
+	addrs  map[uint32]string
+}
+
+func newRing(addrs []string) *ring {
+	r := &ring{addrs: make(map[uint32]string, len(addrs)*replicas)}
+	for _, addr := range addrs {
+		for i := 0; i < replicas; i++ {
+			point := crc32.ChecksumIEEE([]byte(addr + "-" + strconv.Itoa(i)))
+			if _, ok := r.addrs[point]; ok {
+				continue
+			}
+			r.addrs[point] = addr
+			r.points = append(r.points, point)
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool { return r.points[i] < r.points[j] })
+	return r
+}
+
+// get returns the node of key, the first one at or after its hash.
+func (r *ring) get(key string) string {
+	hash := crc32.ChecksumIEEE([]byte(key))
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= hash })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.addrs[r.points[i]]
+}
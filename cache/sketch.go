@@ -0,0 +1,75 @@
+package cache
+
+import "hash/maphash"
+
+// sketch estimates how often keys were read, in a count-min sketch of 4-bit
+// counters. The counters are halved every few reads per counter, so the
+// estimates follow what is popular now.
+type sketch struct {
+	seed     maphash.Seed
+	counters []uint8 // two counters per byte
+	mask     uint64
+	adds     int
+	resetAt  int
+}
+
+// depth is the number of counters of a key. Its estimate is the lowest.
+const depth = 4
+
+func newSketch(keys int) *sketch {
+	width := 1024
+	for width < keys {
+		width *= 2
+	}
+	return &sketch{
+		seed:     maphash.MakeSeed(),
+		counters: make([]uint8, width/2),
+		mask:     uint64(width - 1),
+		resetAt:  10 * width,
+	}
+}
+
+// indexes returns the counters of key, mixing its hash differently for
+// every row.
+func (s *sketch) indexes(key string) [depth]uint64 {
+	h := maphash.String(s.seed, key)
+	var out [depth]uint64
+	for i := range out {
+		x := h + uint64(i+1)*0x9e3779b97f4a7c15
+		x = (x ^ x>>30) * 0xbf58476d1ce4e5b9
+		x = (x ^ x>>27) * 0x94d049bb133111eb
+		out[i] = (x ^ x>>31) & s.mask
+	}
+	return out
+}
+
+func (s *sketch) counter(i uint64) uint8 {
+	return s.counters[i/2] >> (4 * (i % 2)) & 0xf
+}
+
+// add counts a read of key.
+func (s *sketch) add(key string) {
+	for _, i := range s.indexes(key) {
+		if s.counter(i) < 15 {
+			s.counters[i/2] += 1 << (4 * (i % 2))
+		}
+	}
+	s.adds++
+	if s.adds >= s.resetAt {
+		for i := range s.counters {
+			s.counters[i] = s.counters[i] >> 1 & 0x77
+		}
+		s.adds /= 2
+	}
+}
+
+// estimate returns how often key was read.
+func (s *sketch) estimate(key string) uint8 {
+	min := uint8(15)
+	for _, i := range s.indexes(key) {
+		if c := s.counter(i); c < min {
+			min = c
+		}
+	}
+	return min
+}
@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Codec encodes the values of a Store.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+var (
+	// JSON encodes values as JSON, like the services always did.
+	JSON Codec = jsonCodec{}
+	// Gob encodes values with encoding/gob.
+	Gob Codec = gobCodec{}
+)
+
+var codecs = map[string]Codec{"json": JSON, "gob": Gob}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+type gobCodec struct{}
+
+func (gobCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(v)
+	return buf.Bytes(), err
+}
+
+func (gobCodec) Unmarshal(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// TTL returns how long the value of a key is cached, 0 for as long as the
+// cache keeps it.
+type TTL func(key string) time.Duration
+
+// FixedTTL caches every value for ttl.
+func FixedTTL(ttl time.Duration) TTL {
+	return func(string) time.Duration { return ttl }
+}
+
+// Store caches values of type T in a Cache.
+type Store[T any] struct {
+	Cache Cache
+	Codec Codec
+	TTL   TTL
+}
+
+// NewStore returns a store of values in c, encoded with the codec named by
+// CACHE_CODEC, json (default) or gob, and cached for CACHE_TTL, a duration,
+// or as long as c keeps them if unset.
+func NewStore[T any](c Cache) Store[T] {
+	codec := JSON
+	if val, ok := os.LookupEnv("CACHE_CODEC"); ok {
+		if cc, ok := codecs[strings.ToLower(val)]; ok {
+			codec = cc
+		} else {
+			log.Warn().Msgf("Invalid CACHE_CODEC %q, using json", val)
+		}
+	}
+	var ttl time.Duration
+	if val, ok := os.LookupEnv("CACHE_TTL"); ok {
+		if d, err := time.ParseDuration(val); err != nil || d < 0 {
+			log.Warn().Msgf("Invalid CACHE_TTL %q, using %v", val, ttl)
+		} else {
+			ttl = d
+		}
+	}
+	return Store[T]{Cache: c, Codec: codec, TTL: FixedTTL(ttl)}
+}
+
+// Get returns the value of key, or ErrMiss, also if the value does not
+// decode.
+func (s Store[T]) Get(key string) (T, error) {
+	var v T
+	data, err := s.Cache.Get(key)
+	if err != nil {
+		return v, err
+	}
+	if err := s.Codec.Unmarshal(data, &v); err != nil {
+		log.Warn().Msgf("Failed to decode cached value of [%v]: %v", key, err)
+		return v, ErrMiss
+	}
+	return v, nil
+}
+
+// GetMulti returns the values of the cached keys. Values that do not decode
+// are left out like missing ones.
+func (s Store[T]) GetMulti(keys []string) (map[string]T, error) {
+	found, err := s.Cache.GetMulti(keys)
+	values := make(map[string]T, len(found))
+	for key, data := range found {
+		var v T
+		if err := s.Codec.Unmarshal(data, &v); err != nil {
+			log.Warn().Msgf("Failed to decode cached value of [%v]: %v", key, err)
+			continue
+		}
+		values[key] = v
+	}
+	return values, err
+}
+
+// Set caches v for key.
+func (s Store[T]) Set(key string, v T) error {
+	data, err := s.Codec.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var ttl time.Duration
+	if s.TTL != nil {
+		ttl = s.TTL(key)
+	}
+	return s.Cache.Set(key, data, ttl)
+}
+
+// Delete drops key from the cache.
+func (s Store[T]) Delete(key string) error {
+	return s.Cache.Delete(key)
+}
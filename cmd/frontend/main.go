@@ -1,19 +1,17 @@
 package main
 
 import (
-	"encoding/json"
-	"flag"
 	"fmt"
-	"io/ioutil"
 	"os"
-	"strconv"
-	"time"
 
 	"github.com/appnet-org/arpc/pkg/logging"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	"github.com/appnetorg/hotel-reservation-arpc/config"
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	"github.com/appnetorg/hotel-reservation-arpc/services/frontend"
 	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
 	"github.com/appnetorg/hotel-reservation-arpc/tune"
-	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
@@ -37,46 +35,59 @@ func getLoggingConfig() *logging.Config {
 
 func main() {
 	tune.Init()
-	err := logging.Init(getLoggingConfig())
+	logging_config := getLoggingConfig()
+	err := logging.Init(logging_config)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to initialize logging: %v", err))
 	}
-	log.Logger = zerolog.New(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}).With().Timestamp().Caller().Logger()
+	logs.Init(logging_config)
 
 	log.Info().Msg("Reading config...")
-	jsonFile, err := os.Open("config.json")
-	if err != nil {
-		log.Error().Msgf("Got error while reading config: %v", err)
+	var cfg config.Frontend
+	if err := config.Load(&cfg); err != nil {
+		log.Panic().Msgf("Got error while reading config: %v", err)
 	}
-	defer jsonFile.Close()
+	tune.Serve(cfg.AdminPort)
+
+	serv_port := cfg.Port
+	serv_ip := cfg.IP
+	knative_dns := cfg.KnativeDomainName
 
-	byteValue, _ := ioutil.ReadAll(jsonFile)
+	log.Info().Msgf("Read target port: %v", serv_port)
+	log.Info().Msgf("Read jaeger address: %v", cfg.JaegerAddress)
+	log.Info().Msgf("Initializing tracer [service name: %v | endpoint: %v]...", "frontend", cfg.JaegerAddress)
+	tracer, err := tracing.Init("frontend", cfg.JaegerAddress)
+	if err != nil {
+		log.Panic().Msgf("Got error while initializing tracer: %v", err)
+	}
+	log.Info().Msg("Tracer initialized")
 
-	var result map[string]string
-	json.Unmarshal([]byte(byteValue), &result)
+	session_keys, err := frontend.ParseSessionKeys(cfg.SessionKeys)
+	if err != nil {
+		log.Panic().Msgf("Got error while reading session keys: %v", err)
+	}
+	session_ttl := cfg.SessionTTL
+	log.Info().Msgf("Read %d session keys, session TTL: %v", len(session_keys), session_ttl)
 
-	serv_port, _ := strconv.Atoi(result["FrontendPort"])
-	serv_ip := result["FrontendIP"]
-	knative_dns := result["KnativeDomainName"]
+	auth_key, err := auth.ParseKey(cfg.AuthKey)
+	if err != nil {
+		log.Panic().Msgf("Got error while reading auth key: %v", err)
+	}
 
-	log.Info().Msgf("Read target port: %v", serv_port)
-	log.Info().Msgf("Read jaeger address: %v", result["jaegerAddress"])
-	var (
-		jaegeraddr = flag.String("jaegeraddr", result["jaegerAddress"], "Jaeger address")
-	)
-	flag.Parse()
-	log.Info().Msgf("Initializing jaeger agent [service name: %v | host: %v]...", "frontend", *jaegeraddr)
-	tracer, err := tracing.Init("frontend", *jaegeraddr)
+	transports, err := transport.Parse(cfg.GRPCServers, cfg.GRPCHops, cfg.Serializers)
 	if err != nil {
-		log.Panic().Msgf("Got error while initializing jaeger agent: %v", err)
+		log.Panic().Msgf("Got error while reading transports: %v", err)
 	}
-	log.Info().Msg("Jaeger agent initialized")
 
 	srv := &frontend.Server{
-		KnativeDns: knative_dns,
-		Tracer:     tracer,
-		IpAddr:     serv_ip,
-		Port:       serv_port,
+		KnativeDns:  knative_dns,
+		Tracer:      tracer,
+		IpAddr:      serv_ip,
+		Port:        serv_port,
+		SessionKeys: session_keys,
+		SessionTTL:  session_ttl,
+		AuthKey:     auth_key,
+		Transport:   transports,
 	}
 
 	log.Info().Msg("Starting server...")
@@ -1,19 +1,17 @@
 package main
 
 import (
-	"encoding/json"
-	"flag"
 	"fmt"
-	"io/ioutil"
 	"os"
-	"strconv"
-	"time"
 
 	"github.com/appnet-org/arpc/pkg/logging"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	"github.com/appnetorg/hotel-reservation-arpc/config"
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	"github.com/appnetorg/hotel-reservation-arpc/services/geo"
 	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
 	"github.com/appnetorg/hotel-reservation-arpc/tune"
-	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
@@ -37,49 +35,49 @@ func getLoggingConfig() *logging.Config {
 
 func main() {
 	tune.Init()
-	err := logging.Init(getLoggingConfig())
+	logging_config := getLoggingConfig()
+	err := logging.Init(logging_config)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to initialize logging: %v", err))
 	}
-	log.Logger = zerolog.New(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}).With().Timestamp().Caller().Logger()
+	logs.Init(logging_config)
 
 	log.Info().Msg("Reading config...")
-	jsonFile, err := os.Open("config.json")
-	if err != nil {
-		log.Error().Msgf("Got error while reading config: %v", err)
+	var cfg config.Geo
+	if err := config.Load(&cfg); err != nil {
+		log.Panic().Msgf("Got error while reading config: %v", err)
 	}
+	tune.Serve(cfg.AdminPort)
 
-	defer jsonFile.Close()
-
-	byteValue, _ := ioutil.ReadAll(jsonFile)
-
-	var result map[string]string
-	json.Unmarshal([]byte(byteValue), &result)
-
-	log.Info().Msgf("Read database URL: %v", result["GeoMongoAddress"])
+	log.Info().Msgf("Read database URL: %v", cfg.MongoAddress)
 	log.Info().Msg("Initializing DB connection...")
-	mongo_session := initializeDatabase(result["GeoMongoAddress"])
+	mongo_session := initializeDatabase(cfg.MongoAddress)
 	defer mongo_session.Close()
 	log.Info().Msg("Successfull")
 
-	serv_port, _ := strconv.Atoi(result["GeoPort"])
-	serv_ip := result["GeoIP"]
+	serv_port := cfg.Port
+	serv_ip := cfg.IP
 
 	log.Info().Msgf("Read target port: %v", serv_port)
-	log.Info().Msgf("Read jaeger address: %v", result["jaegerAddress"])
-	var (
-		// port       = flag.Int("port", 8083, "Server port")
-		jaegeraddr = flag.String("jaegeraddr", result["jaegerAddress"], "Jaeger address")
-	)
-	flag.Parse()
+	log.Info().Msgf("Read jaeger address: %v", cfg.JaegerAddress)
+
+	log.Info().Msgf("Initializing tracer [service name: %v | endpoint: %v]...", "geo", cfg.JaegerAddress)
 
-	log.Info().Msgf("Initializing jaeger agent [service name: %v | host: %v]...", "geo", *jaegeraddr)
+	tracer, err := tracing.Init("geo", cfg.JaegerAddress)
+	if err != nil {
+		log.Panic().Msgf("Got error while initializing tracer: %v", err)
+	}
+	log.Info().Msg("Tracer initialized")
+
+	auth_key, err := auth.ParseKey(cfg.AuthKey)
+	if err != nil {
+		log.Panic().Msgf("Got error while reading auth key: %v", err)
+	}
 
-	tracer, err := tracing.Init("geo", *jaegeraddr)
+	transports, err := transport.Parse(cfg.GRPCServers, cfg.GRPCHops, cfg.Serializers)
 	if err != nil {
-		log.Panic().Msgf("Got error while initializing jaeger agent: %v", err)
+		log.Panic().Msgf("Got error while reading transports: %v", err)
 	}
-	log.Info().Msg("Jaeger agent initialized")
 
 	srv := &geo.Server{
 		// Port:     *port,
@@ -87,6 +85,8 @@ func main() {
 		IpAddr:       serv_ip,
 		Tracer:       tracer,
 		MongoSession: mongo_session,
+		AuthKey:      auth_key,
+		Transport:    transports,
 	}
 
 	log.Info().Msg("Starting server...")
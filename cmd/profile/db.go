@@ -212,7 +212,20 @@ func initializeDatabase(url string) *mgo.Session {
 		}
 	}
 
-	err = c.EnsureIndexKey("id")
+	// CreateHotel relies on hotel ids being unique. Databases seeded before
+	// have a plain index on id, which is replaced.
+	indexes, err := c.Indexes()
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+	for _, index := range indexes {
+		if index.Name == "id_1" && !index.Unique {
+			if err := c.DropIndexName(index.Name); err != nil {
+				log.Fatal().Msg(err.Error())
+			}
+		}
+	}
+	err = c.EnsureIndex(mgo.Index{Key: []string{"id"}, Unique: true})
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
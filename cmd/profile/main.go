@@ -1,21 +1,19 @@
 package main
 
 import (
-	"encoding/json"
-	"flag"
 	"fmt"
-	"io/ioutil"
 	"os"
-	"strconv"
 
 	"github.com/appnet-org/arpc/pkg/logging"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	"github.com/appnetorg/hotel-reservation-arpc/cache"
+	"github.com/appnetorg/hotel-reservation-arpc/config"
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	"github.com/appnetorg/hotel-reservation-arpc/services/profile"
 	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
 	"github.com/appnetorg/hotel-reservation-arpc/tune"
-	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
-
-	"time"
 )
 
 // getLoggingConfig reads logging configuration from environment variables with defaults
@@ -38,53 +36,56 @@ func getLoggingConfig() *logging.Config {
 
 func main() {
 	tune.Init()
-	err := logging.Init(getLoggingConfig())
+	logging_config := getLoggingConfig()
+	err := logging.Init(logging_config)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to initialize logging: %v", err))
 	}
-	log.Logger = zerolog.New(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}).With().Timestamp().Caller().Logger()
+	logs.Init(logging_config)
 
 	log.Info().Msg("Reading config...")
-	jsonFile, err := os.Open("config.json")
-	if err != nil {
-		log.Error().Msgf("Got error while reading config: %v", err)
+	var cfg config.Profile
+	if err := config.Load(&cfg); err != nil {
+		log.Panic().Msgf("Got error while reading config: %v", err)
 	}
+	tune.Handle("/cache", cache.Handler())
+	tune.Serve(cfg.AdminPort)
 
-	defer jsonFile.Close()
-
-	byteValue, _ := ioutil.ReadAll(jsonFile)
-
-	var result map[string]string
-	json.Unmarshal([]byte(byteValue), &result)
-
-	log.Info().Msgf("Read database URL: %v", result["ProfileMongoAddress"])
+	log.Info().Msgf("Read database URL: %v", cfg.MongoAddress)
 	log.Info().Msg("Initializing DB connection...")
-	mongo_session := initializeDatabase(result["ProfileMongoAddress"])
+	mongo_session := initializeDatabase(cfg.MongoAddress)
 	defer mongo_session.Close()
 	log.Info().Msg("Successfull")
 
-	log.Info().Msgf("Read profile memcashed address: %v", result["ProfileMemcAddress"])
-	log.Info().Msg("Initializing Memcashed client...")
-	memc_client := tune.NewMemCClient2(result["ProfileMemcAddress"])
+	log.Info().Msgf("Read cache: %v", cfg.MemcAddress)
+	log.Info().Msg("Initializing cache...")
+	cache_client, err := cache.New(cfg.MemcAddress)
+	if err != nil {
+		log.Panic().Msgf("Got error while initializing cache: %v", err)
+	}
 	log.Info().Msg("Successfull")
 
-	serv_port, _ := strconv.Atoi(result["ProfilePort"])
-	serv_ip := result["ProfileIP"]
+	serv_port := cfg.Port
+	serv_ip := cfg.IP
 	log.Info().Msgf("Read target port: %v", serv_port)
-	log.Info().Msgf("Read jaeger address: %v", result["jaegerAddress"])
+	log.Info().Msgf("Read jaeger address: %v", cfg.JaegerAddress)
+
+	log.Info().Msgf("Initializing tracer [service name: %v | endpoint: %v]...", "profile", cfg.JaegerAddress)
+	tracer, err := tracing.Init("profile", cfg.JaegerAddress)
+	if err != nil {
+		log.Panic().Msgf("Got error while initializing tracer: %v", err)
+	}
+	log.Info().Msg("Tracer initialized")
 
-	var (
-		// port       = flag.Int("port", 8081, "The server port")
-		jaegeraddr = flag.String("jaegeraddr", result["jaegerAddress"], "Jaeger server addr")
-	)
-	flag.Parse()
+	auth_key, err := auth.ParseKey(cfg.AuthKey)
+	if err != nil {
+		log.Panic().Msgf("Got error while reading auth key: %v", err)
+	}
 
-	log.Info().Msgf("Initializing jaeger agent [service name: %v | host: %v]...", "profile", *jaegeraddr)
-	tracer, err := tracing.Init("profile", *jaegeraddr)
+	transports, err := transport.Parse(cfg.GRPCServers, cfg.GRPCHops, cfg.Serializers)
 	if err != nil {
-		log.Panic().Msgf("Got error while initializing jaeger agent: %v", err)
+		log.Panic().Msgf("Got error while reading transports: %v", err)
 	}
-	log.Info().Msg("Jaeger agent initialized")
 
 	srv := profile.Server{
 		Tracer: tracer,
@@ -92,7 +93,9 @@ func main() {
 		Port:         serv_port,
 		IpAddr:       serv_ip,
 		MongoSession: mongo_session,
-		MemcClient:   memc_client,
+		AuthKey:      auth_key,
+		Cache:        cache_client,
+		Transport:    transports,
 	}
 
 	log.Info().Msg("Starting server...")
@@ -1,21 +1,19 @@
 package main
 
 import (
-	"encoding/json"
-	"flag"
 	"fmt"
-	"io/ioutil"
 	"os"
-	"time"
-
-	"strconv"
 
 	"github.com/appnet-org/arpc/pkg/logging"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	"github.com/appnetorg/hotel-reservation-arpc/config"
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	"github.com/appnetorg/hotel-reservation-arpc/services/recommendation"
 	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
 	"github.com/appnetorg/hotel-reservation-arpc/tune"
-	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"gopkg.in/mgo.v2"
 )
 
 // getLoggingConfig reads logging configuration from environment variables with defaults
@@ -38,56 +36,68 @@ func getLoggingConfig() *logging.Config {
 
 func main() {
 	tune.Init()
-	err := logging.Init(getLoggingConfig())
+	logging_config := getLoggingConfig()
+	err := logging.Init(logging_config)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to initialize logging: %v", err))
 	}
-	log.Logger = zerolog.New(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}).With().Timestamp().Caller().Logger()
+	logs.Init(logging_config)
 
 	log.Info().Msg("Reading config...")
-	jsonFile, err := os.Open("config.json")
-	if err != nil {
-		log.Error().Msgf("Got error while reading config: %v", err)
+	var cfg config.Recommendation
+	if err := config.Load(&cfg); err != nil {
+		log.Panic().Msgf("Got error while reading config: %v", err)
 	}
+	tune.Serve(cfg.AdminPort)
 
-	defer jsonFile.Close()
-
-	byteValue, _ := ioutil.ReadAll(jsonFile)
-
-	var result map[string]string
-	json.Unmarshal([]byte(byteValue), &result)
-
-	log.Info().Msgf("Read database URL: %v", result["RecommendMongoAddress"])
+	log.Info().Msgf("Read database URL: %v", cfg.MongoAddress)
 	log.Info().Msg("Initializing DB connection...")
-	mongo_session := initializeDatabase(result["RecommendMongoAddress"])
+	mongo_session := initializeDatabase(cfg.MongoAddress)
 	defer mongo_session.Close()
 	log.Info().Msg("Successfull")
 
-	serv_port, _ := strconv.Atoi(result["RecommendPort"])
-	serv_ip := result["RecommendIP"]
+	log.Info().Msgf("Read reservation database URL: %v", cfg.ReserveMongoAddress)
+	log.Info().Msg("Initializing reservation DB connection...")
+	reserve_session, err := mgo.Dial(cfg.ReserveMongoAddress)
+	if err != nil {
+		log.Error().Msgf("Got error while connecting to reservation DB, personalization disabled: %v", err)
+	} else {
+		defer reserve_session.Close()
+		log.Info().Msg("Successfull")
+	}
+
+	serv_port := cfg.Port
+	serv_ip := cfg.IP
 
 	log.Info().Msgf("Read target port: %v", serv_port)
-	log.Info().Msgf("Read jaeger address: %v", result["jaegerAddress"])
+	log.Info().Msgf("Read jaeger address: %v", cfg.JaegerAddress)
+
+	log.Info().Msgf("Initializing tracer [service name: %v | endpoint: %v]...", "recommendation", cfg.JaegerAddress)
+	tracer, err := tracing.Init("recommendation", cfg.JaegerAddress)
+	if err != nil {
+		log.Panic().Msgf("Got error while initializing tracer: %v", err)
+	}
+	log.Info().Msg("Tracer initialized")
 
-	var (
-		// port       = flag.Int("port", 8085, "The server port")
-		jaegeraddr = flag.String("jaegeraddr", result["jaegerAddress"], "Jaeger server addr")
-	)
-	flag.Parse()
+	auth_key, err := auth.ParseKey(cfg.AuthKey)
+	if err != nil {
+		log.Panic().Msgf("Got error while reading auth key: %v", err)
+	}
 
-	log.Info().Msgf("Initializing jaeger agent [service name: %v | host: %v]...", "recommendation", *jaegeraddr)
-	tracer, err := tracing.Init("recommendation", *jaegeraddr)
+	transports, err := transport.Parse(cfg.GRPCServers, cfg.GRPCHops, cfg.Serializers)
 	if err != nil {
-		log.Panic().Msgf("Got error while initializing jaeger agent: %v", err)
+		log.Panic().Msgf("Got error while reading transports: %v", err)
 	}
-	log.Info().Msg("Jaeger agent initialized")
 
 	srv := &recommendation.Server{
 		Tracer: tracer,
 		// Port:     *port,
-		Port:         serv_port,
-		IpAddr:       serv_ip,
-		MongoSession: mongo_session,
+		Port:               serv_port,
+		IpAddr:             serv_ip,
+		MongoSession:       mongo_session,
+		AuthKey:            auth_key,
+		ReservationSession: reserve_session,
+		Transport:          transports,
 	}
 
 	log.Info().Msg("Starting server...")
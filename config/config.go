@@ -0,0 +1,235 @@
+// Package config loads the settings of the services. Every setting has a key
+// in config.json and is read, later sources overriding earlier ones, from
+//   - its default
+//   - the config file, config.json or the one named by -config
+//   - the env var named after its key, e.g. GEO_MONGO_ADDRESS for
+//     GeoMongoAddress
+//   - the flag named after its key, e.g. -geo-mongo-address
+//
+// Running a service with -print-config prints its settings and exits.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Common holds the settings of every service. Settings are tagged with their
+// json key, and optionally with a default, a flag name replacing the one
+// derived from the key, validate rules, required and port, and secret,
+// which hides them in the printed config.
+type Common struct {
+	JaegerAddress string `json:"jaegerAddress" flag:"jaegeraddr" validate:"required" usage:"Jaeger OTLP endpoint"`
+	AuthKey       string `json:"AuthKey" secret:"true" usage:"base64 key signing the caller of calls"`
+	GRPCServers   string `json:"GRPCServers" usage:"services serving gRPC"`
+	GRPCHops      string `json:"GRPCHops" usage:"caller.callee hops calling over gRPC"`
+	Serializers   string `json:"Serializers" usage:"aRPC serializers of services and hops"`
+	AdminPort     int    `json:"AdminPort" validate:"port" usage:"port of the tuning endpoint, 0 disables it"`
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// setting is a field of a service config.
+type setting struct {
+	field reflect.Value
+	key   string
+	tag   reflect.StructTag
+}
+
+func (s setting) env() string {
+	return strings.ToUpper(strings.Join(words(s.key), "_"))
+}
+
+func (s setting) flag() string {
+	if name := s.tag.Get("flag"); name != "" {
+		return name
+	}
+	return strings.ToLower(strings.Join(words(s.key), "-"))
+}
+
+// words splits a key like GRPCServers or jaegerAddress into its words.
+func words(key string) []string {
+	var out []string
+	r := []rune(key)
+	start := 0
+	for i := 1; i < len(r); i++ {
+		lowerBefore := unicode.IsLower(r[i-1]) || unicode.IsDigit(r[i-1])
+		acronymEnd := unicode.IsUpper(r[i-1]) && i+1 < len(r) && unicode.IsLower(r[i+1])
+		if unicode.IsUpper(r[i]) && (lowerBefore || acronymEnd) {
+			out = append(out, string(r[start:i]))
+			start = i
+		}
+	}
+	return append(out, string(r[start:]))
+}
+
+// settings returns the settings of cfg, a pointer to a service config.
+func settings(cfg any) []setting {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("config: %T is not a pointer to a struct", cfg))
+	}
+	var out []setting
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.Anonymous {
+				walk(v.Field(i))
+				continue
+			}
+			out = append(out, setting{field: v.Field(i), key: f.Tag.Get("json"), tag: f.Tag})
+		}
+	}
+	walk(v.Elem())
+	return out
+}
+
+// Load fills cfg, a pointer to the config of a service, from its sources and
+// validates it. With -print-config it prints cfg and exits.
+func Load(cfg any) error {
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	print, err := load(cfg, fs, os.Args[1:], os.LookupEnv)
+	if err != nil {
+		return err
+	}
+	if print {
+		os.Stdout.Write(append(Dump(cfg), '\n'))
+		os.Exit(0)
+	}
+	return nil
+}
+
+func load(cfg any, fs *flag.FlagSet, args []string, lookupEnv func(string) (string, bool)) (bool, error) {
+	settings := settings(cfg)
+	path := fs.String("config", "config.json", "config file")
+	print := fs.Bool("print-config", false, "print the config and exit")
+	flags := make(map[string]*string)
+	for _, s := range settings {
+		flags[s.key] = fs.String(s.flag(), "", fmt.Sprintf("%v (config %v, env %v)", s.tag.Get("usage"), s.key, s.env()))
+	}
+	if err := fs.Parse(args); err != nil {
+		return false, err
+	}
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+
+	file, err := readFile(*path)
+	if err != nil {
+		return false, err
+	}
+
+	for _, s := range settings {
+		val, source := s.tag.Get("default"), "default"
+		if v, ok := file[s.key]; ok {
+			val, source = v, *path
+		}
+		if v, ok := lookupEnv(s.env()); ok {
+			val, source = v, "env "+s.env()
+		}
+		if set[s.flag()] {
+			val, source = *flags[s.key], "flag -"+s.flag()
+		}
+		if err := s.set(val); err != nil {
+			return false, fmt.Errorf("invalid %v %q from %v: %v", s.key, val, source, err)
+		}
+	}
+	return *print, validate(settings)
+}
+
+// readFile reads the settings of a config file. Values may be strings, like
+// the ones of config.json, numbers or booleans.
+func readFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var raw map[string]any
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("reading config %v: %w", path, err)
+	}
+	out := make(map[string]string, len(raw))
+	for k, v := range raw {
+		switch v := v.(type) {
+		case string, json.Number, bool:
+			out[k] = fmt.Sprint(v)
+		default:
+			return nil, fmt.Errorf("reading config %v: %v is not a string or number", path, k)
+		}
+	}
+	return out, nil
+}
+
+func (s setting) set(val string) error {
+	switch {
+	case s.field.Type() == durationType:
+		if val == "" {
+			s.field.SetInt(0)
+			return nil
+		}
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			return err
+		}
+		s.field.SetInt(int64(d))
+	case s.field.Kind() == reflect.Int:
+		if val == "" {
+			s.field.SetInt(0)
+			return nil
+		}
+		n, err := strconv.Atoi(val)
+		if err != nil {
+			return fmt.Errorf("not a number")
+		}
+		s.field.SetInt(int64(n))
+	case s.field.Kind() == reflect.String:
+		s.field.SetString(val)
+	default:
+		panic(fmt.Sprintf("config: %v has unsupported type %v", s.key, s.field.Type()))
+	}
+	return nil
+}
+
+// validate checks the validate rules of settings: required settings must be
+// set, and ports must be valid port numbers or 0.
+func validate(settings []setting) error {
+	for _, s := range settings {
+		for _, rule := range strings.Split(s.tag.Get("validate"), ",") {
+			switch rule {
+			case "required":
+				if s.field.IsZero() {
+					return fmt.Errorf("%v is not set, set it in the config file, env %v or flag -%v", s.key, s.env(), s.flag())
+				}
+			case "port":
+				if port := s.field.Int(); port < 0 || port > 65535 {
+					return fmt.Errorf("%v %d is not a port, set it in the config file, env %v or flag -%v", s.key, port, s.env(), s.flag())
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Dump returns cfg in the format of the config file, with secrets hidden.
+func Dump(cfg any) []byte {
+	out := make(map[string]string)
+	for _, s := range settings(cfg) {
+		val := fmt.Sprint(s.field.Interface())
+		if s.tag.Get("secret") == "true" && !s.field.IsZero() {
+			val = "<hidden>"
+		}
+		out[s.key] = val
+	}
+	data, _ := json.MarshalIndent(out, "", "  ")
+	return data
+}
@@ -0,0 +1,130 @@
+package config
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func loadArgs(cfg any, env map[string]string, args ...string) (bool, error) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return load(cfg, fs, args, func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	})
+}
+
+func TestLayers(t *testing.T) {
+	path := writeConfig(t, `{
+		"jaegerAddress": "jaeger:4317",
+		"GeoPort": "11003",
+		"GeoMongoAddress": "mongodb-geo:27017",
+		"ProfilePort": 11001
+	}`)
+
+	var cfg Geo
+	if _, err := loadArgs(&cfg, nil, "-config", path); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Port != 11003 || cfg.MongoAddress != "mongodb-geo:27017" || cfg.JaegerAddress != "jaeger:4317" {
+		t.Errorf("file: got %+v", cfg)
+	}
+
+	env := map[string]string{"GEO_PORT": "12003", "GEO_MONGO_ADDRESS": "mongo:1"}
+	if _, err := loadArgs(&cfg, env, "-config", path, "-geo-mongo-address", "mongo:2", "-jaegeraddr", "otel:4317"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Port != 12003 || cfg.MongoAddress != "mongo:2" || cfg.JaegerAddress != "otel:4317" {
+		t.Errorf("env and flags: got %+v", cfg)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	path := writeConfig(t, `{"jaegerAddress": "jaeger:4317", "SessionTTL": "2h"}`)
+	var cfg Frontend
+	if _, err := loadArgs(&cfg, nil, "-config", path); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Port != 5000 || cfg.SessionTTL != 2*time.Hour {
+		t.Errorf("got %+v", cfg)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	valid := `{"jaegerAddress": "jaeger:4317", "UserMongoAddress": "mongodb-user:27017"}`
+	for _, tt := range []struct {
+		name, config string
+		env          map[string]string
+		want         string
+	}{
+		{"missing file", "", nil, "reading config"},
+		{"not a number", valid, map[string]string{"USER_PORT": "abc"}, `invalid UserPort "abc" from env USER_PORT`},
+		{"no port", valid, map[string]string{"USER_PORT": "0"}, "UserPort is not set"},
+		{"not a port", valid, map[string]string{"ADMIN_PORT": "70000"}, "AdminPort 70000 is not a port"},
+		{"required", `{"jaegerAddress": "jaeger:4317"}`, nil, "UserMongoAddress is not set"},
+		{"nested", `{"UserPort": {"port": 1}}`, nil, "UserPort is not a string"},
+	} {
+		path := filepath.Join(t.TempDir(), "missing.json")
+		if tt.config != "" {
+			path = writeConfig(t, tt.config)
+		}
+		var cfg User
+		_, err := loadArgs(&cfg, tt.env, "-config", path)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: got error %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestDump(t *testing.T) {
+	path := writeConfig(t, `{"jaegerAddress": "jaeger:4317", "AuthKey": "c2VjcmV0", "SessionTTL": "1h"}`)
+	var cfg Frontend
+	print, err := loadArgs(&cfg, nil, "-config", path, "-print-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !print {
+		t.Error("-print-config not reported")
+	}
+	dump := string(Dump(&cfg))
+	if strings.Contains(dump, "c2VjcmV0") {
+		t.Errorf("dump shows the auth key: %v", dump)
+	}
+
+	// the dump is a valid config file
+	var again Frontend
+	if _, err := loadArgs(&again, nil, "-config", writeConfig(t, dump)); err != nil {
+		t.Fatal(err)
+	}
+	if again.SessionTTL != time.Hour || again.Port != 5000 {
+		t.Errorf("reloaded dump: got %+v", again)
+	}
+}
+
+func TestWords(t *testing.T) {
+	for key, want := range map[string][]string{
+		"GRPCServers":        {"GRPC", "Servers"},
+		"jaegerAddress":      {"jaeger", "Address"},
+		"SessionTTL":         {"Session", "TTL"},
+		"ReserveMemcAddress": {"Reserve", "Memc", "Address"},
+	} {
+		if got := words(key); !slices.Equal(got, want) {
+			t.Errorf("words(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
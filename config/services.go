@@ -0,0 +1,74 @@
+package config
+
+import "time"
+
+// Frontend is the config of the frontend.
+type Frontend struct {
+	Common
+	Port              int           `json:"FrontendPort" default:"5000" validate:"required,port" usage:"HTTP port"`
+	IP                string        `json:"FrontendIP" usage:"address to listen on"`
+	KnativeDomainName string        `json:"KnativeDomainName" usage:"Knative domain of the services"`
+	SessionKeys       string        `json:"SessionKeys" secret:"true" usage:"base64 keys signing session tokens"`
+	SessionTTL        time.Duration `json:"SessionTTL" usage:"lifetime of session tokens"`
+}
+
+// Geo is the config of the geo service.
+type Geo struct {
+	Common
+	Port         int    `json:"GeoPort" default:"11003" validate:"required,port" usage:"aRPC port"`
+	IP           string `json:"GeoIP" usage:"address to listen on"`
+	MongoAddress string `json:"GeoMongoAddress" validate:"required" usage:"MongoDB address"`
+}
+
+// Profile is the config of the profile service.
+type Profile struct {
+	Common
+	Port         int    `json:"ProfilePort" default:"11001" validate:"required,port" usage:"aRPC port"`
+	IP           string `json:"ProfileIP" usage:"address to listen on"`
+	MongoAddress string `json:"ProfileMongoAddress" validate:"required" usage:"MongoDB address"`
+	MemcAddress  string `json:"ProfileMemcAddress" validate:"required" usage:"cache, memcached addresses, redis:// addresses or local://"`
+}
+
+// Rate is the config of the rate service.
+type Rate struct {
+	Common
+	Port         int    `json:"RatePort" default:"11004" validate:"required,port" usage:"aRPC port"`
+	IP           string `json:"RateIP" usage:"address to listen on"`
+	MongoAddress string `json:"RateMongoAddress" validate:"required" usage:"MongoDB address"`
+	MemcAddress  string `json:"RateMemcAddress" validate:"required" usage:"cache, memcached addresses, redis:// addresses or local://"`
+}
+
+// Recommendation is the config of the recommendation service.
+type Recommendation struct {
+	Common
+	Port                int    `json:"RecommendPort" default:"11005" validate:"required,port" usage:"aRPC port"`
+	IP                  string `json:"RecommendIP" usage:"address to listen on"`
+	MongoAddress        string `json:"RecommendMongoAddress" validate:"required" usage:"MongoDB address"`
+	ReserveMongoAddress string `json:"ReserveMongoAddress" usage:"MongoDB address of reservations, for personalization"`
+}
+
+// Reservation is the config of the reservation service.
+type Reservation struct {
+	Common
+	Port         int    `json:"ReservePort" default:"11007" validate:"required,port" usage:"aRPC port"`
+	IP           string `json:"ReserveIP" usage:"address to listen on"`
+	MongoAddress string `json:"ReserveMongoAddress" validate:"required" usage:"MongoDB address"`
+	MemcAddress  string `json:"ReserveMemcAddress" validate:"required" usage:"cache, memcached addresses, redis:// addresses or local://"`
+}
+
+// Search is the config of the search service.
+type Search struct {
+	Common
+	Port              int    `json:"SearchPort" default:"11002" validate:"required,port" usage:"aRPC port"`
+	IP                string `json:"SearchIP" usage:"address to listen on"`
+	KnativeDomainName string `json:"KnativeDomainName" usage:"Knative domain of the services"`
+}
+
+// User is the config of the user service.
+type User struct {
+	Common
+	Port         int    `json:"UserPort" default:"11006" validate:"required,port" usage:"aRPC port"`
+	IP           string `json:"UserIP" usage:"address to listen on"`
+	MongoAddress string `json:"UserMongoAddress" validate:"required" usage:"MongoDB address"`
+	BcryptCost   int    `json:"BcryptCost" default:"10" usage:"bcrypt cost of new password hashes, 4 to 31"`
+}
@@ -0,0 +1,66 @@
+package logs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
+	"github.com/rs/zerolog/log"
+)
+
+// geoServer logs each call and records the request ID it was handled with.
+type geoServer struct {
+	ids chan string
+}
+
+func (g geoServer) NearbyGeo(ctx context.Context, req *pb.NearbyRequest) (*pb.NearbyResult, context.Context, error) {
+	log.Ctx(ctx).Info().Msg("handled")
+	g.ids <- RequestID(ctx)
+	return &pb.NearbyResult{}, ctx, nil
+}
+
+func (g geoServer) SetLocation(ctx context.Context, req *pb.SetLocationRequest) (*pb.SetLocationResult, context.Context, error) {
+	return &pb.SetLocationResult{}, ctx, nil
+}
+
+func TestRequestIDOverARPC(t *testing.T) {
+	buf := capture(t)
+	var c *transport.Config
+	server, err := c.NewServer("geo", "127.0.0.1:0", ServerElements())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := geoServer{ids: make(chan string, 1)}
+	pb.RegisterGeoServer(server, srv)
+	// the aRPC server cannot be stopped, it serves until the test binary exits
+	go server.Start()
+	client, err := c.NewClient("search", "geo", server.GetTransport().LocalAddr().String(), ClientElements())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	geo := pb.NewGeoClient(client)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := geo.NearbyGeo(WithRequestID(context.Background(), "abc"), &pb.NearbyRequest{})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("NearbyGeo: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("call timed out")
+	}
+
+	if got := <-srv.ids; got != "abc" {
+		t.Errorf("handler request ID %q, want abc", got)
+	}
+	if event := decode(t, buf); event["request_id"] != "abc" {
+		t.Errorf("event %v has no request ID abc", event)
+	}
+}
@@ -0,0 +1,64 @@
+package logs
+
+import (
+	"context"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+)
+
+// ClientElement adds the request ID of the context of outgoing calls to their
+// metadata.
+type ClientElement struct{}
+
+// ServerElement gives the context of incoming calls the request ID in their
+// metadata, or a new one, so handlers log it with log.Ctx(ctx).
+type ServerElement struct{}
+
+// ClientElements returns the client elements passing request IDs on.
+func ClientElements() []element.RPCElement {
+	return []element.RPCElement{ClientElement{}}
+}
+
+// ServerElements returns the server elements taking request IDs in. They go
+// after the tracing elements, so the loggers add the trace ID too.
+func ServerElements() []element.RPCElement {
+	return []element.RPCElement{ServerElement{}}
+}
+
+// ProcessRequest adds the request ID to the outgoing metadata.
+func (ClientElement) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	if id := RequestID(ctx); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, id)
+	}
+	return req, ctx, nil
+}
+
+// ProcessResponse passes responses through.
+func (ClientElement) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	return resp, ctx, nil
+}
+
+// Name returns the name of the element.
+func (ClientElement) Name() string {
+	return "logs-client"
+}
+
+// ProcessRequest takes the request ID from the incoming metadata.
+func (ServerElement) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	id := metadata.FromIncomingContext(ctx)[MetadataKey]
+	if id == "" {
+		id = NewRequestID()
+	}
+	return req, WithRequestID(ctx, id), nil
+}
+
+// ProcessResponse passes responses through.
+func (ServerElement) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	return resp, ctx, nil
+}
+
+// Name returns the name of the element.
+func (ServerElement) Name() string {
+	return "logs-server"
+}
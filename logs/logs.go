@@ -0,0 +1,34 @@
+// Package logs sets up the zerolog logger of the services and correlates
+// their log lines: every request gets an ID at the frontend, which travels
+// with the calls it causes, and the loggers of their contexts add it, with
+// the trace ID, to every event.
+package logs
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/appnet-org/arpc/pkg/logging"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func init() {
+	// log.Ctx of contexts without a logger logs with the global one
+	zerolog.DefaultContextLogger = &log.Logger
+}
+
+// Init sets up the global logger in the format of the aRPC logger of cfg:
+// JSON lines with format json, colored lines otherwise. tune.Init sets the
+// level.
+func Init(cfg *logging.Config) {
+	log.Logger = newLogger(cfg.Format, os.Stdout)
+}
+
+func newLogger(format string, w io.Writer) zerolog.Logger {
+	if format != "json" {
+		w = zerolog.ConsoleWriter{Out: w, TimeFormat: time.RFC3339}
+	}
+	return zerolog.New(w).With().Timestamp().Caller().Logger()
+}
@@ -0,0 +1,108 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	"github.com/rs/zerolog/log"
+)
+
+// capture makes the global logger write JSON to the returned buffer.
+func capture(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	logger := log.Logger
+	log.Logger = newLogger("json", &buf)
+	t.Cleanup(func() { log.Logger = logger })
+	return &buf
+}
+
+func decode(t *testing.T, buf *bytes.Buffer) map[string]any {
+	t.Helper()
+	var event map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &event); err != nil {
+		t.Fatalf("log line %q is not JSON: %v", buf.String(), err)
+	}
+	return event
+}
+
+func TestJSONFormat(t *testing.T) {
+	var buf bytes.Buffer
+	logger := newLogger("json", &buf)
+	logger.Info().Msg("hello")
+	event := decode(t, &buf)
+	if event["message"] != "hello" || event["level"] != "info" {
+		t.Errorf("got %v", event)
+	}
+}
+
+func TestRequestIDAcrossCall(t *testing.T) {
+	buf := capture(t)
+	client := element.NewRPCElementChain(ClientElements()...)
+	server := element.NewRPCElementChain(ServerElements()...)
+
+	ctx := WithRequestID(context.Background(), "abc")
+	req, cctx, err := client.ProcessRequest(ctx, &element.RPCRequest{ServiceName: "Geo", Method: "NearbyGeo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sctx := metadata.NewIncomingContext(context.Background(), metadata.FromOutgoingContext(cctx))
+	_, sctx, err = server.ProcessRequest(sctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := RequestID(sctx); got != "abc" {
+		t.Errorf("request ID %q, want abc", got)
+	}
+	log.Ctx(sctx).Info().Msg("handled")
+	if event := decode(t, buf); event["request_id"] != "abc" {
+		t.Errorf("event %v has no request ID abc", event)
+	}
+}
+
+func TestServerNewRequestID(t *testing.T) {
+	server := element.NewRPCElementChain(ServerElements()...)
+	_, ctx, err := server.ProcessRequest(context.Background(), &element.RPCRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if RequestID(ctx) == "" {
+		t.Error("call without request ID got none")
+	}
+}
+
+func TestNoContextLogger(t *testing.T) {
+	buf := capture(t)
+	log.Ctx(context.Background()).Info().Msg("no request")
+	if buf.Len() == 0 {
+		t.Error("contexts without logger do not log")
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var id string
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id = RequestID(r.Context())
+	}))
+
+	r := httptest.NewRequest("GET", "/hotels", nil)
+	r.Header.Set(Header, "from-client")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if id != "from-client" || w.Header().Get(Header) != "from-client" {
+		t.Errorf("got request ID %q, response header %q, want from-client", id, w.Header().Get(Header))
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/hotels", nil))
+	if id == "" || w.Header().Get(Header) != id {
+		t.Errorf("got request ID %q, response header %q", id, w.Header().Get(Header))
+	}
+}
@@ -0,0 +1,61 @@
+package logs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// Header is the HTTP header carrying the request ID.
+	Header = "X-Request-ID"
+	// MetadataKey is the call metadata key carrying the request ID.
+	MetadataKey = "x-request-id"
+)
+
+type requestIDKey struct{}
+
+// NewRequestID returns a random request ID.
+func NewRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// RequestID returns the request ID of ctx, or "" if it has none.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// WithRequestID returns a copy of ctx with request ID id, whose logger adds
+// id and the trace ID of the span of ctx to every event.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	c := log.Ctx(ctx).With().Str("request_id", id)
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		// the OpenTelemetry bridge exposes the trace ID, other tracers do not
+		if sc, ok := span.Context().(interface{ TraceID() trace.TraceID }); ok && sc.TraceID().IsValid() {
+			c = c.Str("trace_id", sc.TraceID().String())
+		}
+	}
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+	return c.Logger().WithContext(ctx)
+}
+
+// Middleware gives every request the ID in its X-Request-ID header, or a new
+// one, and echoes it in the response.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(Header)
+		if id == "" || len(id) > 128 {
+			id = NewRequestID()
+		}
+		w.Header().Set(Header, id)
+		next.ServeHTTP(w, r.WithContext(WithRequestID(r.Context(), id)))
+	})
+}
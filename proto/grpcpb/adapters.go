@@ -0,0 +1,392 @@
+// Package grpcpb serves and calls the hotel reservation services over gRPC.
+//
+// hotel_reservation_grpc.pb.go is generated by protoc-gen-go-grpc from
+// ../hotel_reservation.proto, with the services split from the messages so
+// they do not clash with the aRPC stubs of package proto. The adapters in this
+// file run the aRPC handlers behind gRPC and return gRPC clients as the aRPC
+// client interfaces, so services pick the transport without other changes.
+package grpcpb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// unary calls an aRPC handler, dropping the context it returns.
+func unary[Req, Res any](ctx context.Context, req Req, handler func(context.Context, Req) (Res, context.Context, error)) (Res, error) {
+	res, _, err := handler(ctx, req)
+	return res, err
+}
+
+// outgoing sends the aRPC metadata of ctx as gRPC metadata.
+func outgoing(ctx context.Context) context.Context {
+	md := metadata.FromOutgoingContext(ctx)
+	if len(md) == 0 {
+		return ctx
+	}
+	return grpcmd.NewOutgoingContext(ctx, grpcmd.New(md))
+}
+
+// incoming exposes the gRPC metadata of ctx as aRPC metadata.
+func incoming(ctx context.Context) context.Context {
+	in, _ := grpcmd.FromIncomingContext(ctx)
+	md := metadata.New(map[string]string{})
+	for k, vs := range in {
+		if len(vs) > 0 {
+			md.Set(k, vs[0])
+		}
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// UnaryInterceptor makes the aRPC metadata of calls available to handlers and
+// runs elements on the calls, the way the aRPC server does.
+func UnaryInterceptor(elements []element.RPCElement) grpc.UnaryServerInterceptor {
+	chain := element.NewRPCElementChain(elements...)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = incoming(ctx)
+
+		service, method := splitMethod(info.FullMethod)
+		rpcReq, ctx, err := chain.ProcessRequest(ctx, &element.RPCRequest{ServiceName: service, Method: method, Payload: req})
+		if err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		res, err := handler(ctx, rpcReq.Payload)
+
+		rpcRes, _, perr := chain.ProcessResponse(ctx, &element.RPCResponse{Result: res, Error: err})
+		if perr != nil {
+			return nil, status.Error(codes.Internal, perr.Error())
+		}
+		return rpcRes.Result, rpcRes.Error
+	}
+}
+
+// UnaryClientInterceptor runs elements on outgoing calls the way the aRPC
+// client does, sending the aRPC metadata they add along.
+func UnaryClientInterceptor(elements []element.RPCElement) grpc.UnaryClientInterceptor {
+	chain := element.NewRPCElementChain(elements...)
+	return func(ctx context.Context, fullMethod string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		service, method := splitMethod(fullMethod)
+		rpcReq, ctx, err := chain.ProcessRequest(ctx, &element.RPCRequest{ServiceName: service, Method: method, Payload: req})
+		if err != nil {
+			return err
+		}
+
+		err = invoker(outgoing(ctx), fullMethod, rpcReq.Payload, reply, cc, opts...)
+
+		rpcRes, _, perr := chain.ProcessResponse(ctx, &element.RPCResponse{Result: reply, Error: err})
+		if perr != nil {
+			return perr
+		}
+		return rpcRes.Error
+	}
+}
+
+// splitMethod returns the service and method of a full gRPC method like
+// /hotel_reservation.Geo/NearbyGeo.
+func splitMethod(fullMethod string) (service, method string) {
+	service, method, _ = strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return service[strings.LastIndex(service, ".")+1:], method
+}
+
+// RegisterGeo serves the aRPC handlers of srv over gRPC.
+func RegisterGeo(s grpc.ServiceRegistrar, srv pb.GeoServer) {
+	RegisterGeoServer(s, geoHandler{srv: srv})
+}
+
+// NewGeo returns a client calling the Geo service over gRPC.
+func NewGeo(cc grpc.ClientConnInterface) pb.GeoClient {
+	return geoStub{c: NewGeoClient(cc)}
+}
+
+type geoHandler struct {
+	UnimplementedGeoServer
+	srv pb.GeoServer
+}
+
+func (h geoHandler) NearbyGeo(ctx context.Context, req *pb.NearbyRequest) (*pb.NearbyResult, error) {
+	return unary(ctx, req, h.srv.NearbyGeo)
+}
+
+func (h geoHandler) SetLocation(ctx context.Context, req *pb.SetLocationRequest) (*pb.SetLocationResult, error) {
+	return unary(ctx, req, h.srv.SetLocation)
+}
+
+type geoStub struct {
+	c GeoClient
+}
+
+func (s geoStub) NearbyGeo(ctx context.Context, req *pb.NearbyRequest) (*pb.NearbyResult, error) {
+	return s.c.NearbyGeo(outgoing(ctx), req)
+}
+
+func (s geoStub) SetLocation(ctx context.Context, req *pb.SetLocationRequest) (*pb.SetLocationResult, error) {
+	return s.c.SetLocation(outgoing(ctx), req)
+}
+
+// RegisterProfile serves the aRPC handlers of srv over gRPC.
+func RegisterProfile(s grpc.ServiceRegistrar, srv pb.ProfileServer) {
+	RegisterProfileServer(s, profileHandler{srv: srv})
+}
+
+// NewProfile returns a client calling the Profile service over gRPC.
+func NewProfile(cc grpc.ClientConnInterface) pb.ProfileClient {
+	return profileStub{c: NewProfileClient(cc)}
+}
+
+type profileHandler struct {
+	UnimplementedProfileServer
+	srv pb.ProfileServer
+}
+
+func (h profileHandler) GetProfiles(ctx context.Context, req *pb.GetProfilesRequest) (*pb.GetProfilesResult, error) {
+	return unary(ctx, req, h.srv.GetProfiles)
+}
+
+func (h profileHandler) CreateHotel(ctx context.Context, req *pb.HotelRequest) (*pb.HotelResult, error) {
+	return unary(ctx, req, h.srv.CreateHotel)
+}
+
+func (h profileHandler) UpdateHotel(ctx context.Context, req *pb.HotelRequest) (*pb.HotelResult, error) {
+	return unary(ctx, req, h.srv.UpdateHotel)
+}
+
+type profileStub struct {
+	c ProfileClient
+}
+
+func (s profileStub) GetProfiles(ctx context.Context, req *pb.GetProfilesRequest) (*pb.GetProfilesResult, error) {
+	return s.c.GetProfiles(outgoing(ctx), req)
+}
+
+func (s profileStub) CreateHotel(ctx context.Context, req *pb.HotelRequest) (*pb.HotelResult, error) {
+	return s.c.CreateHotel(outgoing(ctx), req)
+}
+
+func (s profileStub) UpdateHotel(ctx context.Context, req *pb.HotelRequest) (*pb.HotelResult, error) {
+	return s.c.UpdateHotel(outgoing(ctx), req)
+}
+
+// RegisterRecommendation serves the aRPC handlers of srv over gRPC.
+func RegisterRecommendation(s grpc.ServiceRegistrar, srv pb.RecommendationServer) {
+	RegisterRecommendationServer(s, recommendationHandler{srv: srv})
+}
+
+// NewRecommendation returns a client calling the Recommendation service over gRPC.
+func NewRecommendation(cc grpc.ClientConnInterface) pb.RecommendationClient {
+	return recommendationStub{c: NewRecommendationClient(cc)}
+}
+
+type recommendationHandler struct {
+	UnimplementedRecommendationServer
+	srv pb.RecommendationServer
+}
+
+func (h recommendationHandler) GetRecommendations(ctx context.Context, req *pb.GetRecommendationsRequest) (*pb.GetRecommendationsResult, error) {
+	return unary(ctx, req, h.srv.GetRecommendations)
+}
+
+func (h recommendationHandler) SetHotel(ctx context.Context, req *pb.SetHotelRequest) (*pb.SetHotelResult, error) {
+	return unary(ctx, req, h.srv.SetHotel)
+}
+
+func (h recommendationHandler) ReloadHotels(ctx context.Context, req *pb.ReloadHotelsRequest) (*pb.ReloadHotelsResult, error) {
+	return unary(ctx, req, h.srv.ReloadHotels)
+}
+
+type recommendationStub struct {
+	c RecommendationClient
+}
+
+func (s recommendationStub) GetRecommendations(ctx context.Context, req *pb.GetRecommendationsRequest) (*pb.GetRecommendationsResult, error) {
+	return s.c.GetRecommendations(outgoing(ctx), req)
+}
+
+func (s recommendationStub) SetHotel(ctx context.Context, req *pb.SetHotelRequest) (*pb.SetHotelResult, error) {
+	return s.c.SetHotel(outgoing(ctx), req)
+}
+
+func (s recommendationStub) ReloadHotels(ctx context.Context, req *pb.ReloadHotelsRequest) (*pb.ReloadHotelsResult, error) {
+	return s.c.ReloadHotels(outgoing(ctx), req)
+}
+
+// RegisterRate serves the aRPC handlers of srv over gRPC.
+func RegisterRate(s grpc.ServiceRegistrar, srv pb.RateServer) {
+	RegisterRateServer(s, rateHandler{srv: srv})
+}
+
+// NewRate returns a client calling the Rate service over gRPC.
+func NewRate(cc grpc.ClientConnInterface) pb.RateClient {
+	return rateStub{c: NewRateClient(cc)}
+}
+
+type rateHandler struct {
+	UnimplementedRateServer
+	srv pb.RateServer
+}
+
+func (h rateHandler) GetRates(ctx context.Context, req *pb.GetRatesRequest) (*pb.GetRatesResult, error) {
+	return unary(ctx, req, h.srv.GetRates)
+}
+
+func (h rateHandler) SetRatePlans(ctx context.Context, req *pb.SetRatePlansRequest) (*pb.SetRatePlansResult, error) {
+	return unary(ctx, req, h.srv.SetRatePlans)
+}
+
+type rateStub struct {
+	c RateClient
+}
+
+func (s rateStub) GetRates(ctx context.Context, req *pb.GetRatesRequest) (*pb.GetRatesResult, error) {
+	return s.c.GetRates(outgoing(ctx), req)
+}
+
+func (s rateStub) SetRatePlans(ctx context.Context, req *pb.SetRatePlansRequest) (*pb.SetRatePlansResult, error) {
+	return s.c.SetRatePlans(outgoing(ctx), req)
+}
+
+// RegisterReservation serves the aRPC handlers of srv over gRPC.
+func RegisterReservation(s grpc.ServiceRegistrar, srv pb.ReservationServer) {
+	RegisterReservationServer(s, reservationHandler{srv: srv})
+}
+
+// NewReservation returns a client calling the Reservation service over gRPC.
+func NewReservation(cc grpc.ClientConnInterface) pb.ReservationClient {
+	return reservationStub{c: NewReservationClient(cc)}
+}
+
+type reservationHandler struct {
+	UnimplementedReservationServer
+	srv pb.ReservationServer
+}
+
+func (h reservationHandler) MakeReservation(ctx context.Context, req *pb.ReservationRequest) (*pb.ReservationResult, error) {
+	return unary(ctx, req, h.srv.MakeReservation)
+}
+
+func (h reservationHandler) CheckAvailability(ctx context.Context, req *pb.ReservationRequest) (*pb.ReservationResult, error) {
+	return unary(ctx, req, h.srv.CheckAvailability)
+}
+
+func (h reservationHandler) SetCapacity(ctx context.Context, req *pb.SetCapacityRequest) (*pb.SetCapacityResult, error) {
+	return unary(ctx, req, h.srv.SetCapacity)
+}
+
+type reservationStub struct {
+	c ReservationClient
+}
+
+func (s reservationStub) MakeReservation(ctx context.Context, req *pb.ReservationRequest) (*pb.ReservationResult, error) {
+	return s.c.MakeReservation(outgoing(ctx), req)
+}
+
+func (s reservationStub) CheckAvailability(ctx context.Context, req *pb.ReservationRequest) (*pb.ReservationResult, error) {
+	return s.c.CheckAvailability(outgoing(ctx), req)
+}
+
+func (s reservationStub) SetCapacity(ctx context.Context, req *pb.SetCapacityRequest) (*pb.SetCapacityResult, error) {
+	return s.c.SetCapacity(outgoing(ctx), req)
+}
+
+// RegisterSearch serves the aRPC handlers of srv over gRPC.
+func RegisterSearch(s grpc.ServiceRegistrar, srv pb.SearchServer) {
+	RegisterSearchServer(s, searchHandler{srv: srv})
+}
+
+// NewSearch returns a client calling the Search service over gRPC.
+func NewSearch(cc grpc.ClientConnInterface) pb.SearchClient {
+	return searchStub{c: NewSearchClient(cc)}
+}
+
+type searchHandler struct {
+	UnimplementedSearchServer
+	srv pb.SearchServer
+}
+
+func (h searchHandler) Nearby(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResult, error) {
+	return unary(ctx, req, h.srv.Nearby)
+}
+
+type searchStub struct {
+	c SearchClient
+}
+
+func (s searchStub) Nearby(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResult, error) {
+	return s.c.Nearby(outgoing(ctx), req)
+}
+
+// RegisterUser serves the aRPC handlers of srv over gRPC.
+func RegisterUser(s grpc.ServiceRegistrar, srv pb.UserServer) {
+	RegisterUserServer(s, userHandler{srv: srv})
+}
+
+// NewUser returns a client calling the User service over gRPC.
+func NewUser(cc grpc.ClientConnInterface) pb.UserClient {
+	return userStub{c: NewUserClient(cc)}
+}
+
+type userHandler struct {
+	UnimplementedUserServer
+	srv pb.UserServer
+}
+
+func (h userHandler) CheckUser(ctx context.Context, req *pb.CheckUserRequest) (*pb.CheckUserResult, error) {
+	return unary(ctx, req, h.srv.CheckUser)
+}
+
+func (h userHandler) RegisterUser(ctx context.Context, req *pb.RegisterUserRequest) (*pb.RegisterUserResult, error) {
+	return unary(ctx, req, h.srv.RegisterUser)
+}
+
+func (h userHandler) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResult, error) {
+	return unary(ctx, req, h.srv.ChangePassword)
+}
+
+func (h userHandler) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResult, error) {
+	return unary(ctx, req, h.srv.DeleteUser)
+}
+
+func (h userHandler) ReloadUsers(ctx context.Context, req *pb.ReloadUsersRequest) (*pb.ReloadUsersResult, error) {
+	return unary(ctx, req, h.srv.ReloadUsers)
+}
+
+func (h userHandler) CheckSession(ctx context.Context, req *pb.CheckSessionRequest) (*pb.CheckSessionResult, error) {
+	return unary(ctx, req, h.srv.CheckSession)
+}
+
+type userStub struct {
+	c UserClient
+}
+
+func (s userStub) CheckUser(ctx context.Context, req *pb.CheckUserRequest) (*pb.CheckUserResult, error) {
+	return s.c.CheckUser(outgoing(ctx), req)
+}
+
+func (s userStub) RegisterUser(ctx context.Context, req *pb.RegisterUserRequest) (*pb.RegisterUserResult, error) {
+	return s.c.RegisterUser(outgoing(ctx), req)
+}
+
+func (s userStub) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResult, error) {
+	return s.c.ChangePassword(outgoing(ctx), req)
+}
+
+func (s userStub) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResult, error) {
+	return s.c.DeleteUser(outgoing(ctx), req)
+}
+
+func (s userStub) ReloadUsers(ctx context.Context, req *pb.ReloadUsersRequest) (*pb.ReloadUsersResult, error) {
+	return s.c.ReloadUsers(outgoing(ctx), req)
+}
+
+func (s userStub) CheckSession(ctx context.Context, req *pb.CheckSessionRequest) (*pb.CheckSessionResult, error) {
+	return s.c.CheckSession(outgoing(ctx), req)
+}
@@ -0,0 +1,110 @@
+package grpcpb
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// geoHandlers answers with the caller found in the aRPC metadata.
+type geoHandlers struct{}
+
+func (geoHandlers) NearbyGeo(ctx context.Context, req *pb.NearbyRequest) (*pb.NearbyResult, context.Context, error) {
+	return &pb.NearbyResult{HotelIds: []string{metadata.FromIncomingContext(ctx).Get("username")}}, ctx, nil
+}
+
+func (geoHandlers) SetLocation(ctx context.Context, req *pb.SetLocationRequest) (*pb.SetLocationResult, context.Context, error) {
+	return &pb.SetLocationResult{}, ctx, nil
+}
+
+// denySet rejects SetLocation.
+type denySet struct{}
+
+func (denySet) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	if req.ServiceName == "Geo" && req.Method == "SetLocation" {
+		return nil, ctx, errors.New("denied")
+	}
+	return req, ctx, nil
+}
+
+func (denySet) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	return resp, ctx, nil
+}
+
+func (denySet) Name() string { return "deny-set" }
+
+// addCaller adds a caller to the metadata of outgoing calls.
+type addCaller struct{}
+
+func (addCaller) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	return req, metadata.AppendToOutgoingContext(ctx, "username", "Cornell_2"), nil
+}
+
+func (addCaller) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	return resp, ctx, nil
+}
+
+func (addCaller) Name() string { return "add-caller" }
+
+func newGeo(t *testing.T, clientElements ...element.RPCElement) pb.GeoClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.UnaryInterceptor(UnaryInterceptor([]element.RPCElement{denySet{}})))
+	RegisterGeo(srv, geoHandlers{})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor(clientElements)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewGeo(conn)
+}
+
+func TestMetadata(t *testing.T) {
+	geo := newGeo(t)
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.New(map[string]string{"username": "Cornell_1"}))
+
+	res, err := geo.NearbyGeo(ctx, &pb.NearbyRequest{Lat: 37.7, Lon: -122.4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.HotelIds) != 1 || res.HotelIds[0] != "Cornell_1" {
+		t.Errorf("handler saw username %v, want Cornell_1", res.HotelIds)
+	}
+}
+
+func TestElements(t *testing.T) {
+	geo := newGeo(t)
+
+	_, err := geo.SetLocation(context.Background(), &pb.SetLocationRequest{HotelId: "1"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("SetLocation error = %v, want PermissionDenied", err)
+	}
+}
+
+func TestClientElements(t *testing.T) {
+	geo := newGeo(t, addCaller{})
+
+	res, err := geo.NearbyGeo(context.Background(), &pb.NearbyRequest{Lat: 37.7, Lon: -122.4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.HotelIds) != 1 || res.HotelIds[0] != "Cornell_2" {
+		t.Errorf("handler saw username %v, want Cornell_2", res.HotelIds)
+	}
+}
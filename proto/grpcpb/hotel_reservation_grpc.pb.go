@@ -0,0 +1,1270 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: hotel_reservation.proto
+
+package grpcpb
+
+import (
+	context "context"
+	proto "github.com/appnetorg/hotel-reservation-arpc/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Geo_NearbyGeo_FullMethodName   = "/hotel_reservation.Geo/NearbyGeo"
+	Geo_SetLocation_FullMethodName = "/hotel_reservation.Geo/SetLocation"
+)
+
+// GeoClient is the client API for Geo service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GeoClient interface {
+	// Finds the hotels contained nearby the current lat/lon.
+	NearbyGeo(ctx context.Context, in *proto.NearbyRequest, opts ...grpc.CallOption) (*proto.NearbyResult, error)
+	// SetLocation adds or moves a hotel in the geo index.
+	SetLocation(ctx context.Context, in *proto.SetLocationRequest, opts ...grpc.CallOption) (*proto.SetLocationResult, error)
+}
+
+type geoClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGeoClient(cc grpc.ClientConnInterface) GeoClient {
+	return &geoClient{cc}
+}
+
+func (c *geoClient) NearbyGeo(ctx context.Context, in *proto.NearbyRequest, opts ...grpc.CallOption) (*proto.NearbyResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.NearbyResult)
+	err := c.cc.Invoke(ctx, Geo_NearbyGeo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoClient) SetLocation(ctx context.Context, in *proto.SetLocationRequest, opts ...grpc.CallOption) (*proto.SetLocationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.SetLocationResult)
+	err := c.cc.Invoke(ctx, Geo_SetLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GeoServer is the server API for Geo service.
+// All implementations must embed UnimplementedGeoServer
+// for forward compatibility.
+type GeoServer interface {
+	// Finds the hotels contained nearby the current lat/lon.
+	NearbyGeo(context.Context, *proto.NearbyRequest) (*proto.NearbyResult, error)
+	// SetLocation adds or moves a hotel in the geo index.
+	SetLocation(context.Context, *proto.SetLocationRequest) (*proto.SetLocationResult, error)
+	mustEmbedUnimplementedGeoServer()
+}
+
+// UnimplementedGeoServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGeoServer struct{}
+
+func (UnimplementedGeoServer) NearbyGeo(context.Context, *proto.NearbyRequest) (*proto.NearbyResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NearbyGeo not implemented")
+}
+func (UnimplementedGeoServer) SetLocation(context.Context, *proto.SetLocationRequest) (*proto.SetLocationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLocation not implemented")
+}
+func (UnimplementedGeoServer) mustEmbedUnimplementedGeoServer() {}
+func (UnimplementedGeoServer) testEmbeddedByValue()             {}
+
+// UnsafeGeoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GeoServer will
+// result in compilation errors.
+type UnsafeGeoServer interface {
+	mustEmbedUnimplementedGeoServer()
+}
+
+func RegisterGeoServer(s grpc.ServiceRegistrar, srv GeoServer) {
+	// If the following call pancis, it indicates UnimplementedGeoServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Geo_ServiceDesc, srv)
+}
+
+func _Geo_NearbyGeo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.NearbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).NearbyGeo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geo_NearbyGeo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).NearbyGeo(ctx, req.(*proto.NearbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geo_SetLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.SetLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).SetLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geo_SetLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).SetLocation(ctx, req.(*proto.SetLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Geo_ServiceDesc is the grpc.ServiceDesc for Geo service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Geo_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel_reservation.Geo",
+	HandlerType: (*GeoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NearbyGeo",
+			Handler:    _Geo_NearbyGeo_Handler,
+		},
+		{
+			MethodName: "SetLocation",
+			Handler:    _Geo_SetLocation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel_reservation.proto",
+}
+
+const (
+	Profile_GetProfiles_FullMethodName = "/hotel_reservation.Profile/GetProfiles"
+	Profile_CreateHotel_FullMethodName = "/hotel_reservation.Profile/CreateHotel"
+	Profile_UpdateHotel_FullMethodName = "/hotel_reservation.Profile/UpdateHotel"
+)
+
+// ProfileClient is the client API for Profile service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProfileClient interface {
+	GetProfiles(ctx context.Context, in *proto.GetProfilesRequest, opts ...grpc.CallOption) (*proto.GetProfilesResult, error)
+	// CreateHotel stores the profile of a new hotel and onboards it in the
+	// geo, rate, recommendation and reservation services.
+	CreateHotel(ctx context.Context, in *proto.HotelRequest, opts ...grpc.CallOption) (*proto.HotelResult, error)
+	// UpdateHotel replaces the profile of an existing hotel and its data in
+	// the geo, rate, recommendation and reservation services.
+	UpdateHotel(ctx context.Context, in *proto.HotelRequest, opts ...grpc.CallOption) (*proto.HotelResult, error)
+}
+
+type profileClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProfileClient(cc grpc.ClientConnInterface) ProfileClient {
+	return &profileClient{cc}
+}
+
+func (c *profileClient) GetProfiles(ctx context.Context, in *proto.GetProfilesRequest, opts ...grpc.CallOption) (*proto.GetProfilesResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.GetProfilesResult)
+	err := c.cc.Invoke(ctx, Profile_GetProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) CreateHotel(ctx context.Context, in *proto.HotelRequest, opts ...grpc.CallOption) (*proto.HotelResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.HotelResult)
+	err := c.cc.Invoke(ctx, Profile_CreateHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) UpdateHotel(ctx context.Context, in *proto.HotelRequest, opts ...grpc.CallOption) (*proto.HotelResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.HotelResult)
+	err := c.cc.Invoke(ctx, Profile_UpdateHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfileServer is the server API for Profile service.
+// All implementations must embed UnimplementedProfileServer
+// for forward compatibility.
+type ProfileServer interface {
+	GetProfiles(context.Context, *proto.GetProfilesRequest) (*proto.GetProfilesResult, error)
+	// CreateHotel stores the profile of a new hotel and onboards it in the
+	// geo, rate, recommendation and reservation services.
+	CreateHotel(context.Context, *proto.HotelRequest) (*proto.HotelResult, error)
+	// UpdateHotel replaces the profile of an existing hotel and its data in
+	// the geo, rate, recommendation and reservation services.
+	UpdateHotel(context.Context, *proto.HotelRequest) (*proto.HotelResult, error)
+	mustEmbedUnimplementedProfileServer()
+}
+
+// UnimplementedProfileServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProfileServer struct{}
+
+func (UnimplementedProfileServer) GetProfiles(context.Context, *proto.GetProfilesRequest) (*proto.GetProfilesResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfiles not implemented")
+}
+func (UnimplementedProfileServer) CreateHotel(context.Context, *proto.HotelRequest) (*proto.HotelResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHotel not implemented")
+}
+func (UnimplementedProfileServer) UpdateHotel(context.Context, *proto.HotelRequest) (*proto.HotelResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHotel not implemented")
+}
+func (UnimplementedProfileServer) mustEmbedUnimplementedProfileServer() {}
+func (UnimplementedProfileServer) testEmbeddedByValue()                 {}
+
+// UnsafeProfileServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProfileServer will
+// result in compilation errors.
+type UnsafeProfileServer interface {
+	mustEmbedUnimplementedProfileServer()
+}
+
+func RegisterProfileServer(s grpc.ServiceRegistrar, srv ProfileServer) {
+	// If the following call pancis, it indicates UnimplementedProfileServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Profile_ServiceDesc, srv)
+}
+
+func _Profile_GetProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.GetProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_GetProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetProfiles(ctx, req.(*proto.GetProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_CreateHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.HotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).CreateHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_CreateHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).CreateHotel(ctx, req.(*proto.HotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_UpdateHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.HotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).UpdateHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_UpdateHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).UpdateHotel(ctx, req.(*proto.HotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Profile_ServiceDesc is the grpc.ServiceDesc for Profile service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Profile_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel_reservation.Profile",
+	HandlerType: (*ProfileServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProfiles",
+			Handler:    _Profile_GetProfiles_Handler,
+		},
+		{
+			MethodName: "CreateHotel",
+			Handler:    _Profile_CreateHotel_Handler,
+		},
+		{
+			MethodName: "UpdateHotel",
+			Handler:    _Profile_UpdateHotel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel_reservation.proto",
+}
+
+const (
+	Recommendation_GetRecommendations_FullMethodName = "/hotel_reservation.Recommendation/GetRecommendations"
+	Recommendation_SetHotel_FullMethodName           = "/hotel_reservation.Recommendation/SetHotel"
+	Recommendation_ReloadHotels_FullMethodName       = "/hotel_reservation.Recommendation/ReloadHotels"
+)
+
+// RecommendationClient is the client API for Recommendation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RecommendationClient interface {
+	// GetRecommendations returns recommended hotels for a given requirement
+	GetRecommendations(ctx context.Context, in *proto.GetRecommendationsRequest, opts ...grpc.CallOption) (*proto.GetRecommendationsResult, error)
+	// SetHotel adds or replaces the data a hotel is recommended on
+	SetHotel(ctx context.Context, in *proto.SetHotelRequest, opts ...grpc.CallOption) (*proto.SetHotelResult, error)
+	// ReloadHotels reloads the hotel data and booking model from the databases
+	ReloadHotels(ctx context.Context, in *proto.ReloadHotelsRequest, opts ...grpc.CallOption) (*proto.ReloadHotelsResult, error)
+}
+
+type recommendationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecommendationClient(cc grpc.ClientConnInterface) RecommendationClient {
+	return &recommendationClient{cc}
+}
+
+func (c *recommendationClient) GetRecommendations(ctx context.Context, in *proto.GetRecommendationsRequest, opts ...grpc.CallOption) (*proto.GetRecommendationsResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.GetRecommendationsResult)
+	err := c.cc.Invoke(ctx, Recommendation_GetRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recommendationClient) SetHotel(ctx context.Context, in *proto.SetHotelRequest, opts ...grpc.CallOption) (*proto.SetHotelResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.SetHotelResult)
+	err := c.cc.Invoke(ctx, Recommendation_SetHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recommendationClient) ReloadHotels(ctx context.Context, in *proto.ReloadHotelsRequest, opts ...grpc.CallOption) (*proto.ReloadHotelsResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.ReloadHotelsResult)
+	err := c.cc.Invoke(ctx, Recommendation_ReloadHotels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecommendationServer is the server API for Recommendation service.
+// All implementations must embed UnimplementedRecommendationServer
+// for forward compatibility.
+type RecommendationServer interface {
+	// GetRecommendations returns recommended hotels for a given requirement
+	GetRecommendations(context.Context, *proto.GetRecommendationsRequest) (*proto.GetRecommendationsResult, error)
+	// SetHotel adds or replaces the data a hotel is recommended on
+	SetHotel(context.Context, *proto.SetHotelRequest) (*proto.SetHotelResult, error)
+	// ReloadHotels reloads the hotel data and booking model from the databases
+	ReloadHotels(context.Context, *proto.ReloadHotelsRequest) (*proto.ReloadHotelsResult, error)
+	mustEmbedUnimplementedRecommendationServer()
+}
+
+// UnimplementedRecommendationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecommendationServer struct{}
+
+func (UnimplementedRecommendationServer) GetRecommendations(context.Context, *proto.GetRecommendationsRequest) (*proto.GetRecommendationsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendations not implemented")
+}
+func (UnimplementedRecommendationServer) SetHotel(context.Context, *proto.SetHotelRequest) (*proto.SetHotelResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHotel not implemented")
+}
+func (UnimplementedRecommendationServer) ReloadHotels(context.Context, *proto.ReloadHotelsRequest) (*proto.ReloadHotelsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadHotels not implemented")
+}
+func (UnimplementedRecommendationServer) mustEmbedUnimplementedRecommendationServer() {}
+func (UnimplementedRecommendationServer) testEmbeddedByValue()                        {}
+
+// UnsafeRecommendationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecommendationServer will
+// result in compilation errors.
+type UnsafeRecommendationServer interface {
+	mustEmbedUnimplementedRecommendationServer()
+}
+
+func RegisterRecommendationServer(s grpc.ServiceRegistrar, srv RecommendationServer) {
+	// If the following call pancis, it indicates UnimplementedRecommendationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Recommendation_ServiceDesc, srv)
+}
+
+func _Recommendation_GetRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.GetRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecommendationServer).GetRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Recommendation_GetRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecommendationServer).GetRecommendations(ctx, req.(*proto.GetRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Recommendation_SetHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.SetHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecommendationServer).SetHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Recommendation_SetHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecommendationServer).SetHotel(ctx, req.(*proto.SetHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Recommendation_ReloadHotels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.ReloadHotelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecommendationServer).ReloadHotels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Recommendation_ReloadHotels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecommendationServer).ReloadHotels(ctx, req.(*proto.ReloadHotelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Recommendation_ServiceDesc is the grpc.ServiceDesc for Recommendation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Recommendation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel_reservation.Recommendation",
+	HandlerType: (*RecommendationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRecommendations",
+			Handler:    _Recommendation_GetRecommendations_Handler,
+		},
+		{
+			MethodName: "SetHotel",
+			Handler:    _Recommendation_SetHotel_Handler,
+		},
+		{
+			MethodName: "ReloadHotels",
+			Handler:    _Recommendation_ReloadHotels_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel_reservation.proto",
+}
+
+const (
+	Rate_GetRates_FullMethodName     = "/hotel_reservation.Rate/GetRates"
+	Rate_SetRatePlans_FullMethodName = "/hotel_reservation.Rate/SetRatePlans"
+)
+
+// RateClient is the client API for Rate service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RateClient interface {
+	// GetRates returns rate codes for hotels for a given date range
+	GetRates(ctx context.Context, in *proto.GetRatesRequest, opts ...grpc.CallOption) (*proto.GetRatesResult, error)
+	// SetRatePlans replaces all rate plans of a hotel
+	SetRatePlans(ctx context.Context, in *proto.SetRatePlansRequest, opts ...grpc.CallOption) (*proto.SetRatePlansResult, error)
+}
+
+type rateClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRateClient(cc grpc.ClientConnInterface) RateClient {
+	return &rateClient{cc}
+}
+
+func (c *rateClient) GetRates(ctx context.Context, in *proto.GetRatesRequest, opts ...grpc.CallOption) (*proto.GetRatesResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.GetRatesResult)
+	err := c.cc.Invoke(ctx, Rate_GetRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateClient) SetRatePlans(ctx context.Context, in *proto.SetRatePlansRequest, opts ...grpc.CallOption) (*proto.SetRatePlansResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.SetRatePlansResult)
+	err := c.cc.Invoke(ctx, Rate_SetRatePlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RateServer is the server API for Rate service.
+// All implementations must embed UnimplementedRateServer
+// for forward compatibility.
+type RateServer interface {
+	// GetRates returns rate codes for hotels for a given date range
+	GetRates(context.Context, *proto.GetRatesRequest) (*proto.GetRatesResult, error)
+	// SetRatePlans replaces all rate plans of a hotel
+	SetRatePlans(context.Context, *proto.SetRatePlansRequest) (*proto.SetRatePlansResult, error)
+	mustEmbedUnimplementedRateServer()
+}
+
+// UnimplementedRateServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRateServer struct{}
+
+func (UnimplementedRateServer) GetRates(context.Context, *proto.GetRatesRequest) (*proto.GetRatesResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRates not implemented")
+}
+func (UnimplementedRateServer) SetRatePlans(context.Context, *proto.SetRatePlansRequest) (*proto.SetRatePlansResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRatePlans not implemented")
+}
+func (UnimplementedRateServer) mustEmbedUnimplementedRateServer() {}
+func (UnimplementedRateServer) testEmbeddedByValue()              {}
+
+// UnsafeRateServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RateServer will
+// result in compilation errors.
+type UnsafeRateServer interface {
+	mustEmbedUnimplementedRateServer()
+}
+
+func RegisterRateServer(s grpc.ServiceRegistrar, srv RateServer) {
+	// If the following call pancis, it indicates UnimplementedRateServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Rate_ServiceDesc, srv)
+}
+
+func _Rate_GetRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.GetRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateServer).GetRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rate_GetRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateServer).GetRates(ctx, req.(*proto.GetRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rate_SetRatePlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.SetRatePlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateServer).SetRatePlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Rate_SetRatePlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateServer).SetRatePlans(ctx, req.(*proto.SetRatePlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Rate_ServiceDesc is the grpc.ServiceDesc for Rate service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Rate_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel_reservation.Rate",
+	HandlerType: (*RateServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRates",
+			Handler:    _Rate_GetRates_Handler,
+		},
+		{
+			MethodName: "SetRatePlans",
+			Handler:    _Rate_SetRatePlans_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel_reservation.proto",
+}
+
+const (
+	Reservation_MakeReservation_FullMethodName   = "/hotel_reservation.Reservation/MakeReservation"
+	Reservation_CheckAvailability_FullMethodName = "/hotel_reservation.Reservation/CheckAvailability"
+	Reservation_SetCapacity_FullMethodName       = "/hotel_reservation.Reservation/SetCapacity"
+)
+
+// ReservationClient is the client API for Reservation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReservationClient interface {
+	// MakeReservation makes a reservation based on given information
+	MakeReservation(ctx context.Context, in *proto.ReservationRequest, opts ...grpc.CallOption) (*proto.ReservationResult, error)
+	// CheckAvailability checks if given information is available
+	CheckAvailability(ctx context.Context, in *proto.ReservationRequest, opts ...grpc.CallOption) (*proto.ReservationResult, error)
+	// SetCapacity sets the number of rooms of a hotel
+	SetCapacity(ctx context.Context, in *proto.SetCapacityRequest, opts ...grpc.CallOption) (*proto.SetCapacityResult, error)
+}
+
+type reservationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReservationClient(cc grpc.ClientConnInterface) ReservationClient {
+	return &reservationClient{cc}
+}
+
+func (c *reservationClient) MakeReservation(ctx context.Context, in *proto.ReservationRequest, opts ...grpc.CallOption) (*proto.ReservationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.ReservationResult)
+	err := c.cc.Invoke(ctx, Reservation_MakeReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) CheckAvailability(ctx context.Context, in *proto.ReservationRequest, opts ...grpc.CallOption) (*proto.ReservationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.ReservationResult)
+	err := c.cc.Invoke(ctx, Reservation_CheckAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) SetCapacity(ctx context.Context, in *proto.SetCapacityRequest, opts ...grpc.CallOption) (*proto.SetCapacityResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.SetCapacityResult)
+	err := c.cc.Invoke(ctx, Reservation_SetCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReservationServer is the server API for Reservation service.
+// All implementations must embed UnimplementedReservationServer
+// for forward compatibility.
+type ReservationServer interface {
+	// MakeReservation makes a reservation based on given information
+	MakeReservation(context.Context, *proto.ReservationRequest) (*proto.ReservationResult, error)
+	// CheckAvailability checks if given information is available
+	CheckAvailability(context.Context, *proto.ReservationRequest) (*proto.ReservationResult, error)
+	// SetCapacity sets the number of rooms of a hotel
+	SetCapacity(context.Context, *proto.SetCapacityRequest) (*proto.SetCapacityResult, error)
+	mustEmbedUnimplementedReservationServer()
+}
+
+// UnimplementedReservationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReservationServer struct{}
+
+func (UnimplementedReservationServer) MakeReservation(context.Context, *proto.ReservationRequest) (*proto.ReservationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeReservation not implemented")
+}
+func (UnimplementedReservationServer) CheckAvailability(context.Context, *proto.ReservationRequest) (*proto.ReservationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAvailability not implemented")
+}
+func (UnimplementedReservationServer) SetCapacity(context.Context, *proto.SetCapacityRequest) (*proto.SetCapacityResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCapacity not implemented")
+}
+func (UnimplementedReservationServer) mustEmbedUnimplementedReservationServer() {}
+func (UnimplementedReservationServer) testEmbeddedByValue()                     {}
+
+// UnsafeReservationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReservationServer will
+// result in compilation errors.
+type UnsafeReservationServer interface {
+	mustEmbedUnimplementedReservationServer()
+}
+
+func RegisterReservationServer(s grpc.ServiceRegistrar, srv ReservationServer) {
+	// If the following call pancis, it indicates UnimplementedReservationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Reservation_ServiceDesc, srv)
+}
+
+func _Reservation_MakeReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.ReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).MakeReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reservation_MakeReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).MakeReservation(ctx, req.(*proto.ReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_CheckAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.ReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).CheckAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reservation_CheckAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).CheckAvailability(ctx, req.(*proto.ReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_SetCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.SetCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).SetCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reservation_SetCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).SetCapacity(ctx, req.(*proto.SetCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Reservation_ServiceDesc is the grpc.ServiceDesc for Reservation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Reservation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel_reservation.Reservation",
+	HandlerType: (*ReservationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MakeReservation",
+			Handler:    _Reservation_MakeReservation_Handler,
+		},
+		{
+			MethodName: "CheckAvailability",
+			Handler:    _Reservation_CheckAvailability_Handler,
+		},
+		{
+			MethodName: "SetCapacity",
+			Handler:    _Reservation_SetCapacity_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel_reservation.proto",
+}
+
+const (
+	Search_Nearby_FullMethodName = "/hotel_reservation.Search/Nearby"
+)
+
+// SearchClient is the client API for Search service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SearchClient interface {
+	Nearby(ctx context.Context, in *proto.SearchRequest, opts ...grpc.CallOption) (*proto.SearchResult, error)
+}
+
+type searchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchClient(cc grpc.ClientConnInterface) SearchClient {
+	return &searchClient{cc}
+}
+
+func (c *searchClient) Nearby(ctx context.Context, in *proto.SearchRequest, opts ...grpc.CallOption) (*proto.SearchResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.SearchResult)
+	err := c.cc.Invoke(ctx, Search_Nearby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchServer is the server API for Search service.
+// All implementations must embed UnimplementedSearchServer
+// for forward compatibility.
+type SearchServer interface {
+	Nearby(context.Context, *proto.SearchRequest) (*proto.SearchResult, error)
+	mustEmbedUnimplementedSearchServer()
+}
+
+// UnimplementedSearchServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSearchServer struct{}
+
+func (UnimplementedSearchServer) Nearby(context.Context, *proto.SearchRequest) (*proto.SearchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nearby not implemented")
+}
+func (UnimplementedSearchServer) mustEmbedUnimplementedSearchServer() {}
+func (UnimplementedSearchServer) testEmbeddedByValue()                {}
+
+// UnsafeSearchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SearchServer will
+// result in compilation errors.
+type UnsafeSearchServer interface {
+	mustEmbedUnimplementedSearchServer()
+}
+
+func RegisterSearchServer(s grpc.ServiceRegistrar, srv SearchServer) {
+	// If the following call pancis, it indicates UnimplementedSearchServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Search_ServiceDesc, srv)
+}
+
+func _Search_Nearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).Nearby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_Nearby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).Nearby(ctx, req.(*proto.SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Search_ServiceDesc is the grpc.ServiceDesc for Search service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Search_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel_reservation.Search",
+	HandlerType: (*SearchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Nearby",
+			Handler:    _Search_Nearby_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel_reservation.proto",
+}
+
+const (
+	User_CheckUser_FullMethodName      = "/hotel_reservation.User/CheckUser"
+	User_RegisterUser_FullMethodName   = "/hotel_reservation.User/RegisterUser"
+	User_ChangePassword_FullMethodName = "/hotel_reservation.User/ChangePassword"
+	User_DeleteUser_FullMethodName     = "/hotel_reservation.User/DeleteUser"
+	User_ReloadUsers_FullMethodName    = "/hotel_reservation.User/ReloadUsers"
+	User_CheckSession_FullMethodName   = "/hotel_reservation.User/CheckSession"
+)
+
+// UserClient is the client API for User service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserClient interface {
+	// CheckUser returns whether the username and password are correct
+	CheckUser(ctx context.Context, in *proto.CheckUserRequest, opts ...grpc.CallOption) (*proto.CheckUserResult, error)
+	// RegisterUser creates a user with the given password
+	RegisterUser(ctx context.Context, in *proto.RegisterUserRequest, opts ...grpc.CallOption) (*proto.RegisterUserResult, error)
+	// ChangePassword replaces the password of a user
+	ChangePassword(ctx context.Context, in *proto.ChangePasswordRequest, opts ...grpc.CallOption) (*proto.ChangePasswordResult, error)
+	// DeleteUser removes a user
+	DeleteUser(ctx context.Context, in *proto.DeleteUserRequest, opts ...grpc.CallOption) (*proto.DeleteUserResult, error)
+	// ReloadUsers reloads the users from the database
+	ReloadUsers(ctx context.Context, in *proto.ReloadUsersRequest, opts ...grpc.CallOption) (*proto.ReloadUsersResult, error)
+	// CheckSession returns whether a session token issued to a user is still
+	// valid
+	CheckSession(ctx context.Context, in *proto.CheckSessionRequest, opts ...grpc.CallOption) (*proto.CheckSessionResult, error)
+}
+
+type userClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserClient(cc grpc.ClientConnInterface) UserClient {
+	return &userClient{cc}
+}
+
+func (c *userClient) CheckUser(ctx context.Context, in *proto.CheckUserRequest, opts ...grpc.CallOption) (*proto.CheckUserResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.CheckUserResult)
+	err := c.cc.Invoke(ctx, User_CheckUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RegisterUser(ctx context.Context, in *proto.RegisterUserRequest, opts ...grpc.CallOption) (*proto.RegisterUserResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.RegisterUserResult)
+	err := c.cc.Invoke(ctx, User_RegisterUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ChangePassword(ctx context.Context, in *proto.ChangePasswordRequest, opts ...grpc.CallOption) (*proto.ChangePasswordResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.ChangePasswordResult)
+	err := c.cc.Invoke(ctx, User_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteUser(ctx context.Context, in *proto.DeleteUserRequest, opts ...grpc.CallOption) (*proto.DeleteUserResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.DeleteUserResult)
+	err := c.cc.Invoke(ctx, User_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ReloadUsers(ctx context.Context, in *proto.ReloadUsersRequest, opts ...grpc.CallOption) (*proto.ReloadUsersResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.ReloadUsersResult)
+	err := c.cc.Invoke(ctx, User_ReloadUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CheckSession(ctx context.Context, in *proto.CheckSessionRequest, opts ...grpc.CallOption) (*proto.CheckSessionResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proto.CheckSessionResult)
+	err := c.cc.Invoke(ctx, User_CheckSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServer is the server API for User service.
+// All implementations must embed UnimplementedUserServer
+// for forward compatibility.
+type UserServer interface {
+	// CheckUser returns whether the username and password are correct
+	CheckUser(context.Context, *proto.CheckUserRequest) (*proto.CheckUserResult, error)
+	// RegisterUser creates a user with the given password
+	RegisterUser(context.Context, *proto.RegisterUserRequest) (*proto.RegisterUserResult, error)
+	// ChangePassword replaces the password of a user
+	ChangePassword(context.Context, *proto.ChangePasswordRequest) (*proto.ChangePasswordResult, error)
+	// DeleteUser removes a user
+	DeleteUser(context.Context, *proto.DeleteUserRequest) (*proto.DeleteUserResult, error)
+	// ReloadUsers reloads the users from the database
+	ReloadUsers(context.Context, *proto.ReloadUsersRequest) (*proto.ReloadUsersResult, error)
+	// CheckSession returns whether a session token issued to a user is still
+	// valid
+	CheckSession(context.Context, *proto.CheckSessionRequest) (*proto.CheckSessionResult, error)
+	mustEmbedUnimplementedUserServer()
+}
+
+// UnimplementedUserServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServer struct{}
+
+func (UnimplementedUserServer) CheckUser(context.Context, *proto.CheckUserRequest) (*proto.CheckUserResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUser not implemented")
+}
+func (UnimplementedUserServer) RegisterUser(context.Context, *proto.RegisterUserRequest) (*proto.RegisterUserResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
+}
+func (UnimplementedUserServer) ChangePassword(context.Context, *proto.ChangePasswordRequest) (*proto.ChangePasswordResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUserServer) DeleteUser(context.Context, *proto.DeleteUserRequest) (*proto.DeleteUserResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServer) ReloadUsers(context.Context, *proto.ReloadUsersRequest) (*proto.ReloadUsersResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadUsers not implemented")
+}
+func (UnimplementedUserServer) CheckSession(context.Context, *proto.CheckSessionRequest) (*proto.CheckSessionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSession not implemented")
+}
+func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
+func (UnimplementedUserServer) testEmbeddedByValue()              {}
+
+// UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServer will
+// result in compilation errors.
+type UnsafeUserServer interface {
+	mustEmbedUnimplementedUserServer()
+}
+
+func RegisterUserServer(s grpc.ServiceRegistrar, srv UserServer) {
+	// If the following call pancis, it indicates UnimplementedUserServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&User_ServiceDesc, srv)
+}
+
+func _User_CheckUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.CheckUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CheckUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CheckUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CheckUser(ctx, req.(*proto.CheckUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.RegisterUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RegisterUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RegisterUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RegisterUser(ctx, req.(*proto.RegisterUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ChangePassword(ctx, req.(*proto.ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteUser(ctx, req.(*proto.DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ReloadUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.ReloadUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ReloadUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ReloadUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ReloadUsers(ctx, req.(*proto.ReloadUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CheckSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.CheckSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CheckSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CheckSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CheckSession(ctx, req.(*proto.CheckSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// User_ServiceDesc is the grpc.ServiceDesc for User service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var User_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel_reservation.User",
+	HandlerType: (*UserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckUser",
+			Handler:    _User_CheckUser_Handler,
+		},
+		{
+			MethodName: "RegisterUser",
+			Handler:    _User_RegisterUser_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _User_ChangePassword_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _User_DeleteUser_Handler,
+		},
+		{
+			MethodName: "ReloadUsers",
+			Handler:    _User_ReloadUsers_Handler,
+		},
+		{
+			MethodName: "CheckSession",
+			Handler:    _User_CheckSession_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel_reservation.proto",
+}
@@ -9,6 +9,7 @@ package hotel_reservation
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -126,17 +127,124 @@ func (x *NearbyResult) GetHotelIds() []string {
 	return nil
 }
 
-type GetProfilesRequest struct {
+type SetLocationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HotelIds      []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
-	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	Lat           float32                `protobuf:"fixed32,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float32                `protobuf:"fixed32,3,opt,name=lon,proto3" json:"lon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLocationRequest) Reset() {
+	*x = SetLocationRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLocationRequest) ProtoMessage() {}
+
+func (x *SetLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLocationRequest.ProtoReflect.Descriptor instead.
+func (*SetLocationRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetLocationRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *SetLocationRequest) GetLat() float32 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *SetLocationRequest) GetLon() float32 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+type SetLocationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLocationResult) Reset() {
+	*x = SetLocationResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLocationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLocationResult) ProtoMessage() {}
+
+func (x *SetLocationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLocationResult.ProtoReflect.Descriptor instead.
+func (*SetLocationResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetLocationResult) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+type GetProfilesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	HotelIds []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+	Locale   string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Profile fields to return, e.g. "name" or "address.lat". The hotel id is
+	// always returned; an empty list returns the full profile.
+	Fields        []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetProfilesRequest) Reset() {
 	*x = GetProfilesRequest{}
-	mi := &file_hotel_reservation_proto_msgTypes[2]
+	mi := &file_hotel_reservation_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -148,7 +256,7 @@ func (x *GetProfilesRequest) String() string {
 func (*GetProfilesRequest) ProtoMessage() {}
 
 func (x *GetProfilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[2]
+	mi := &file_hotel_reservation_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +269,7 @@ func (x *GetProfilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfilesRequest.ProtoReflect.Descriptor instead.
 func (*GetProfilesRequest) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{2}
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetProfilesRequest) GetHotelIds() []string {
@@ -178,6 +286,13 @@ func (x *GetProfilesRequest) GetLocale() string {
 	return ""
 }
 
+func (x *GetProfilesRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 type GetProfilesResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Hotels        []*Hotel               `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels,omitempty"`
@@ -187,7 +302,7 @@ type GetProfilesResult struct {
 
 func (x *GetProfilesResult) Reset() {
 	*x = GetProfilesResult{}
-	mi := &file_hotel_reservation_proto_msgTypes[3]
+	mi := &file_hotel_reservation_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -199,7 +314,7 @@ func (x *GetProfilesResult) String() string {
 func (*GetProfilesResult) ProtoMessage() {}
 
 func (x *GetProfilesResult) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[3]
+	mi := &file_hotel_reservation_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,7 +327,7 @@ func (x *GetProfilesResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfilesResult.ProtoReflect.Descriptor instead.
 func (*GetProfilesResult) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{3}
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetProfilesResult) GetHotels() []*Hotel {
@@ -236,7 +351,7 @@ type Hotel struct {
 
 func (x *Hotel) Reset() {
 	*x = Hotel{}
-	mi := &file_hotel_reservation_proto_msgTypes[4]
+	mi := &file_hotel_reservation_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +363,7 @@ func (x *Hotel) String() string {
 func (*Hotel) ProtoMessage() {}
 
 func (x *Hotel) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[4]
+	mi := &file_hotel_reservation_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +376,7 @@ func (x *Hotel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hotel.ProtoReflect.Descriptor instead.
 func (*Hotel) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{4}
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Hotel) GetId() string {
@@ -322,7 +437,7 @@ type Address struct {
 
 func (x *Address) Reset() {
 	*x = Address{}
-	mi := &file_hotel_reservation_proto_msgTypes[5]
+	mi := &file_hotel_reservation_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -334,7 +449,7 @@ func (x *Address) String() string {
 func (*Address) ProtoMessage() {}
 
 func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[5]
+	mi := &file_hotel_reservation_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +462,7 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Address.ProtoReflect.Descriptor instead.
 func (*Address) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{5}
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Address) GetStreetNumber() string {
@@ -416,7 +531,7 @@ type Image struct {
 
 func (x *Image) Reset() {
 	*x = Image{}
-	mi := &file_hotel_reservation_proto_msgTypes[6]
+	mi := &file_hotel_reservation_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -428,7 +543,7 @@ func (x *Image) String() string {
 func (*Image) ProtoMessage() {}
 
 func (x *Image) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[6]
+	mi := &file_hotel_reservation_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +556,7 @@ func (x *Image) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Image.ProtoReflect.Descriptor instead.
 func (*Image) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{6}
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Image) GetUrl() string {
@@ -458,31 +573,33 @@ func (x *Image) GetDefault() bool {
 	return false
 }
 
-// The requirement of the recommendation.
-type GetRecommendationsRequest struct {
+// Everything needed to list a hotel across all services.
+type HotelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Require       string                 `protobuf:"bytes,1,opt,name=require,proto3" json:"require,omitempty"`
-	Lat           float64                `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
-	Lon           float64                `protobuf:"fixed64,3,opt,name=lon,proto3" json:"lon,omitempty"`
+	Hotel         *Hotel                 `protobuf:"bytes,1,opt,name=hotel,proto3" json:"hotel,omitempty"`
+	RatePlans     []*RatePlan            `protobuf:"bytes,2,rep,name=ratePlans,proto3" json:"ratePlans,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Capacity      int32                  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRecommendationsRequest) Reset() {
-	*x = GetRecommendationsRequest{}
-	mi := &file_hotel_reservation_proto_msgTypes[7]
+func (x *HotelRequest) Reset() {
+	*x = HotelRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRecommendationsRequest) String() string {
+func (x *HotelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRecommendationsRequest) ProtoMessage() {}
+func (*HotelRequest) ProtoMessage() {}
 
-func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[7]
+func (x *HotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,54 +610,68 @@ func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
-func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use HotelRequest.ProtoReflect.Descriptor instead.
+func (*HotelRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetRecommendationsRequest) GetRequire() string {
+func (x *HotelRequest) GetHotel() *Hotel {
 	if x != nil {
-		return x.Require
+		return x.Hotel
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRecommendationsRequest) GetLat() float64 {
+func (x *HotelRequest) GetRatePlans() []*RatePlan {
 	if x != nil {
-		return x.Lat
+		return x.RatePlans
+	}
+	return nil
+}
+
+func (x *HotelRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
 	}
 	return 0
 }
 
-func (x *GetRecommendationsRequest) GetLon() float64 {
+func (x *HotelRequest) GetPrice() float64 {
 	if x != nil {
-		return x.Lon
+		return x.Price
 	}
 	return 0
 }
 
-type GetRecommendationsResult struct {
+func (x *HotelRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type HotelResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HotelIds      []string               `protobuf:"bytes,1,rep,name=HotelIds,proto3" json:"HotelIds,omitempty"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRecommendationsResult) Reset() {
-	*x = GetRecommendationsResult{}
-	mi := &file_hotel_reservation_proto_msgTypes[8]
+func (x *HotelResult) Reset() {
+	*x = HotelResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRecommendationsResult) String() string {
+func (x *HotelResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRecommendationsResult) ProtoMessage() {}
+func (*HotelResult) ProtoMessage() {}
 
-func (x *GetRecommendationsResult) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[8]
+func (x *HotelResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,42 +682,54 @@ func (x *GetRecommendationsResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRecommendationsResult.ProtoReflect.Descriptor instead.
-func (*GetRecommendationsResult) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use HotelResult.ProtoReflect.Descriptor instead.
+func (*HotelResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetRecommendationsResult) GetHotelIds() []string {
+func (x *HotelResult) GetHotelId() string {
 	if x != nil {
-		return x.HotelIds
+		return x.HotelId
 	}
-	return nil
+	return ""
 }
 
-type GetRatesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HotelIds      []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
-	InDate        string                 `protobuf:"bytes,2,opt,name=inDate,proto3" json:"inDate,omitempty"`
-	OutDate       string                 `protobuf:"bytes,3,opt,name=outDate,proto3" json:"outDate,omitempty"`
+// The requirement of the recommendation: "dis", "rate", "price", "mixed"
+// to rank on the weighted combination of all three, or "similar" to rank on
+// what guests who booked the same hotels as the user booked.
+type GetRecommendationsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Require string                 `protobuf:"bytes,1,opt,name=require,proto3" json:"require,omitempty"`
+	Lat     float64                `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon     float64                `protobuf:"fixed64,3,opt,name=lon,proto3" json:"lon,omitempty"`
+	// Number of hotels to return. Zero returns every hotel tied for the best score.
+	K int32 `protobuf:"varint,4,opt,name=k,proto3" json:"k,omitempty"`
+	// Criteria weights for "mixed". All zero weighs the criteria equally.
+	DisWeight   float64 `protobuf:"fixed64,5,opt,name=disWeight,proto3" json:"disWeight,omitempty"`
+	RateWeight  float64 `protobuf:"fixed64,6,opt,name=rateWeight,proto3" json:"rateWeight,omitempty"`
+	PriceWeight float64 `protobuf:"fixed64,7,opt,name=priceWeight,proto3" json:"priceWeight,omitempty"`
+	// Personalizes the ranking with the user's past reservations when set.
+	// "similar" falls back to the most booked hotels without it.
+	Username      string `protobuf:"bytes,8,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRatesRequest) Reset() {
-	*x = GetRatesRequest{}
-	mi := &file_hotel_reservation_proto_msgTypes[9]
+func (x *GetRecommendationsRequest) Reset() {
+	*x = GetRecommendationsRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRatesRequest) String() string {
+func (x *GetRecommendationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRatesRequest) ProtoMessage() {}
+func (*GetRecommendationsRequest) ProtoMessage() {}
 
-func (x *GetRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[9]
+func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,54 +740,91 @@ func (x *GetRatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRatesRequest.ProtoReflect.Descriptor instead.
-func (*GetRatesRequest) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetRatesRequest) GetHotelIds() []string {
+func (x *GetRecommendationsRequest) GetRequire() string {
 	if x != nil {
-		return x.HotelIds
+		return x.Require
 	}
-	return nil
+	return ""
 }
 
-func (x *GetRatesRequest) GetInDate() string {
+func (x *GetRecommendationsRequest) GetLat() float64 {
 	if x != nil {
-		return x.InDate
+		return x.Lat
 	}
-	return ""
+	return 0
 }
 
-func (x *GetRatesRequest) GetOutDate() string {
+func (x *GetRecommendationsRequest) GetLon() float64 {
 	if x != nil {
-		return x.OutDate
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *GetRecommendationsRequest) GetK() int32 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+func (x *GetRecommendationsRequest) GetDisWeight() float64 {
+	if x != nil {
+		return x.DisWeight
+	}
+	return 0
+}
+
+func (x *GetRecommendationsRequest) GetRateWeight() float64 {
+	if x != nil {
+		return x.RateWeight
+	}
+	return 0
+}
+
+func (x *GetRecommendationsRequest) GetPriceWeight() float64 {
+	if x != nil {
+		return x.PriceWeight
+	}
+	return 0
+}
+
+func (x *GetRecommendationsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-type GetRatesResult struct {
+// Hotels ordered from best to worst, each with a score between 0 and 1.
+type GetRecommendationsResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RatePlans     []*RatePlan            `protobuf:"bytes,1,rep,name=ratePlans,proto3" json:"ratePlans,omitempty"`
+	HotelIds      []string               `protobuf:"bytes,1,rep,name=HotelIds,proto3" json:"HotelIds,omitempty"`
+	Scores        []float64              `protobuf:"fixed64,2,rep,packed,name=scores,proto3" json:"scores,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRatesResult) Reset() {
-	*x = GetRatesResult{}
-	mi := &file_hotel_reservation_proto_msgTypes[10]
+func (x *GetRecommendationsResult) Reset() {
+	*x = GetRecommendationsResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRatesResult) String() string {
+func (x *GetRecommendationsResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRatesResult) ProtoMessage() {}
+func (*GetRecommendationsResult) ProtoMessage() {}
 
-func (x *GetRatesResult) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[10]
+func (x *GetRecommendationsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,44 +835,51 @@ func (x *GetRatesResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRatesResult.ProtoReflect.Descriptor instead.
-func (*GetRatesResult) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetRecommendationsResult.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetRatesResult) GetRatePlans() []*RatePlan {
+func (x *GetRecommendationsResult) GetHotelIds() []string {
 	if x != nil {
-		return x.RatePlans
+		return x.HotelIds
 	}
 	return nil
 }
 
-type RatePlan struct {
+func (x *GetRecommendationsResult) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type SetHotelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HotelId       string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	InDate        string                 `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
-	OutDate       string                 `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
-	RoomType      *RoomType              `protobuf:"bytes,5,opt,name=roomType,proto3" json:"roomType,omitempty"`
+	Lat           float64                `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float64                `protobuf:"fixed64,3,opt,name=lon,proto3" json:"lon,omitempty"`
+	Rate          float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RatePlan) Reset() {
-	*x = RatePlan{}
-	mi := &file_hotel_reservation_proto_msgTypes[11]
+func (x *SetHotelRequest) Reset() {
+	*x = SetHotelRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RatePlan) String() string {
+func (x *SetHotelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RatePlan) ProtoMessage() {}
+func (*SetHotelRequest) ProtoMessage() {}
 
-func (x *RatePlan) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[11]
+func (x *SetHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,73 +890,1068 @@ func (x *RatePlan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RatePlan.ProtoReflect.Descriptor instead.
-func (*RatePlan) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SetHotelRequest.ProtoReflect.Descriptor instead.
+func (*SetHotelRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RatePlan) GetHotelId() string {
+func (x *SetHotelRequest) GetHotelId() string {
 	if x != nil {
 		return x.HotelId
 	}
 	return ""
 }
 
-func (x *RatePlan) GetCode() string {
+func (x *SetHotelRequest) GetLat() float64 {
 	if x != nil {
-		return x.Code
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *SetHotelRequest) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *SetHotelRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *SetHotelRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type SetHotelResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHotelResult) Reset() {
+	*x = SetHotelResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHotelResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHotelResult) ProtoMessage() {}
+
+func (x *SetHotelResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHotelResult.ProtoReflect.Descriptor instead.
+func (*SetHotelResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetHotelResult) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
 	}
 	return ""
 }
 
-func (x *RatePlan) GetInDate() string {
+type ReloadHotelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadHotelsRequest) Reset() {
+	*x = ReloadHotelsRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadHotelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadHotelsRequest) ProtoMessage() {}
+
+func (x *ReloadHotelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadHotelsRequest.ProtoReflect.Descriptor instead.
+func (*ReloadHotelsRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{15}
+}
+
+type ReloadHotelsResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of hotels loaded.
+	Count         int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadHotelsResult) Reset() {
+	*x = ReloadHotelsResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadHotelsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadHotelsResult) ProtoMessage() {}
+
+func (x *ReloadHotelsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadHotelsResult.ProtoReflect.Descriptor instead.
+func (*ReloadHotelsResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReloadHotelsResult) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelIds      []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+	InDate        string                 `protobuf:"bytes,2,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate       string                 `protobuf:"bytes,3,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatesRequest) Reset() {
+	*x = GetRatesRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatesRequest) ProtoMessage() {}
+
+func (x *GetRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetRatesRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetRatesRequest) GetHotelIds() []string {
+	if x != nil {
+		return x.HotelIds
+	}
+	return nil
+}
+
+func (x *GetRatesRequest) GetInDate() string {
 	if x != nil {
 		return x.InDate
 	}
 	return ""
 }
 
-func (x *RatePlan) GetOutDate() string {
+func (x *GetRatesRequest) GetOutDate() string {
+	if x != nil {
+		return x.OutDate
+	}
+	return ""
+}
+
+type GetRatesResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatePlans     []*RatePlan            `protobuf:"bytes,1,rep,name=ratePlans,proto3" json:"ratePlans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatesResult) Reset() {
+	*x = GetRatesResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatesResult) ProtoMessage() {}
+
+func (x *GetRatesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatesResult.ProtoReflect.Descriptor instead.
+func (*GetRatesResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetRatesResult) GetRatePlans() []*RatePlan {
+	if x != nil {
+		return x.RatePlans
+	}
+	return nil
+}
+
+type RatePlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	InDate        string                 `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate       string                 `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	RoomType      *RoomType              `protobuf:"bytes,5,opt,name=roomType,proto3" json:"roomType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatePlan) Reset() {
+	*x = RatePlan{}
+	mi := &file_hotel_reservation_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatePlan) ProtoMessage() {}
+
+func (x *RatePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatePlan.ProtoReflect.Descriptor instead.
+func (*RatePlan) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RatePlan) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *RatePlan) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RatePlan) GetInDate() string {
+	if x != nil {
+		return x.InDate
+	}
+	return ""
+}
+
+func (x *RatePlan) GetOutDate() string {
+	if x != nil {
+		return x.OutDate
+	}
+	return ""
+}
+
+func (x *RatePlan) GetRoomType() *RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return nil
+}
+
+type SetRatePlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	RatePlans     []*RatePlan            `protobuf:"bytes,2,rep,name=ratePlans,proto3" json:"ratePlans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRatePlansRequest) Reset() {
+	*x = SetRatePlansRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRatePlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRatePlansRequest) ProtoMessage() {}
+
+func (x *SetRatePlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRatePlansRequest.ProtoReflect.Descriptor instead.
+func (*SetRatePlansRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetRatePlansRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *SetRatePlansRequest) GetRatePlans() []*RatePlan {
+	if x != nil {
+		return x.RatePlans
+	}
+	return nil
+}
+
+type SetRatePlansResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRatePlansResult) Reset() {
+	*x = SetRatePlansResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRatePlansResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRatePlansResult) ProtoMessage() {}
+
+func (x *SetRatePlansResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRatePlansResult.ProtoReflect.Descriptor instead.
+func (*SetRatePlansResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetRatePlansResult) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+type RoomType struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BookableRate       float64                `protobuf:"fixed64,1,opt,name=bookableRate,proto3" json:"bookableRate,omitempty"`
+	TotalRate          float64                `protobuf:"fixed64,2,opt,name=totalRate,proto3" json:"totalRate,omitempty"`
+	TotalRateInclusive float64                `protobuf:"fixed64,3,opt,name=totalRateInclusive,proto3" json:"totalRateInclusive,omitempty"`
+	Code               string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Currency           string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	RoomDescription    string                 `protobuf:"bytes,6,opt,name=roomDescription,proto3" json:"roomDescription,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RoomType) Reset() {
+	*x = RoomType{}
+	mi := &file_hotel_reservation_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomType) ProtoMessage() {}
+
+func (x *RoomType) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomType.ProtoReflect.Descriptor instead.
+func (*RoomType) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RoomType) GetBookableRate() float64 {
+	if x != nil {
+		return x.BookableRate
+	}
+	return 0
+}
+
+func (x *RoomType) GetTotalRate() float64 {
+	if x != nil {
+		return x.TotalRate
+	}
+	return 0
+}
+
+func (x *RoomType) GetTotalRateInclusive() float64 {
+	if x != nil {
+		return x.TotalRateInclusive
+	}
+	return 0
+}
+
+func (x *RoomType) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RoomType) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RoomType) GetRoomDescription() string {
+	if x != nil {
+		return x.RoomDescription
+	}
+	return ""
+}
+
+type ReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerName  string                 `protobuf:"bytes,1,opt,name=customerName,proto3" json:"customerName,omitempty"`
+	HotelId       []string               `protobuf:"bytes,2,rep,name=hotelId,proto3" json:"hotelId,omitempty"`
+	InDate        string                 `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate       string                 `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	RoomNumber    int32                  `protobuf:"varint,5,opt,name=roomNumber,proto3" json:"roomNumber,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationRequest) Reset() {
+	*x = ReservationRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationRequest) ProtoMessage() {}
+
+func (x *ReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationRequest.ProtoReflect.Descriptor instead.
+func (*ReservationRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReservationRequest) GetCustomerName() string {
+	if x != nil {
+		return x.CustomerName
+	}
+	return ""
+}
+
+func (x *ReservationRequest) GetHotelId() []string {
+	if x != nil {
+		return x.HotelId
+	}
+	return nil
+}
+
+func (x *ReservationRequest) GetInDate() string {
+	if x != nil {
+		return x.InDate
+	}
+	return ""
+}
+
+func (x *ReservationRequest) GetOutDate() string {
+	if x != nil {
+		return x.OutDate
+	}
+	return ""
+}
+
+func (x *ReservationRequest) GetRoomNumber() int32 {
+	if x != nil {
+		return x.RoomNumber
+	}
+	return 0
+}
+
+type ReservationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       []string               `protobuf:"bytes,1,rep,name=hotelId,proto3" json:"hotelId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationResult) Reset() {
+	*x = ReservationResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationResult) ProtoMessage() {}
+
+func (x *ReservationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationResult.ProtoReflect.Descriptor instead.
+func (*ReservationResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReservationResult) GetHotelId() []string {
+	if x != nil {
+		return x.HotelId
+	}
+	return nil
+}
+
+type SetCapacityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	NumberOfRoom  int32                  `protobuf:"varint,2,opt,name=numberOfRoom,proto3" json:"numberOfRoom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCapacityRequest) Reset() {
+	*x = SetCapacityRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCapacityRequest) ProtoMessage() {}
+
+func (x *SetCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCapacityRequest.ProtoReflect.Descriptor instead.
+func (*SetCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetCapacityRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *SetCapacityRequest) GetNumberOfRoom() int32 {
+	if x != nil {
+		return x.NumberOfRoom
+	}
+	return 0
+}
+
+type SetCapacityResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCapacityResult) Reset() {
+	*x = SetCapacityResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCapacityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCapacityResult) ProtoMessage() {}
+
+func (x *SetCapacityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCapacityResult.ProtoReflect.Descriptor instead.
+func (*SetCapacityResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetCapacityResult) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float32                `protobuf:"fixed32,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float32                `protobuf:"fixed32,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	InDate        string                 `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate       string                 `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchRequest) GetLat() float32 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetLon() float32 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetInDate() string {
+	if x != nil {
+		return x.InDate
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetOutDate() string {
+	if x != nil {
+		return x.OutDate
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelIds      []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchResult) GetHotelIds() []string {
+	if x != nil {
+		return x.HotelIds
+	}
+	return nil
+}
+
+type CheckUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUserRequest) Reset() {
+	*x = CheckUserRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserRequest) ProtoMessage() {}
+
+func (x *CheckUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserRequest.ProtoReflect.Descriptor instead.
+func (*CheckUserRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CheckUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CheckUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type CheckUserResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Correct bool                   `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+	// Seconds until the user may try again, set while repeated failures lock
+	// the user out.
+	RetryAfter int32 `protobuf:"varint,2,opt,name=retryAfter,proto3" json:"retryAfter,omitempty"`
+	// Role of the user: "guest", "hotel-manager" or "admin".
+	Role string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// Hotels a hotel manager manages.
+	Hotels []string `protobuf:"bytes,4,rep,name=hotels,proto3" json:"hotels,omitempty"`
+	// When the password was set, in Unix nanoseconds. Session tokens carry it,
+	// so changing the password or deleting the user revokes them.
+	PasswordChanged int64 `protobuf:"varint,5,opt,name=passwordChanged,proto3" json:"passwordChanged,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckUserResult) Reset() {
+	*x = CheckUserResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUserResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserResult) ProtoMessage() {}
+
+func (x *CheckUserResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserResult.ProtoReflect.Descriptor instead.
+func (*CheckUserResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CheckUserResult) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *CheckUserResult) GetRetryAfter() int32 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+func (x *CheckUserResult) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CheckUserResult) GetHotels() []string {
+	if x != nil {
+		return x.Hotels
+	}
+	return nil
+}
+
+func (x *CheckUserResult) GetPasswordChanged() int64 {
+	if x != nil {
+		return x.PasswordChanged
+	}
+	return 0
+}
+
+type RegisterUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterUserRequest) Reset() {
+	*x = RegisterUserRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterUserRequest) ProtoMessage() {}
+
+func (x *RegisterUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterUserRequest.ProtoReflect.Descriptor instead.
+func (*RegisterUserRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RegisterUserRequest) GetUsername() string {
 	if x != nil {
-		return x.OutDate
+		return x.Username
 	}
 	return ""
 }
 
-func (x *RatePlan) GetRoomType() *RoomType {
+func (x *RegisterUserRequest) GetPassword() string {
 	if x != nil {
-		return x.RoomType
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-type RoomType struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	BookableRate       float64                `protobuf:"fixed64,1,opt,name=bookableRate,proto3" json:"bookableRate,omitempty"`
-	TotalRate          float64                `protobuf:"fixed64,2,opt,name=totalRate,proto3" json:"totalRate,omitempty"`
-	TotalRateInclusive float64                `protobuf:"fixed64,3,opt,name=totalRateInclusive,proto3" json:"totalRateInclusive,omitempty"`
-	Code               string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
-	Currency           string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
-	RoomDescription    string                 `protobuf:"bytes,6,opt,name=roomDescription,proto3" json:"roomDescription,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type RegisterUserResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False when the username is taken.
+	Registered    bool `protobuf:"varint,1,opt,name=registered,proto3" json:"registered,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoomType) Reset() {
-	*x = RoomType{}
-	mi := &file_hotel_reservation_proto_msgTypes[12]
+func (x *RegisterUserResult) Reset() {
+	*x = RegisterUserResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoomType) String() string {
+func (x *RegisterUserResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomType) ProtoMessage() {}
+func (*RegisterUserResult) ProtoMessage() {}
 
-func (x *RoomType) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[12]
+func (x *RegisterUserResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,79 +1962,101 @@ func (x *RoomType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomType.ProtoReflect.Descriptor instead.
-func (*RoomType) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RegisterUserResult.ProtoReflect.Descriptor instead.
+func (*RegisterUserResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *RoomType) GetBookableRate() float64 {
+func (x *RegisterUserResult) GetRegistered() bool {
 	if x != nil {
-		return x.BookableRate
+		return x.Registered
 	}
-	return 0
+	return false
 }
 
-func (x *RoomType) GetTotalRate() float64 {
-	if x != nil {
-		return x.TotalRate
-	}
-	return 0
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoomType) GetTotalRateInclusive() float64 {
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[33]
 	if x != nil {
-		return x.TotalRateInclusive
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *RoomType) GetCode() string {
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ChangePasswordRequest) GetUsername() string {
 	if x != nil {
-		return x.Code
+		return x.Username
 	}
 	return ""
 }
 
-func (x *RoomType) GetCurrency() string {
+func (x *ChangePasswordRequest) GetPassword() string {
 	if x != nil {
-		return x.Currency
+		return x.Password
 	}
 	return ""
 }
 
-func (x *RoomType) GetRoomDescription() string {
+func (x *ChangePasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.RoomDescription
+		return x.NewPassword
 	}
 	return ""
 }
 
-type ReservationRequest struct {
+type ChangePasswordResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerName  string                 `protobuf:"bytes,1,opt,name=customerName,proto3" json:"customerName,omitempty"`
-	HotelId       []string               `protobuf:"bytes,2,rep,name=hotelId,proto3" json:"hotelId,omitempty"`
-	InDate        string                 `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
-	OutDate       string                 `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
-	RoomNumber    int32                  `protobuf:"varint,5,opt,name=roomNumber,proto3" json:"roomNumber,omitempty"`
+	Changed       bool                   `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+	RetryAfter    int32                  `protobuf:"varint,2,opt,name=retryAfter,proto3" json:"retryAfter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReservationRequest) Reset() {
-	*x = ReservationRequest{}
-	mi := &file_hotel_reservation_proto_msgTypes[13]
+func (x *ChangePasswordResult) Reset() {
+	*x = ChangePasswordResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReservationRequest) String() string {
+func (x *ChangePasswordResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReservationRequest) ProtoMessage() {}
+func (*ChangePasswordResult) ProtoMessage() {}
 
-func (x *ReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[13]
+func (x *ChangePasswordResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,68 +2067,48 @@ func (x *ReservationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReservationRequest.ProtoReflect.Descriptor instead.
-func (*ReservationRequest) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ReservationRequest) GetCustomerName() string {
-	if x != nil {
-		return x.CustomerName
-	}
-	return ""
-}
-
-func (x *ReservationRequest) GetHotelId() []string {
-	if x != nil {
-		return x.HotelId
-	}
-	return nil
-}
-
-func (x *ReservationRequest) GetInDate() string {
-	if x != nil {
-		return x.InDate
-	}
-	return ""
+// Deprecated: Use ChangePasswordResult.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ReservationRequest) GetOutDate() string {
+func (x *ChangePasswordResult) GetChanged() bool {
 	if x != nil {
-		return x.OutDate
+		return x.Changed
 	}
-	return ""
+	return false
 }
 
-func (x *ReservationRequest) GetRoomNumber() int32 {
+func (x *ChangePasswordResult) GetRetryAfter() int32 {
 	if x != nil {
-		return x.RoomNumber
+		return x.RetryAfter
 	}
 	return 0
 }
 
-type ReservationResult struct {
+type DeleteUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HotelId       []string               `protobuf:"bytes,1,rep,name=hotelId,proto3" json:"hotelId,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReservationResult) Reset() {
-	*x = ReservationResult{}
-	mi := &file_hotel_reservation_proto_msgTypes[14]
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReservationResult) String() string {
+func (x *DeleteUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReservationResult) ProtoMessage() {}
+func (*DeleteUserRequest) ProtoMessage() {}
 
-func (x *ReservationResult) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[14]
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,43 +2119,48 @@ func (x *ReservationResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReservationResult.ProtoReflect.Descriptor instead.
-func (*ReservationResult) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ReservationResult) GetHotelId() []string {
+func (x *DeleteUserRequest) GetUsername() string {
 	if x != nil {
-		return x.HotelId
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-type SearchRequest struct {
+func (x *DeleteUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteUserResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lat           float32                `protobuf:"fixed32,1,opt,name=lat,proto3" json:"lat,omitempty"`
-	Lon           float32                `protobuf:"fixed32,2,opt,name=lon,proto3" json:"lon,omitempty"`
-	InDate        string                 `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
-	OutDate       string                 `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	RetryAfter    int32                  `protobuf:"varint,2,opt,name=retryAfter,proto3" json:"retryAfter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchRequest) Reset() {
-	*x = SearchRequest{}
-	mi := &file_hotel_reservation_proto_msgTypes[15]
+func (x *DeleteUserResult) Reset() {
+	*x = DeleteUserResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchRequest) String() string {
+func (x *DeleteUserResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest) ProtoMessage() {}
+func (*DeleteUserResult) ProtoMessage() {}
 
-func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[15]
+func (x *DeleteUserResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,61 +2171,84 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
-func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DeleteUserResult.ProtoReflect.Descriptor instead.
+func (*DeleteUserResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SearchRequest) GetLat() float32 {
+func (x *DeleteUserResult) GetDeleted() bool {
 	if x != nil {
-		return x.Lat
+		return x.Deleted
 	}
-	return 0
+	return false
 }
 
-func (x *SearchRequest) GetLon() float32 {
+func (x *DeleteUserResult) GetRetryAfter() int32 {
 	if x != nil {
-		return x.Lon
+		return x.RetryAfter
 	}
 	return 0
 }
 
-func (x *SearchRequest) GetInDate() string {
-	if x != nil {
-		return x.InDate
-	}
-	return ""
+type ReloadUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchRequest) GetOutDate() string {
+func (x *ReloadUsersRequest) Reset() {
+	*x = ReloadUsersRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadUsersRequest) ProtoMessage() {}
+
+func (x *ReloadUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[37]
 	if x != nil {
-		return x.OutDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type SearchResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HotelIds      []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+// Deprecated: Use ReloadUsersRequest.ProtoReflect.Descriptor instead.
+func (*ReloadUsersRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{37}
+}
+
+type ReloadUsersResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of users loaded.
+	Count         int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchResult) Reset() {
-	*x = SearchResult{}
-	mi := &file_hotel_reservation_proto_msgTypes[16]
+func (x *ReloadUsersResult) Reset() {
+	*x = ReloadUsersResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchResult) String() string {
+func (x *ReloadUsersResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchResult) ProtoMessage() {}
+func (*ReloadUsersResult) ProtoMessage() {}
 
-func (x *SearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[16]
+func (x *ReloadUsersResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,41 +2259,42 @@ func (x *SearchResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
-func (*SearchResult) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ReloadUsersResult.ProtoReflect.Descriptor instead.
+func (*ReloadUsersResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *SearchResult) GetHotelIds() []string {
+func (x *ReloadUsersResult) GetCount() int32 {
 	if x != nil {
-		return x.HotelIds
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type CheckUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CheckSessionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// passwordChanged of the CheckUser call the token was issued after.
+	PasswordChanged int64 `protobuf:"varint,2,opt,name=passwordChanged,proto3" json:"passwordChanged,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CheckUserRequest) Reset() {
-	*x = CheckUserRequest{}
-	mi := &file_hotel_reservation_proto_msgTypes[17]
+func (x *CheckSessionRequest) Reset() {
+	*x = CheckSessionRequest{}
+	mi := &file_hotel_reservation_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckUserRequest) String() string {
+func (x *CheckSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckUserRequest) ProtoMessage() {}
+func (*CheckSessionRequest) ProtoMessage() {}
 
-func (x *CheckUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[17]
+func (x *CheckSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1092,47 +2305,48 @@ func (x *CheckUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckUserRequest.ProtoReflect.Descriptor instead.
-func (*CheckUserRequest) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CheckSessionRequest.ProtoReflect.Descriptor instead.
+func (*CheckSessionRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *CheckUserRequest) GetUsername() string {
+func (x *CheckSessionRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *CheckUserRequest) GetPassword() string {
+func (x *CheckSessionRequest) GetPasswordChanged() int64 {
 	if x != nil {
-		return x.Password
+		return x.PasswordChanged
 	}
-	return ""
+	return 0
 }
 
-type CheckUserResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Correct       bool                   `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+type CheckSessionResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False when the user changed the password or was deleted since.
+	Valid         bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckUserResult) Reset() {
-	*x = CheckUserResult{}
-	mi := &file_hotel_reservation_proto_msgTypes[18]
+func (x *CheckSessionResult) Reset() {
+	*x = CheckSessionResult{}
+	mi := &file_hotel_reservation_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckUserResult) String() string {
+func (x *CheckSessionResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckUserResult) ProtoMessage() {}
+func (*CheckSessionResult) ProtoMessage() {}
 
-func (x *CheckUserResult) ProtoReflect() protoreflect.Message {
-	mi := &file_hotel_reservation_proto_msgTypes[18]
+func (x *CheckSessionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_reservation_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,119 +2357,232 @@ func (x *CheckUserResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckUserResult.ProtoReflect.Descriptor instead.
-func (*CheckUserResult) Descriptor() ([]byte, []int) {
-	return file_hotel_reservation_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CheckSessionResult.ProtoReflect.Descriptor instead.
+func (*CheckSessionResult) Descriptor() ([]byte, []int) {
+	return file_hotel_reservation_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CheckUserResult) GetCorrect() bool {
+func (x *CheckSessionResult) GetValid() bool {
 	if x != nil {
-		return x.Correct
+		return x.Valid
 	}
 	return false
 }
 
+var file_hotel_reservation_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50001,
+		Name:          "hotel_reservation.is_public",
+		Tag:           "varint,50001,opt,name=is_public",
+		Filename:      "hotel_reservation.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional bool is_public = 50001;
+	E_IsPublic = &file_hotel_reservation_proto_extTypes[0]
+)
+
 var File_hotel_reservation_proto protoreflect.FileDescriptor
 
 const file_hotel_reservation_proto_rawDesc = "" +
 	"\n" +
-	"\x17hotel_reservation.proto\x12\x11hotel_reservation\"Q\n" +
-	"\rNearbyRequest\x12\x10\n" +
-	"\x03lat\x18\x01 \x01(\x02R\x03lat\x12\x10\n" +
-	"\x03lon\x18\x02 \x01(\x02R\x03lon\x12\x1c\n" +
-	"\tlatstring\x18\x03 \x01(\tR\tlatstring\"*\n" +
-	"\fNearbyResult\x12\x1a\n" +
-	"\bhotelIds\x18\x01 \x03(\tR\bhotelIds\"H\n" +
-	"\x12GetProfilesRequest\x12\x1a\n" +
-	"\bhotelIds\x18\x01 \x03(\tR\bhotelIds\x12\x16\n" +
-	"\x06locale\x18\x02 \x01(\tR\x06locale\"E\n" +
-	"\x11GetProfilesResult\x120\n" +
-	"\x06hotels\x18\x01 \x03(\v2\x18.hotel_reservation.HotelR\x06hotels\"\xd7\x01\n" +
-	"\x05Hotel\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vphoneNumber\x18\x03 \x01(\tR\vphoneNumber\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x124\n" +
-	"\aaddress\x18\x05 \x01(\v2\x1a.hotel_reservation.AddressR\aaddress\x120\n" +
-	"\x06images\x18\x06 \x03(\v2\x18.hotel_reservation.ImageR\x06images\"\xd5\x01\n" +
-	"\aAddress\x12\"\n" +
-	"\fstreetNumber\x18\x01 \x01(\tR\fstreetNumber\x12\x1e\n" +
+	"\x17hotel_reservation.proto\x12\x11hotel_reservation\x1a google/protobuf/descriptor.proto\"c\n" +
+	"\rNearbyRequest\x12\x16\n" +
+	"\x03lat\x18\x01 \x01(\x02B\x04\x88\xb5\x18\x01R\x03lat\x12\x16\n" +
+	"\x03lon\x18\x02 \x01(\x02B\x04\x88\xb5\x18\x01R\x03lon\x12\"\n" +
+	"\tlatstring\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\tlatstring\"0\n" +
+	"\fNearbyResult\x12 \n" +
+	"\bhotelIds\x18\x01 \x03(\tB\x04\x88\xb5\x18\x01R\bhotelIds\"d\n" +
+	"\x12SetLocationRequest\x12\x1e\n" +
+	"\ahotelId\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\ahotelId\x12\x16\n" +
+	"\x03lat\x18\x02 \x01(\x02B\x04\x88\xb5\x18\x01R\x03lat\x12\x16\n" +
+	"\x03lon\x18\x03 \x01(\x02B\x04\x88\xb5\x18\x01R\x03lon\"3\n" +
+	"\x11SetLocationResult\x12\x1e\n" +
+	"\ahotelId\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\ahotelId\"r\n" +
+	"\x12GetProfilesRequest\x12 \n" +
+	"\bhotelIds\x18\x01 \x03(\tB\x04\x88\xb5\x18\x01R\bhotelIds\x12\x1c\n" +
+	"\x06locale\x18\x02 \x01(\tB\x04\x88\xb5\x18\x01R\x06locale\x12\x1c\n" +
+	"\x06fields\x18\x03 \x03(\tB\x04\x88\xb5\x18\x01R\x06fields\"K\n" +
+	"\x11GetProfilesResult\x126\n" +
+	"\x06hotels\x18\x01 \x03(\v2\x18.hotel_reservation.HotelB\x04\x88\xb5\x18\x01R\x06hotels\"\xfb\x01\n" +
+	"\x05Hotel\x12\x14\n" +
+	"\x02id\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\x02id\x12\x18\n" +
+	"\x04name\x18\x02 \x01(\tB\x04\x88\xb5\x18\x01R\x04name\x12&\n" +
+	"\vphoneNumber\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\vphoneNumber\x12&\n" +
+	"\vdescription\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\vdescription\x12:\n" +
+	"\aaddress\x18\x05 \x01(\v2\x1a.hotel_reservation.AddressB\x04\x88\xb5\x18\x01R\aaddress\x126\n" +
+	"\x06images\x18\x06 \x03(\v2\x18.hotel_reservation.ImageB\x04\x88\xb5\x18\x01R\x06images\"\x85\x02\n" +
+	"\aAddress\x12(\n" +
+	"\fstreetNumber\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\fstreetNumber\x12$\n" +
+	"\n" +
+	"streetName\x18\x02 \x01(\tB\x04\x88\xb5\x18\x01R\n" +
+	"streetName\x12\x18\n" +
+	"\x04city\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\x04city\x12\x1a\n" +
+	"\x05state\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\x05state\x12\x1e\n" +
+	"\acountry\x18\x05 \x01(\tB\x04\x88\xb5\x18\x01R\acountry\x12$\n" +
 	"\n" +
-	"streetName\x18\x02 \x01(\tR\n" +
-	"streetName\x12\x12\n" +
-	"\x04city\x18\x03 \x01(\tR\x04city\x12\x14\n" +
-	"\x05state\x18\x04 \x01(\tR\x05state\x12\x18\n" +
-	"\acountry\x18\x05 \x01(\tR\acountry\x12\x1e\n" +
+	"postalCode\x18\x06 \x01(\tB\x04\x88\xb5\x18\x01R\n" +
+	"postalCode\x12\x16\n" +
+	"\x03lat\x18\a \x01(\x02B\x04\x88\xb5\x18\x01R\x03lat\x12\x16\n" +
+	"\x03lon\x18\b \x01(\x02B\x04\x88\xb5\x18\x01R\x03lon\"?\n" +
+	"\x05Image\x12\x16\n" +
+	"\x03url\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\x03url\x12\x1e\n" +
+	"\adefault\x18\x02 \x01(\bB\x04\x88\xb5\x18\x01R\adefault\"\xdd\x01\n" +
+	"\fHotelRequest\x124\n" +
+	"\x05hotel\x18\x01 \x01(\v2\x18.hotel_reservation.HotelB\x04\x88\xb5\x18\x01R\x05hotel\x12?\n" +
+	"\tratePlans\x18\x02 \x03(\v2\x1b.hotel_reservation.RatePlanB\x04\x88\xb5\x18\x01R\tratePlans\x12\x18\n" +
+	"\x04rate\x18\x03 \x01(\x01B\x04\x88\xb5\x18\x01R\x04rate\x12\x1a\n" +
+	"\x05price\x18\x04 \x01(\x01B\x04\x88\xb5\x18\x01R\x05price\x12 \n" +
+	"\bcapacity\x18\x05 \x01(\x05B\x04\x88\xb5\x18\x01R\bcapacity\"-\n" +
+	"\vHotelResult\x12\x1e\n" +
+	"\ahotelId\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\ahotelId\"\x8d\x02\n" +
+	"\x19GetRecommendationsRequest\x12\x1e\n" +
+	"\arequire\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\arequire\x12\x16\n" +
+	"\x03lat\x18\x02 \x01(\x01B\x04\x88\xb5\x18\x01R\x03lat\x12\x16\n" +
+	"\x03lon\x18\x03 \x01(\x01B\x04\x88\xb5\x18\x01R\x03lon\x12\x12\n" +
+	"\x01k\x18\x04 \x01(\x05B\x04\x88\xb5\x18\x01R\x01k\x12\"\n" +
+	"\tdisWeight\x18\x05 \x01(\x01B\x04\x88\xb5\x18\x01R\tdisWeight\x12$\n" +
 	"\n" +
-	"postalCode\x18\x06 \x01(\tR\n" +
-	"postalCode\x12\x10\n" +
-	"\x03lat\x18\a \x01(\x02R\x03lat\x12\x10\n" +
-	"\x03lon\x18\b \x01(\x02R\x03lon\"3\n" +
-	"\x05Image\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\x12\x18\n" +
-	"\adefault\x18\x02 \x01(\bR\adefault\"Y\n" +
-	"\x19GetRecommendationsRequest\x12\x18\n" +
-	"\arequire\x18\x01 \x01(\tR\arequire\x12\x10\n" +
-	"\x03lat\x18\x02 \x01(\x01R\x03lat\x12\x10\n" +
-	"\x03lon\x18\x03 \x01(\x01R\x03lon\"6\n" +
-	"\x18GetRecommendationsResult\x12\x1a\n" +
-	"\bHotelIds\x18\x01 \x03(\tR\bHotelIds\"_\n" +
-	"\x0fGetRatesRequest\x12\x1a\n" +
-	"\bhotelIds\x18\x01 \x03(\tR\bhotelIds\x12\x16\n" +
-	"\x06inDate\x18\x02 \x01(\tR\x06inDate\x12\x18\n" +
-	"\aoutDate\x18\x03 \x01(\tR\aoutDate\"K\n" +
-	"\x0eGetRatesResult\x129\n" +
-	"\tratePlans\x18\x01 \x03(\v2\x1b.hotel_reservation.RatePlanR\tratePlans\"\xa3\x01\n" +
-	"\bRatePlan\x12\x18\n" +
-	"\ahotelId\x18\x01 \x01(\tR\ahotelId\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
-	"\x06inDate\x18\x03 \x01(\tR\x06inDate\x12\x18\n" +
-	"\aoutDate\x18\x04 \x01(\tR\aoutDate\x127\n" +
-	"\broomType\x18\x05 \x01(\v2\x1b.hotel_reservation.RoomTypeR\broomType\"\xd6\x01\n" +
-	"\bRoomType\x12\"\n" +
-	"\fbookableRate\x18\x01 \x01(\x01R\fbookableRate\x12\x1c\n" +
-	"\ttotalRate\x18\x02 \x01(\x01R\ttotalRate\x12.\n" +
-	"\x12totalRateInclusive\x18\x03 \x01(\x01R\x12totalRateInclusive\x12\x12\n" +
-	"\x04code\x18\x04 \x01(\tR\x04code\x12\x1a\n" +
-	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12(\n" +
-	"\x0froomDescription\x18\x06 \x01(\tR\x0froomDescription\"\xa4\x01\n" +
+	"rateWeight\x18\x06 \x01(\x01B\x04\x88\xb5\x18\x01R\n" +
+	"rateWeight\x12&\n" +
+	"\vpriceWeight\x18\a \x01(\x01B\x04\x88\xb5\x18\x01R\vpriceWeight\x12\x1a\n" +
+	"\busername\x18\b \x01(\tR\busername\"Z\n" +
+	"\x18GetRecommendationsResult\x12 \n" +
+	"\bHotelIds\x18\x01 \x03(\tB\x04\x88\xb5\x18\x01R\bHotelIds\x12\x1c\n" +
+	"\x06scores\x18\x02 \x03(\x01B\x04\x88\xb5\x18\x01R\x06scores\"\x97\x01\n" +
+	"\x0fSetHotelRequest\x12\x1e\n" +
+	"\ahotelId\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\ahotelId\x12\x16\n" +
+	"\x03lat\x18\x02 \x01(\x01B\x04\x88\xb5\x18\x01R\x03lat\x12\x16\n" +
+	"\x03lon\x18\x03 \x01(\x01B\x04\x88\xb5\x18\x01R\x03lon\x12\x18\n" +
+	"\x04rate\x18\x04 \x01(\x01B\x04\x88\xb5\x18\x01R\x04rate\x12\x1a\n" +
+	"\x05price\x18\x05 \x01(\x01B\x04\x88\xb5\x18\x01R\x05price\"0\n" +
+	"\x0eSetHotelResult\x12\x1e\n" +
+	"\ahotelId\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\ahotelId\"\x15\n" +
+	"\x13ReloadHotelsRequest\"0\n" +
+	"\x12ReloadHotelsResult\x12\x1a\n" +
+	"\x05count\x18\x01 \x01(\x05B\x04\x88\xb5\x18\x01R\x05count\"q\n" +
+	"\x0fGetRatesRequest\x12 \n" +
+	"\bhotelIds\x18\x01 \x03(\tB\x04\x88\xb5\x18\x01R\bhotelIds\x12\x1c\n" +
+	"\x06inDate\x18\x02 \x01(\tB\x04\x88\xb5\x18\x01R\x06inDate\x12\x1e\n" +
+	"\aoutDate\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\aoutDate\"Q\n" +
+	"\x0eGetRatesResult\x12?\n" +
+	"\tratePlans\x18\x01 \x03(\v2\x1b.hotel_reservation.RatePlanB\x04\x88\xb5\x18\x01R\tratePlans\"\xc1\x01\n" +
+	"\bRatePlan\x12\x1e\n" +
+	"\ahotelId\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\ahotelId\x12\x18\n" +
+	"\x04code\x18\x02 \x01(\tB\x04\x88\xb5\x18\x01R\x04code\x12\x1c\n" +
+	"\x06inDate\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\x06inDate\x12\x1e\n" +
+	"\aoutDate\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\aoutDate\x12=\n" +
+	"\broomType\x18\x05 \x01(\v2\x1b.hotel_reservation.RoomTypeB\x04\x88\xb5\x18\x01R\broomType\"v\n" +
+	"\x13SetRatePlansRequest\x12\x1e\n" +
+	"\ahotelId\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\ahotelId\x12?\n" +
+	"\tratePlans\x18\x02 \x03(\v2\x1b.hotel_reservation.RatePlanB\x04\x88\xb5\x18\x01R\tratePlans\"4\n" +
+	"\x12SetRatePlansResult\x12\x1e\n" +
+	"\ahotelId\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\ahotelId\"\xfa\x01\n" +
+	"\bRoomType\x12(\n" +
+	"\fbookableRate\x18\x01 \x01(\x01B\x04\x88\xb5\x18\x01R\fbookableRate\x12\"\n" +
+	"\ttotalRate\x18\x02 \x01(\x01B\x04\x88\xb5\x18\x01R\ttotalRate\x124\n" +
+	"\x12totalRateInclusive\x18\x03 \x01(\x01B\x04\x88\xb5\x18\x01R\x12totalRateInclusive\x12\x18\n" +
+	"\x04code\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\x04code\x12 \n" +
+	"\bcurrency\x18\x05 \x01(\tB\x04\x88\xb5\x18\x01R\bcurrency\x12.\n" +
+	"\x0froomDescription\x18\x06 \x01(\tB\x04\x88\xb5\x18\x01R\x0froomDescription\"\xbc\x01\n" +
 	"\x12ReservationRequest\x12\"\n" +
-	"\fcustomerName\x18\x01 \x01(\tR\fcustomerName\x12\x18\n" +
-	"\ahotelId\x18\x02 \x03(\tR\ahotelId\x12\x16\n" +
-	"\x06inDate\x18\x03 \x01(\tR\x06inDate\x12\x18\n" +
-	"\aoutDate\x18\x04 \x01(\tR\aoutDate\x12\x1e\n" +
+	"\fcustomerName\x18\x01 \x01(\tR\fcustomerName\x12\x1e\n" +
+	"\ahotelId\x18\x02 \x03(\tB\x04\x88\xb5\x18\x01R\ahotelId\x12\x1c\n" +
+	"\x06inDate\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\x06inDate\x12\x1e\n" +
+	"\aoutDate\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\aoutDate\x12$\n" +
 	"\n" +
-	"roomNumber\x18\x05 \x01(\x05R\n" +
-	"roomNumber\"-\n" +
-	"\x11ReservationResult\x12\x18\n" +
-	"\ahotelId\x18\x01 \x03(\tR\ahotelId\"e\n" +
-	"\rSearchRequest\x12\x10\n" +
-	"\x03lat\x18\x01 \x01(\x02R\x03lat\x12\x10\n" +
-	"\x03lon\x18\x02 \x01(\x02R\x03lon\x12\x16\n" +
-	"\x06inDate\x18\x03 \x01(\tR\x06inDate\x12\x18\n" +
-	"\aoutDate\x18\x04 \x01(\tR\aoutDate\"*\n" +
-	"\fSearchResult\x12\x1a\n" +
-	"\bhotelIds\x18\x01 \x03(\tR\bhotelIds\"J\n" +
+	"roomNumber\x18\x05 \x01(\x05B\x04\x88\xb5\x18\x01R\n" +
+	"roomNumber\"3\n" +
+	"\x11ReservationResult\x12\x1e\n" +
+	"\ahotelId\x18\x01 \x03(\tB\x04\x88\xb5\x18\x01R\ahotelId\"^\n" +
+	"\x12SetCapacityRequest\x12\x1e\n" +
+	"\ahotelId\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\ahotelId\x12(\n" +
+	"\fnumberOfRoom\x18\x02 \x01(\x05B\x04\x88\xb5\x18\x01R\fnumberOfRoom\"3\n" +
+	"\x11SetCapacityResult\x12\x1e\n" +
+	"\ahotelId\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\ahotelId\"}\n" +
+	"\rSearchRequest\x12\x16\n" +
+	"\x03lat\x18\x01 \x01(\x02B\x04\x88\xb5\x18\x01R\x03lat\x12\x16\n" +
+	"\x03lon\x18\x02 \x01(\x02B\x04\x88\xb5\x18\x01R\x03lon\x12\x1c\n" +
+	"\x06inDate\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\x06inDate\x12\x1e\n" +
+	"\aoutDate\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\aoutDate\"0\n" +
+	"\fSearchResult\x12 \n" +
+	"\bhotelIds\x18\x01 \x03(\tB\x04\x88\xb5\x18\x01R\bhotelIds\"J\n" +
 	"\x10CheckUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"+\n" +
-	"\x0fCheckUserResult\x12\x18\n" +
-	"\acorrect\x18\x01 \x01(\bR\acorrect2U\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xbf\x01\n" +
+	"\x0fCheckUserResult\x12\x1e\n" +
+	"\acorrect\x18\x01 \x01(\bB\x04\x88\xb5\x18\x01R\acorrect\x12$\n" +
+	"\n" +
+	"retryAfter\x18\x02 \x01(\x05B\x04\x88\xb5\x18\x01R\n" +
+	"retryAfter\x12\x18\n" +
+	"\x04role\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\x04role\x12\x1c\n" +
+	"\x06hotels\x18\x04 \x03(\tB\x04\x88\xb5\x18\x01R\x06hotels\x12.\n" +
+	"\x0fpasswordChanged\x18\x05 \x01(\x03B\x04\x88\xb5\x18\x01R\x0fpasswordChanged\"M\n" +
+	"\x13RegisterUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\":\n" +
+	"\x12RegisterUserResult\x12$\n" +
+	"\n" +
+	"registered\x18\x01 \x01(\bB\x04\x88\xb5\x18\x01R\n" +
+	"registered\"q\n" +
+	"\x15ChangePasswordRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12 \n" +
+	"\vnewPassword\x18\x03 \x01(\tR\vnewPassword\"\\\n" +
+	"\x14ChangePasswordResult\x12\x1e\n" +
+	"\achanged\x18\x01 \x01(\bB\x04\x88\xb5\x18\x01R\achanged\x12$\n" +
+	"\n" +
+	"retryAfter\x18\x02 \x01(\x05B\x04\x88\xb5\x18\x01R\n" +
+	"retryAfter\"K\n" +
+	"\x11DeleteUserRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"X\n" +
+	"\x10DeleteUserResult\x12\x1e\n" +
+	"\adeleted\x18\x01 \x01(\bB\x04\x88\xb5\x18\x01R\adeleted\x12$\n" +
+	"\n" +
+	"retryAfter\x18\x02 \x01(\x05B\x04\x88\xb5\x18\x01R\n" +
+	"retryAfter\"\x14\n" +
+	"\x12ReloadUsersRequest\"/\n" +
+	"\x11ReloadUsersResult\x12\x1a\n" +
+	"\x05count\x18\x01 \x01(\x05B\x04\x88\xb5\x18\x01R\x05count\"a\n" +
+	"\x13CheckSessionRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12.\n" +
+	"\x0fpasswordChanged\x18\x02 \x01(\x03B\x04\x88\xb5\x18\x01R\x0fpasswordChanged\"0\n" +
+	"\x12CheckSessionResult\x12\x1a\n" +
+	"\x05valid\x18\x01 \x01(\bB\x04\x88\xb5\x18\x01R\x05valid2\xb1\x01\n" +
 	"\x03Geo\x12N\n" +
-	"\tNearbyGeo\x12 .hotel_reservation.NearbyRequest\x1a\x1f.hotel_reservation.NearbyResult2e\n" +
+	"\tNearbyGeo\x12 .hotel_reservation.NearbyRequest\x1a\x1f.hotel_reservation.NearbyResult\x12Z\n" +
+	"\vSetLocation\x12%.hotel_reservation.SetLocationRequest\x1a$.hotel_reservation.SetLocationResult2\x85\x02\n" +
 	"\aProfile\x12Z\n" +
-	"\vGetProfiles\x12%.hotel_reservation.GetProfilesRequest\x1a$.hotel_reservation.GetProfilesResult2\x81\x01\n" +
+	"\vGetProfiles\x12%.hotel_reservation.GetProfilesRequest\x1a$.hotel_reservation.GetProfilesResult\x12N\n" +
+	"\vCreateHotel\x12\x1f.hotel_reservation.HotelRequest\x1a\x1e.hotel_reservation.HotelResult\x12N\n" +
+	"\vUpdateHotel\x12\x1f.hotel_reservation.HotelRequest\x1a\x1e.hotel_reservation.HotelResult2\xb3\x02\n" +
 	"\x0eRecommendation\x12o\n" +
-	"\x12GetRecommendations\x12,.hotel_reservation.GetRecommendationsRequest\x1a+.hotel_reservation.GetRecommendationsResult2Y\n" +
+	"\x12GetRecommendations\x12,.hotel_reservation.GetRecommendationsRequest\x1a+.hotel_reservation.GetRecommendationsResult\x12Q\n" +
+	"\bSetHotel\x12\".hotel_reservation.SetHotelRequest\x1a!.hotel_reservation.SetHotelResult\x12]\n" +
+	"\fReloadHotels\x12&.hotel_reservation.ReloadHotelsRequest\x1a%.hotel_reservation.ReloadHotelsResult2\xb8\x01\n" +
 	"\x04Rate\x12Q\n" +
-	"\bGetRates\x12\".hotel_reservation.GetRatesRequest\x1a!.hotel_reservation.GetRatesResult2\xcf\x01\n" +
+	"\bGetRates\x12\".hotel_reservation.GetRatesRequest\x1a!.hotel_reservation.GetRatesResult\x12]\n" +
+	"\fSetRatePlans\x12&.hotel_reservation.SetRatePlansRequest\x1a%.hotel_reservation.SetRatePlansResult2\xab\x02\n" +
 	"\vReservation\x12^\n" +
 	"\x0fMakeReservation\x12%.hotel_reservation.ReservationRequest\x1a$.hotel_reservation.ReservationResult\x12`\n" +
-	"\x11CheckAvailability\x12%.hotel_reservation.ReservationRequest\x1a$.hotel_reservation.ReservationResult2U\n" +
+	"\x11CheckAvailability\x12%.hotel_reservation.ReservationRequest\x1a$.hotel_reservation.ReservationResult\x12Z\n" +
+	"\vSetCapacity\x12%.hotel_reservation.SetCapacityRequest\x1a$.hotel_reservation.SetCapacityResult2U\n" +
 	"\x06Search\x12K\n" +
-	"\x06Nearby\x12 .hotel_reservation.SearchRequest\x1a\x1f.hotel_reservation.SearchResult2\\\n" +
+	"\x06Nearby\x12 .hotel_reservation.SearchRequest\x1a\x1f.hotel_reservation.SearchResult2\xb4\x04\n" +
 	"\x04User\x12T\n" +
-	"\tCheckUser\x12#.hotel_reservation.CheckUserRequest\x1a\".hotel_reservation.CheckUserResultB\x15Z\x13./hotel_reservationb\x06proto3"
+	"\tCheckUser\x12#.hotel_reservation.CheckUserRequest\x1a\".hotel_reservation.CheckUserResult\x12]\n" +
+	"\fRegisterUser\x12&.hotel_reservation.RegisterUserRequest\x1a%.hotel_reservation.RegisterUserResult\x12c\n" +
+	"\x0eChangePassword\x12(.hotel_reservation.ChangePasswordRequest\x1a'.hotel_reservation.ChangePasswordResult\x12W\n" +
+	"\n" +
+	"DeleteUser\x12$.hotel_reservation.DeleteUserRequest\x1a#.hotel_reservation.DeleteUserResult\x12Z\n" +
+	"\vReloadUsers\x12%.hotel_reservation.ReloadUsersRequest\x1a$.hotel_reservation.ReloadUsersResult\x12]\n" +
+	"\fCheckSession\x12&.hotel_reservation.CheckSessionRequest\x1a%.hotel_reservation.CheckSessionResult:<\n" +
+	"\tis_public\x12\x1d.google.protobuf.FieldOptions\x18ц\x03 \x01(\bR\bisPublicB\x15Z\x13./hotel_reservationb\x06proto3"
 
 var (
 	file_hotel_reservation_proto_rawDescOnce sync.Once
@@ -1269,55 +2596,106 @@ func file_hotel_reservation_proto_rawDescGZIP() []byte {
 	return file_hotel_reservation_proto_rawDescData
 }
 
-var file_hotel_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_hotel_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_hotel_reservation_proto_goTypes = []any{
 	(*NearbyRequest)(nil),             // 0: hotel_reservation.NearbyRequest
 	(*NearbyResult)(nil),              // 1: hotel_reservation.NearbyResult
-	(*GetProfilesRequest)(nil),        // 2: hotel_reservation.GetProfilesRequest
-	(*GetProfilesResult)(nil),         // 3: hotel_reservation.GetProfilesResult
-	(*Hotel)(nil),                     // 4: hotel_reservation.Hotel
-	(*Address)(nil),                   // 5: hotel_reservation.Address
-	(*Image)(nil),                     // 6: hotel_reservation.Image
-	(*GetRecommendationsRequest)(nil), // 7: hotel_reservation.GetRecommendationsRequest
-	(*GetRecommendationsResult)(nil),  // 8: hotel_reservation.GetRecommendationsResult
-	(*GetRatesRequest)(nil),           // 9: hotel_reservation.GetRatesRequest
-	(*GetRatesResult)(nil),            // 10: hotel_reservation.GetRatesResult
-	(*RatePlan)(nil),                  // 11: hotel_reservation.RatePlan
-	(*RoomType)(nil),                  // 12: hotel_reservation.RoomType
-	(*ReservationRequest)(nil),        // 13: hotel_reservation.ReservationRequest
-	(*ReservationResult)(nil),         // 14: hotel_reservation.ReservationResult
-	(*SearchRequest)(nil),             // 15: hotel_reservation.SearchRequest
-	(*SearchResult)(nil),              // 16: hotel_reservation.SearchResult
-	(*CheckUserRequest)(nil),          // 17: hotel_reservation.CheckUserRequest
-	(*CheckUserResult)(nil),           // 18: hotel_reservation.CheckUserResult
+	(*SetLocationRequest)(nil),        // 2: hotel_reservation.SetLocationRequest
+	(*SetLocationResult)(nil),         // 3: hotel_reservation.SetLocationResult
+	(*GetProfilesRequest)(nil),        // 4: hotel_reservation.GetProfilesRequest
+	(*GetProfilesResult)(nil),         // 5: hotel_reservation.GetProfilesResult
+	(*Hotel)(nil),                     // 6: hotel_reservation.Hotel
+	(*Address)(nil),                   // 7: hotel_reservation.Address
+	(*Image)(nil),                     // 8: hotel_reservation.Image
+	(*HotelRequest)(nil),              // 9: hotel_reservation.HotelRequest
+	(*HotelResult)(nil),               // 10: hotel_reservation.HotelResult
+	(*GetRecommendationsRequest)(nil), // 11: hotel_reservation.GetRecommendationsRequest
+	(*GetRecommendationsResult)(nil),  // 12: hotel_reservation.GetRecommendationsResult
+	(*SetHotelRequest)(nil),           // 13: hotel_reservation.SetHotelRequest
+	(*SetHotelResult)(nil),            // 14: hotel_reservation.SetHotelResult
+	(*ReloadHotelsRequest)(nil),       // 15: hotel_reservation.ReloadHotelsRequest
+	(*ReloadHotelsResult)(nil),        // 16: hotel_reservation.ReloadHotelsResult
+	(*GetRatesRequest)(nil),           // 17: hotel_reservation.GetRatesRequest
+	(*GetRatesResult)(nil),            // 18: hotel_reservation.GetRatesResult
+	(*RatePlan)(nil),                  // 19: hotel_reservation.RatePlan
+	(*SetRatePlansRequest)(nil),       // 20: hotel_reservation.SetRatePlansRequest
+	(*SetRatePlansResult)(nil),        // 21: hotel_reservation.SetRatePlansResult
+	(*RoomType)(nil),                  // 22: hotel_reservation.RoomType
+	(*ReservationRequest)(nil),        // 23: hotel_reservation.ReservationRequest
+	(*ReservationResult)(nil),         // 24: hotel_reservation.ReservationResult
+	(*SetCapacityRequest)(nil),        // 25: hotel_reservation.SetCapacityRequest
+	(*SetCapacityResult)(nil),         // 26: hotel_reservation.SetCapacityResult
+	(*SearchRequest)(nil),             // 27: hotel_reservation.SearchRequest
+	(*SearchResult)(nil),              // 28: hotel_reservation.SearchResult
+	(*CheckUserRequest)(nil),          // 29: hotel_reservation.CheckUserRequest
+	(*CheckUserResult)(nil),           // 30: hotel_reservation.CheckUserResult
+	(*RegisterUserRequest)(nil),       // 31: hotel_reservation.RegisterUserRequest
+	(*RegisterUserResult)(nil),        // 32: hotel_reservation.RegisterUserResult
+	(*ChangePasswordRequest)(nil),     // 33: hotel_reservation.ChangePasswordRequest
+	(*ChangePasswordResult)(nil),      // 34: hotel_reservation.ChangePasswordResult
+	(*DeleteUserRequest)(nil),         // 35: hotel_reservation.DeleteUserRequest
+	(*DeleteUserResult)(nil),          // 36: hotel_reservation.DeleteUserResult
+	(*ReloadUsersRequest)(nil),        // 37: hotel_reservation.ReloadUsersRequest
+	(*ReloadUsersResult)(nil),         // 38: hotel_reservation.ReloadUsersResult
+	(*CheckSessionRequest)(nil),       // 39: hotel_reservation.CheckSessionRequest
+	(*CheckSessionResult)(nil),        // 40: hotel_reservation.CheckSessionResult
+	(*descriptorpb.FieldOptions)(nil), // 41: google.protobuf.FieldOptions
 }
 var file_hotel_reservation_proto_depIdxs = []int32{
-	4,  // 0: hotel_reservation.GetProfilesResult.hotels:type_name -> hotel_reservation.Hotel
-	5,  // 1: hotel_reservation.Hotel.address:type_name -> hotel_reservation.Address
-	6,  // 2: hotel_reservation.Hotel.images:type_name -> hotel_reservation.Image
-	11, // 3: hotel_reservation.GetRatesResult.ratePlans:type_name -> hotel_reservation.RatePlan
-	12, // 4: hotel_reservation.RatePlan.roomType:type_name -> hotel_reservation.RoomType
-	0,  // 5: hotel_reservation.Geo.NearbyGeo:input_type -> hotel_reservation.NearbyRequest
-	2,  // 6: hotel_reservation.Profile.GetProfiles:input_type -> hotel_reservation.GetProfilesRequest
-	7,  // 7: hotel_reservation.Recommendation.GetRecommendations:input_type -> hotel_reservation.GetRecommendationsRequest
-	9,  // 8: hotel_reservation.Rate.GetRates:input_type -> hotel_reservation.GetRatesRequest
-	13, // 9: hotel_reservation.Reservation.MakeReservation:input_type -> hotel_reservation.ReservationRequest
-	13, // 10: hotel_reservation.Reservation.CheckAvailability:input_type -> hotel_reservation.ReservationRequest
-	15, // 11: hotel_reservation.Search.Nearby:input_type -> hotel_reservation.SearchRequest
-	17, // 12: hotel_reservation.User.CheckUser:input_type -> hotel_reservation.CheckUserRequest
-	1,  // 13: hotel_reservation.Geo.NearbyGeo:output_type -> hotel_reservation.NearbyResult
-	3,  // 14: hotel_reservation.Profile.GetProfiles:output_type -> hotel_reservation.GetProfilesResult
-	8,  // 15: hotel_reservation.Recommendation.GetRecommendations:output_type -> hotel_reservation.GetRecommendationsResult
-	10, // 16: hotel_reservation.Rate.GetRates:output_type -> hotel_reservation.GetRatesResult
-	14, // 17: hotel_reservation.Reservation.MakeReservation:output_type -> hotel_reservation.ReservationResult
-	14, // 18: hotel_reservation.Reservation.CheckAvailability:output_type -> hotel_reservation.ReservationResult
-	16, // 19: hotel_reservation.Search.Nearby:output_type -> hotel_reservation.SearchResult
-	18, // 20: hotel_reservation.User.CheckUser:output_type -> hotel_reservation.CheckUserResult
-	13, // [13:21] is the sub-list for method output_type
-	5,  // [5:13] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	6,  // 0: hotel_reservation.GetProfilesResult.hotels:type_name -> hotel_reservation.Hotel
+	7,  // 1: hotel_reservation.Hotel.address:type_name -> hotel_reservation.Address
+	8,  // 2: hotel_reservation.Hotel.images:type_name -> hotel_reservation.Image
+	6,  // 3: hotel_reservation.HotelRequest.hotel:type_name -> hotel_reservation.Hotel
+	19, // 4: hotel_reservation.HotelRequest.ratePlans:type_name -> hotel_reservation.RatePlan
+	19, // 5: hotel_reservation.GetRatesResult.ratePlans:type_name -> hotel_reservation.RatePlan
+	22, // 6: hotel_reservation.RatePlan.roomType:type_name -> hotel_reservation.RoomType
+	19, // 7: hotel_reservation.SetRatePlansRequest.ratePlans:type_name -> hotel_reservation.RatePlan
+	41, // 8: hotel_reservation.is_public:extendee -> google.protobuf.FieldOptions
+	0,  // 9: hotel_reservation.Geo.NearbyGeo:input_type -> hotel_reservation.NearbyRequest
+	2,  // 10: hotel_reservation.Geo.SetLocation:input_type -> hotel_reservation.SetLocationRequest
+	4,  // 11: hotel_reservation.Profile.GetProfiles:input_type -> hotel_reservation.GetProfilesRequest
+	9,  // 12: hotel_reservation.Profile.CreateHotel:input_type -> hotel_reservation.HotelRequest
+	9,  // 13: hotel_reservation.Profile.UpdateHotel:input_type -> hotel_reservation.HotelRequest
+	11, // 14: hotel_reservation.Recommendation.GetRecommendations:input_type -> hotel_reservation.GetRecommendationsRequest
+	13, // 15: hotel_reservation.Recommendation.SetHotel:input_type -> hotel_reservation.SetHotelRequest
+	15, // 16: hotel_reservation.Recommendation.ReloadHotels:input_type -> hotel_reservation.ReloadHotelsRequest
+	17, // 17: hotel_reservation.Rate.GetRates:input_type -> hotel_reservation.GetRatesRequest
+	20, // 18: hotel_reservation.Rate.SetRatePlans:input_type -> hotel_reservation.SetRatePlansRequest
+	23, // 19: hotel_reservation.Reservation.MakeReservation:input_type -> hotel_reservation.ReservationRequest
+	23, // 20: hotel_reservation.Reservation.CheckAvailability:input_type -> hotel_reservation.ReservationRequest
+	25, // 21: hotel_reservation.Reservation.SetCapacity:input_type -> hotel_reservation.SetCapacityRequest
+	27, // 22: hotel_reservation.Search.Nearby:input_type -> hotel_reservation.SearchRequest
+	29, // 23: hotel_reservation.User.CheckUser:input_type -> hotel_reservation.CheckUserRequest
+	31, // 24: hotel_reservation.User.RegisterUser:input_type -> hotel_reservation.RegisterUserRequest
+	33, // 25: hotel_reservation.User.ChangePassword:input_type -> hotel_reservation.ChangePasswordRequest
+	35, // 26: hotel_reservation.User.DeleteUser:input_type -> hotel_reservation.DeleteUserRequest
+	37, // 27: hotel_reservation.User.ReloadUsers:input_type -> hotel_reservation.ReloadUsersRequest
+	39, // 28: hotel_reservation.User.CheckSession:input_type -> hotel_reservation.CheckSessionRequest
+	1,  // 29: hotel_reservation.Geo.NearbyGeo:output_type -> hotel_reservation.NearbyResult
+	3,  // 30: hotel_reservation.Geo.SetLocation:output_type -> hotel_reservation.SetLocationResult
+	5,  // 31: hotel_reservation.Profile.GetProfiles:output_type -> hotel_reservation.GetProfilesResult
+	10, // 32: hotel_reservation.Profile.CreateHotel:output_type -> hotel_reservation.HotelResult
+	10, // 33: hotel_reservation.Profile.UpdateHotel:output_type -> hotel_reservation.HotelResult
+	12, // 34: hotel_reservation.Recommendation.GetRecommendations:output_type -> hotel_reservation.GetRecommendationsResult
+	14, // 35: hotel_reservation.Recommendation.SetHotel:output_type -> hotel_reservation.SetHotelResult
+	16, // 36: hotel_reservation.Recommendation.ReloadHotels:output_type -> hotel_reservation.ReloadHotelsResult
+	18, // 37: hotel_reservation.Rate.GetRates:output_type -> hotel_reservation.GetRatesResult
+	21, // 38: hotel_reservation.Rate.SetRatePlans:output_type -> hotel_reservation.SetRatePlansResult
+	24, // 39: hotel_reservation.Reservation.MakeReservation:output_type -> hotel_reservation.ReservationResult
+	24, // 40: hotel_reservation.Reservation.CheckAvailability:output_type -> hotel_reservation.ReservationResult
+	26, // 41: hotel_reservation.Reservation.SetCapacity:output_type -> hotel_reservation.SetCapacityResult
+	28, // 42: hotel_reservation.Search.Nearby:output_type -> hotel_reservation.SearchResult
+	30, // 43: hotel_reservation.User.CheckUser:output_type -> hotel_reservation.CheckUserResult
+	32, // 44: hotel_reservation.User.RegisterUser:output_type -> hotel_reservation.RegisterUserResult
+	34, // 45: hotel_reservation.User.ChangePassword:output_type -> hotel_reservation.ChangePasswordResult
+	36, // 46: hotel_reservation.User.DeleteUser:output_type -> hotel_reservation.DeleteUserResult
+	38, // 47: hotel_reservation.User.ReloadUsers:output_type -> hotel_reservation.ReloadUsersResult
+	40, // 48: hotel_reservation.User.CheckSession:output_type -> hotel_reservation.CheckSessionResult
+	29, // [29:49] is the sub-list for method output_type
+	9,  // [9:29] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	8,  // [8:9] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_hotel_reservation_proto_init() }
@@ -1331,13 +2709,14 @@ func file_hotel_reservation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_reservation_proto_rawDesc), len(file_hotel_reservation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
-			NumExtensions: 0,
+			NumMessages:   41,
+			NumExtensions: 1,
 			NumServices:   7,
 		},
 		GoTypes:           file_hotel_reservation_proto_goTypes,
 		DependencyIndexes: file_hotel_reservation_proto_depIdxs,
 		MessageInfos:      file_hotel_reservation_proto_msgTypes,
+		ExtensionInfos:    file_hotel_reservation_proto_extTypes,
 	}.Build()
 	File_hotel_reservation_proto = out.File
 	file_hotel_reservation_proto_goTypes = nil
@@ -12,11 +12,6 @@ import (
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
 func (m *NearbyRequest) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *NearbyRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	size := 0
 	size += 12 // table
 	size += 4 + len(m.Latstring)
@@ -49,13 +44,13 @@ func (m *NearbyRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *NearbyRequest) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *NearbyRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *NearbyRequest) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *NearbyRequest) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -93,16 +88,21 @@ func (m *NearbyRequest) UnmarshalSymphonyPrivate(data []byte) error {
 	return nil
 }
 
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *NearbyRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
 func (m *NearbyRequest) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
-	// Private segment:
-	size += 1  // version byte
 	size += 12 // table entries
 	// Field 3 (Latstring): variable-length payload
 	size += 4 + len(m.Latstring) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
 
 	buf := make([]byte, size)
 
@@ -118,6 +118,10 @@ func (m *NearbyRequest) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 4                    // field Lat
+	publicSegmentSize += 4                    // field Lon
+	publicSegmentSize += 4                    // offset placeholder
+	publicSegmentSize += 4 + len(m.Latstring) // field 3 payload
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -126,36 +130,36 @@ func (m *NearbyRequest) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 12
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (Lat): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], math.Float32bits(m.Lat))
+
+	// Field 2 (Lon): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], math.Float32bits(m.Lon))
+
+	// Field 3 (Latstring): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+8:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Latstring)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Latstring)
+	publicPayloadOffset += 4 + len(m.Latstring)
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 12 bytes table
-	privatePayloadStart := privateTableStart + 12
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (Lat): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], math.Float32bits(m.Lat))
-
-	// Field 2 (Lon): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], math.Float32bits(m.Lon))
-
-	// Field 3 (Latstring): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+8:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Latstring)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Latstring)
-	privatePayloadOffset += 4 + len(m.Latstring)
-
 	return buf, nil
 }
 
@@ -191,28 +195,21 @@ func (m *NearbyRequest) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
 	// Field 1 (Lat): fixed-length (4 bytes)
-	if len(data) < privateTableStart+4 {
+	if len(data) < publicTableStart+4 {
 		return fmt.Errorf("invalid data: too short for field")
 	}
-	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
+	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
 
 	// Field 2 (Lon): fixed-length (4 bytes)
-	if len(data) < privateTableStart+8 {
+	if len(data) < publicTableStart+8 {
 		return fmt.Errorf("invalid data: too short for field")
 	}
-	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
+	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
 
 	// Field 3 (Latstring): variable-length
-	if len(data) >= privateTableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+8:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	if len(data) >= publicTableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+8:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
@@ -221,6 +218,10 @@ func (m *NearbyRequest) UnmarshalSymphony(data []byte) error {
 		}
 	}
 
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
@@ -236,67 +237,30 @@ func (m *NearbyRequestRaw) UnmarshalSymphony(data []byte) error {
 }
 
 func (m NearbyRequestRaw) GetLat() float32 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Lat called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Lat called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
 	// Field 1 (Lat): fixed-length (4 bytes)
-	if len(m) < offsetToPrivate+1+4 {
+	if len(m) < 13+4 {
 		return 0
 	}
-	return math.Float32frombits(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
+	return math.Float32frombits(binary.LittleEndian.Uint32(m[13:]))
 }
 
 func (m NearbyRequestRaw) GetLon() float32 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Lon called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Lon called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
 	// Field 2 (Lon): fixed-length (4 bytes)
-	if len(m) < offsetToPrivate+5+4 {
+	if len(m) < 17+4 {
 		return 0
 	}
-	return math.Float32frombits(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
+	return math.Float32frombits(binary.LittleEndian.Uint32(m[17:]))
 }
 
 func (m NearbyRequestRaw) GetLatstring() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Latstring called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Latstring called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
 	// Field 3 (Latstring): variable-length
-	if len(m) < offsetToPrivate+9+4 {
+	if len(m) < 21+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+9:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[21:]))
 	if payloadOffset == 0 {
 		return ""
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
 		return ""
 	}
@@ -308,68 +272,50 @@ func (m NearbyRequestRaw) GetLatstring() string {
 }
 
 func (m *NearbyRequestRaw) SetLat(v float32) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Lat called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lat called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Lat called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
 	// Field 1 (Lat): fixed-length (4 bytes)
-	if len(*m) < offsetToPrivate+1+4 {
+	if len(*m) < 13+4 {
 		return fmt.Errorf("buffer too short")
 	}
-	binary.LittleEndian.PutUint32((*m)[offsetToPrivate+1:], math.Float32bits(v))
+	binary.LittleEndian.PutUint32((*m)[13:], math.Float32bits(v))
 	return nil
 }
 
 func (m *NearbyRequestRaw) SetLon(v float32) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Lon called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lon called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Lon called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
 	// Field 2 (Lon): fixed-length (4 bytes)
-	if len(*m) < offsetToPrivate+5+4 {
+	if len(*m) < 17+4 {
 		return fmt.Errorf("buffer too short")
 	}
-	binary.LittleEndian.PutUint32((*m)[offsetToPrivate+5:], math.Float32bits(v))
+	binary.LittleEndian.PutUint32((*m)[17:], math.Float32bits(v))
 	return nil
 }
 
 func (m *NearbyRequestRaw) SetLatstring(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Latstring called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Latstring called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Latstring called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
 	// Field 3 (Latstring): variable-length
-	if len(*m) < offsetToPrivate+9+4 {
+	if len(*m) < 21+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+9:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[21:]))
 	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
 		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
@@ -381,27 +327,42 @@ func (m *NearbyRequestRaw) SetLatstring(v string) error {
 		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
 	var temp NearbyRequest
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
 	temp.Latstring = v
-	newData, err := temp.MarshalSymphony()
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = NearbyRequestRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = NearbyRequestRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
 func (m *NearbyResult) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *NearbyResult) MarshalSymphonyPrivate() ([]byte, error) {
 	size := 0
 	size += 4 // table
 	size += 4 // count for HotelIds
@@ -440,13 +401,13 @@ func (m *NearbyResult) MarshalSymphonyPrivate() ([]byte, error) {
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *NearbyResult) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *NearbyResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *NearbyResult) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *NearbyResult) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -480,19 +441,24 @@ func (m *NearbyResult) UnmarshalSymphonyPrivate(data []byte) error {
 	return nil
 }
 
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *NearbyResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
 func (m *NearbyResult) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
-	// Private segment:
-	size += 1 // version byte
-	size += 4 // table entries
+	size += 4  // table entries
 	// Field 1 (HotelIds): repeated variable-length payload
 	size += 4 // count
 	for _, item := range m.HotelIds {
 		size += 4 + len(item) // 4 bytes length prefix + data
 	}
+	// Private segment:
+	size += 1 // version byte
 
 	buf := make([]byte, size)
 
@@ -508,6 +474,11 @@ func (m *NearbyResult) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // field 1 count
+	for _, item := range m.HotelIds {
+		publicSegmentSize += 4 + len(item)
+	}
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -516,39 +487,39 @@ func (m *NearbyResult) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 4
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
-	// === PRIVATE SEGMENT ===
-	privateStart := publicSegmentSize
-	buf[privateStart] = 0x01 // version byte
-
-	// Write private fields
-	privateTableStart := privateStart + 1 // 4 bytes table
-	privatePayloadStart := privateTableStart + 4
-	privatePayloadOffset := 0
-	_ = privatePayloadStart
-	_ = privatePayloadOffset
-
-	// Private segment offsets are stored relative to privateStart
 	// Field 1 (HotelIds): repeated variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
 	count = len(m.HotelIds)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(count))
-	currentOffset = privatePayloadStart + privatePayloadOffset + 4
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	currentOffset = publicPayloadStart + publicPayloadOffset + 4
 	for _, item := range m.HotelIds {
 		itemLen := len(item)
 		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
 		copy(buf[currentOffset+4:], item)
 		currentOffset += 4 + itemLen
 	}
-	privatePayloadOffset += 4 // count
+	publicPayloadOffset += 4 // count
 	for _, item := range m.HotelIds {
-		privatePayloadOffset += 4 + len(item)
+		publicPayloadOffset += 4 + len(item)
 	}
 
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
 	return buf, nil
 }
 
@@ -584,16 +555,9 @@ func (m *NearbyResult) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
 	// Field 1 (HotelIds): repeated variable-length
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			m.HotelIds = make([]string, 0, count)
@@ -610,6 +574,10 @@ func (m *NearbyResult) UnmarshalSymphony(data []byte) error {
 		}
 	}
 
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
@@ -625,27 +593,14 @@ func (m *NearbyResultRaw) UnmarshalSymphony(data []byte) error {
 }
 
 func (m NearbyResultRaw) GetHotelIds() []string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter HotelIds called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter HotelIds called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
 	// Field 1 (HotelIds): repeated variable-length
-	if len(m) < offsetToPrivate+1+4 {
+	if len(m) < 13+4 {
 		return nil
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
 	if payloadOffset == 0 {
 		return nil
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
 		return nil
 	}
@@ -667,26 +622,18 @@ func (m NearbyResultRaw) GetHotelIds() []string {
 }
 
 func (m *NearbyResultRaw) SetHotelIds(v []string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter HotelIds called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelIds called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter HotelIds called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
 	// Field 1 (HotelIds): repeated variable-length
-	if len(*m) < offsetToPrivate+1+4 {
+	if len(*m) < 13+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
 	var oldCount int
 	var oldDataSize int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
@@ -720,34 +667,45 @@ func (m *NearbyResultRaw) SetHotelIds(v []string) error {
 		}
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
 	var temp NearbyResult
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
 	temp.HotelIds = v
-	newData, err := temp.MarshalSymphony()
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = NearbyResultRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = NearbyResultRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *GetProfilesRequest) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *GetProfilesRequest) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *SetLocationRequest) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
-	size += 8 // table
-	size += 4 // count for HotelIds
-	for _, item := range m.HotelIds {
-		size += 4 + len(item)
-	}
-	size += 4 + len(m.Locale)
+	size += 12 // table
+	size += 4 + len(m.HotelId)
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -756,44 +714,34 @@ func (m *GetProfilesRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 8
+	payloadStart := tableStart + 12
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (HotelIds): repeated variable-length
+	// Field 1 (HotelId): variable-length
 	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	count = len(m.HotelIds)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
-	currentOffset = payloadStart + payloadOffset + 4
-	for _, item := range m.HotelIds {
-		itemLen := len(item)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
-		copy(buf[currentOffset+4:], item)
-		currentOffset += 4 + itemLen
-	}
-	payloadOffset += 4 // count
-	for _, item := range m.HotelIds {
-		payloadOffset += 4 + len(item)
-	}
-
-	// Field 2 (Locale): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.Locale)
+	dataLen = len(m.HotelId)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.Locale)
-	payloadOffset += 4 + len(m.Locale)
+	copy(buf[payloadStart+payloadOffset+4:], m.HotelId)
+	payloadOffset += 4 + len(m.HotelId)
+
+	// Field 2 (Lat): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], math.Float32bits(m.Lat))
+
+	// Field 3 (Lon): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+8:], math.Float32bits(m.Lon))
 
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *GetProfilesRequest) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *SetLocationRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *GetProfilesRequest) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *SetLocationRequest) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -805,54 +753,47 @@ func (m *GetProfilesRequest) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (HotelIds): repeated variable-length
+	// Field 1 (HotelId): variable-length
 	if len(data) >= tableStart+0+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.HotelIds = make([]string, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
-						currentOffset += 4 + itemLen
-					}
-				}
-			}
-		}
-	}
-
-	// Field 2 (Locale): variable-length
-	if len(data) >= tableStart+4+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.Locale = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
+	// Field 2 (Lat): fixed-length (4 bytes)
+	if len(data) < tableStart+8 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[tableStart+4:]))
+
+	// Field 3 (Lon): fixed-length (4 bytes)
+	if len(data) < tableStart+12 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[tableStart+8:]))
+
 	return nil
 }
 
-func (m *GetProfilesRequest) MarshalSymphony() ([]byte, error) {
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *SetLocationRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *SetLocationRequest) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 12 // table entries
+	// Field 1 (HotelId): variable-length payload
+	size += 4 + len(m.HotelId) // 4 bytes length prefix + data
 	// Private segment:
 	size += 1 // version byte
-	size += 8 // table entries
-	// Field 1 (HotelIds): repeated variable-length payload
-	size += 4 // count
-	for _, item := range m.HotelIds {
-		size += 4 + len(item) // 4 bytes length prefix + data
-	}
-	// Field 2 (Locale): variable-length payload
-	size += 4 + len(m.Locale) // 4 bytes length prefix + data
 
 	buf := make([]byte, size)
 
@@ -868,6 +809,10 @@ func (m *GetProfilesRequest) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4                  // field Lat
+	publicSegmentSize += 4                  // field Lon
+	publicSegmentSize += 4 + len(m.HotelId) // field 1 payload
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -876,50 +821,40 @@ func (m *GetProfilesRequest) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 12
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.HotelId)
+	publicPayloadOffset += 4 + len(m.HotelId)
+
+	// Field 2 (Lat): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], math.Float32bits(m.Lat))
+
+	// Field 3 (Lon): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+8:], math.Float32bits(m.Lon))
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 8 bytes table
-	privatePayloadStart := privateTableStart + 8
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (HotelIds): repeated variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	count = len(m.HotelIds)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(count))
-	currentOffset = privatePayloadStart + privatePayloadOffset + 4
-	for _, item := range m.HotelIds {
-		itemLen := len(item)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
-		copy(buf[currentOffset+4:], item)
-		currentOffset += 4 + itemLen
-	}
-	privatePayloadOffset += 4 // count
-	for _, item := range m.HotelIds {
-		privatePayloadOffset += 4 + len(item)
-	}
-
-	// Field 2 (Locale): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Locale)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Locale)
-	privatePayloadOffset += 4 + len(m.Locale)
-
 	return buf, nil
 }
 
-func (m *GetProfilesRequest) UnmarshalSymphony(data []byte) error {
+func (m *SetLocationRequest) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -951,124 +886,56 @@ func (m *GetProfilesRequest) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (HotelIds): repeated variable-length
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.HotelIds = make([]string, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
-						currentOffset += 4 + itemLen
-					}
-				}
-			}
-		}
-	}
-
-	// Field 2 (Locale): variable-length
-	if len(data) >= privateTableStart+4+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	// Field 1 (HotelId): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.Locale = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
+	// Field 2 (Lat): fixed-length (4 bytes)
+	if len(data) < publicTableStart+8 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
+
+	// Field 3 (Lon): fixed-length (4 bytes)
+	if len(data) < publicTableStart+12 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[publicTableStart+8:]))
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
-type GetProfilesRequestRaw []byte
+type SetLocationRequestRaw []byte
 
-func (m GetProfilesRequestRaw) MarshalSymphony() ([]byte, error) {
+func (m SetLocationRequestRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *GetProfilesRequestRaw) UnmarshalSymphony(data []byte) error {
-	*m = GetProfilesRequestRaw(data)
+func (m *SetLocationRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = SetLocationRequestRaw(data)
 	return nil
 }
 
-func (m GetProfilesRequestRaw) GetHotelIds() []string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter HotelIds called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter HotelIds called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (HotelIds): repeated variable-length
-	if len(m) < offsetToPrivate+1+4 {
-		return nil
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
-	if payloadOffset == 0 {
-		return nil
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return nil
-	}
-	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	result := make([]string, count)
-	currentOffset := payloadOffset + 4
-	for i := 0; i < count; i++ {
-		if len(m) < currentOffset+4 {
-			return nil
-		}
-		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
-		if len(m) < currentOffset+4+itemLen {
-			return nil
-		}
-		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
-		currentOffset += 4 + itemLen
-	}
-	return result
-}
-
-func (m GetProfilesRequestRaw) GetLocale() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Locale called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Locale called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 2 (Locale): variable-length
-	if len(m) < offsetToPrivate+5+4 {
+func (m SetLocationRequestRaw) GetHotelId() string {
+	// Field 1 (HotelId): variable-length
+	if len(m) < 13+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
 	if payloadOffset == 0 {
 		return ""
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
 		return ""
 	}
@@ -1079,134 +946,117 @@ func (m GetProfilesRequestRaw) GetLocale() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m *GetProfilesRequestRaw) SetHotelIds(v []string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter HotelIds called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+func (m SetLocationRequestRaw) GetLat() float32 {
+	// Field 2 (Lat): fixed-length (4 bytes)
+	if len(m) < 17+4 {
+		return 0
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+	return math.Float32frombits(binary.LittleEndian.Uint32(m[17:]))
+}
+
+func (m SetLocationRequestRaw) GetLon() float32 {
+	// Field 3 (Lon): fixed-length (4 bytes)
+	if len(m) < 21+4 {
+		return 0
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(m[21:]))
+}
+
+func (m *SetLocationRequestRaw) SetHotelId(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter HotelIds called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 1 (HotelIds): repeated variable-length
-	if len(*m) < offsetToPrivate+1+4 {
+	// Field 1 (HotelId): variable-length
+	if len(*m) < 13+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldCount int
-	var oldDataSize int
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
-		oldDataSize = 4
-		currentOffset := oldPayloadOffset + 4
-		for i := 0; i < oldCount; i++ {
-			if len(*m) < currentOffset+4 {
-				break
-			}
-			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
-			oldDataSize += 4 + itemLen
-			currentOffset += 4 + itemLen
-		}
-	}
-	newCount := len(v)
-	newDataSize := 4 // count
-	for _, item := range v {
-		newDataSize += 4 + len(item) // 4 bytes length + data
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
 	}
-	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
 		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
-		currentOffset := oldPayloadOffset + 4
-		for _, item := range v {
-			itemLen := len(item)
-			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
-			copy((*m)[currentOffset+4:], item)
-			currentOffset += 4 + itemLen
-		}
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp GetProfilesRequest
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp SetLocationRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.HotelIds = v
-	newData, err := temp.MarshalSymphony()
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = GetProfilesRequestRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SetLocationRequestRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
-func (m *GetProfilesRequestRaw) SetLocale(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Locale called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *SetLocationRequestRaw) SetLat(v float32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lat called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Locale called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 2 (Locale): variable-length
-	if len(*m) < offsetToPrivate+5+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+5:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
 	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
+	// Field 2 (Lat): fixed-length (4 bytes)
+	if len(*m) < 17+4 {
+		return fmt.Errorf("buffer too short")
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp GetProfilesRequest
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
+	binary.LittleEndian.PutUint32((*m)[17:], math.Float32bits(v))
+	return nil
+}
+
+func (m *SetLocationRequestRaw) SetLon(v float32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lon called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
 	}
-	temp.Locale = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+	// Field 3 (Lon): fixed-length (4 bytes)
+	if len(*m) < 21+4 {
+		return fmt.Errorf("buffer too short")
 	}
-	*m = GetProfilesRequestRaw(newData)
+	binary.LittleEndian.PutUint32((*m)[21:], math.Float32bits(v))
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *GetProfilesResult) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *GetProfilesResult) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *SetLocationResult) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
 	size += 4 // table
-	size += 4 // count for Hotels
-	for _, item := range m.Hotels {
-		nested, _ := item.MarshalSymphony()
-		size += 4 + len(nested)
-	}
+	size += 4 + len(m.HotelId)
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -1220,34 +1070,23 @@ func (m *GetProfilesResult) MarshalSymphonyPrivate() ([]byte, error) {
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (Hotels): repeated nested message
+	// Field 1 (HotelId): variable-length
 	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	count = len(m.Hotels)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
-	payloadOffset += 4
-	currentOffset = payloadStart + payloadOffset
-	for _, item := range m.Hotels {
-		nestedData, err := item.MarshalSymphony()
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
-		}
-		nestedSize := len(nestedData)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
-		copy(buf[currentOffset+4:], nestedData)
-		currentOffset += 4 + nestedSize
-		payloadOffset += 4 + nestedSize
-	}
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.HotelId)
+	payloadOffset += 4 + len(m.HotelId)
 
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *GetProfilesResult) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *SetLocationResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *GetProfilesResult) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *SetLocationResult) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -1259,25 +1098,13 @@ func (m *GetProfilesResult) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (Hotels): repeated nested message
+	// Field 1 (HotelId): variable-length
 	if len(data) >= tableStart+0+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.Hotels = make([]*Hotel, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						item := &Hotel{}
-						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
-							return fmt.Errorf("failed to unmarshal nested message: %w", err)
-						}
-						m.Hotels = append(m.Hotels, item)
-						currentOffset += 4 + itemLen
-					}
-				}
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
@@ -1285,74 +1112,21 @@ func (m *GetProfilesResult) UnmarshalSymphonyPrivate(data []byte) error {
 	return nil
 }
 
-func (m *GetProfilesResult) MarshalSymphony() ([]byte, error) {
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *SetLocationResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *SetLocationResult) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 4  // table entries
+	// Field 1 (HotelId): variable-length payload
+	size += 4 + len(m.HotelId) // 4 bytes length prefix + data
 	// Private segment:
 	size += 1 // version byte
-	size += 4 // table entries
-	// Field 1 (Hotels): repeated nested message payload
-	size += 4 // count
-	for _, item := range m.Hotels {
-		nestedSize1 := 0
-		// Public segment:
-		nestedSize1 += 1  // version byte
-		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
-		// Private segment:
-		nestedSize1 += 1  // version byte
-		nestedSize1 += 24 // table entries
-		// Field 1 (Id): variable-length payload
-		nestedSize1 += 4 + len(item.Id) // 4 bytes length prefix + data
-		// Field 2 (Name): variable-length payload
-		nestedSize1 += 4 + len(item.Name) // 4 bytes length prefix + data
-		// Field 3 (PhoneNumber): variable-length payload
-		nestedSize1 += 4 + len(item.PhoneNumber) // 4 bytes length prefix + data
-		// Field 4 (Description): variable-length payload
-		nestedSize1 += 4 + len(item.Description) // 4 bytes length prefix + data
-		// Field 5 (Address): nested message payload
-		if item.Address != nil {
-			nestedSize2 := 0
-			// Public segment:
-			nestedSize2 += 1  // version byte
-			nestedSize2 += 12 // reserved: offset_to_private, service_name, method_name
-			// Private segment:
-			nestedSize2 += 1  // version byte
-			nestedSize2 += 32 // table entries
-			// Field 1 (StreetNumber): variable-length payload
-			nestedSize2 += 4 + len(item.Address.StreetNumber) // 4 bytes length prefix + data
-			// Field 2 (StreetName): variable-length payload
-			nestedSize2 += 4 + len(item.Address.StreetName) // 4 bytes length prefix + data
-			// Field 3 (City): variable-length payload
-			nestedSize2 += 4 + len(item.Address.City) // 4 bytes length prefix + data
-			// Field 4 (State): variable-length payload
-			nestedSize2 += 4 + len(item.Address.State) // 4 bytes length prefix + data
-			// Field 5 (Country): variable-length payload
-			nestedSize2 += 4 + len(item.Address.Country) // 4 bytes length prefix + data
-			// Field 6 (PostalCode): variable-length payload
-			nestedSize2 += 4 + len(item.Address.PostalCode) // 4 bytes length prefix + data
-
-			nestedSize1 += 4 + nestedSize2 // 4 bytes size + message data
-		}
-		// Field 6 (Images): repeated nested message payload
-		nestedSize1 += 4 // count
-		for _, item := range item.Images {
-			nestedSize2 := 0
-			// Public segment:
-			nestedSize2 += 1  // version byte
-			nestedSize2 += 12 // reserved: offset_to_private, service_name, method_name
-			// Private segment:
-			nestedSize2 += 1 // version byte
-			nestedSize2 += 5 // table entries
-			// Field 1 (Url): variable-length payload
-			nestedSize2 += 4 + len(item.Url) // 4 bytes length prefix + data
-
-			nestedSize1 += 4 + nestedSize2 // 4 bytes size + message data
-		}
-
-		size += 4 + nestedSize1 // 4 bytes size + message data
-	}
 
 	buf := make([]byte, size)
 
@@ -1368,6 +1142,8 @@ func (m *GetProfilesResult) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4 + len(m.HotelId) // field 1 payload
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -1376,45 +1152,34 @@ func (m *GetProfilesResult) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 4
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.HotelId)
+	publicPayloadOffset += 4 + len(m.HotelId)
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 4 bytes table
-	privatePayloadStart := privateTableStart + 4
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (Hotels): repeated nested message
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	count = len(m.Hotels)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(count))
-	privatePayloadOffset += 4
-	currentOffset = privatePayloadStart + privatePayloadOffset
-	for _, item := range m.Hotels {
-		nestedData, err := item.MarshalSymphony()
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
-		}
-		nestedSize := len(nestedData)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
-		copy(buf[currentOffset+4:], nestedData)
-		currentOffset += 4 + nestedSize
-		privatePayloadOffset += 4 + nestedSize
-	}
-
 	return buf, nil
 }
 
-func (m *GetProfilesResult) UnmarshalSymphony(data []byte) error {
+func (m *SetLocationResult) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -1446,187 +1211,124 @@ func (m *GetProfilesResult) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (Hotels): repeated nested message
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	// Field 1 (HotelId): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.Hotels = make([]*Hotel, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						item := &Hotel{}
-						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
-							return fmt.Errorf("failed to unmarshal nested message: %w", err)
-						}
-						m.Hotels = append(m.Hotels, item)
-						currentOffset += 4 + itemLen
-					}
-				}
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
-type GetProfilesResultRaw []byte
+type SetLocationResultRaw []byte
 
-func (m GetProfilesResultRaw) MarshalSymphony() ([]byte, error) {
+func (m SetLocationResultRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *GetProfilesResultRaw) UnmarshalSymphony(data []byte) error {
-	*m = GetProfilesResultRaw(data)
+func (m *SetLocationResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = SetLocationResultRaw(data)
 	return nil
 }
 
-func (m GetProfilesResultRaw) GetHotels() []HotelRaw {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Hotels called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Hotels called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (Hotels): repeated nested message
-	if len(m) < offsetToPrivate+1+4 {
-		return nil
+func (m SetLocationResultRaw) GetHotelId() string {
+	// Field 1 (HotelId): variable-length
+	if len(m) < 13+4 {
+		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
 	if payloadOffset == 0 {
-		return nil
+		return ""
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
-		return nil
+		return ""
 	}
-	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	result := make([]HotelRaw, count)
-	currentOffset := payloadOffset + 4
-	for i := 0; i < count; i++ {
-		if len(m) < currentOffset+4 {
-			return nil
-		}
-		nestedSize := int(binary.LittleEndian.Uint32(m[currentOffset:]))
-		if len(m) < currentOffset+4+nestedSize {
-			return nil
-		}
-		result[i] = HotelRaw(m[currentOffset+4 : currentOffset+4+nestedSize])
-		currentOffset += 4 + nestedSize
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
 	}
-	return result
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m *GetProfilesResultRaw) SetHotels(v []HotelRaw) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Hotels called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *SetLocationResultRaw) SetHotelId(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Hotels called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 1 (Hotels): repeated nested message
-	if len(*m) < offsetToPrivate+1+4 {
+	// Field 1 (HotelId): variable-length
+	if len(*m) < 13+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldCount int
-	var oldDataSize int
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-		// Calculate old data size: 4 bytes count + for each item: 4 bytes size + data
-		oldDataSize = 4
-		currentOffset := oldPayloadOffset + 4
-		for i := 0; i < oldCount; i++ {
-			if len(*m) < currentOffset+4 {
-				break
-			}
-			itemSize := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
-			oldDataSize += 4 + itemSize
-			currentOffset += 4 + itemSize
-		}
-	}
-	newCount := len(v)
-	newDataSize := 4 // count
-	for _, item := range v {
-		newDataSize += 4 + len(item) // 4 bytes size + data
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
 	}
-	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
 		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
-		currentOffset := oldPayloadOffset + 4
-		for _, item := range v {
-			itemSize := len(item)
-			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemSize))
-			copy((*m)[currentOffset+4:], item)
-			currentOffset += 4 + itemSize
-		}
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp GetProfilesResult
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp SetLocationResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.Hotels = make([]*Hotel, len(v))
-	for i, rawItem := range v {
-		temp.Hotels[i] = &Hotel{}
-		if err := temp.Hotels[i].UnmarshalSymphony([]byte(rawItem)); err != nil {
-			return fmt.Errorf("failed to unmarshal nested message: %w", err)
-		}
-	}
-	newData, err := temp.MarshalSymphony()
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = GetProfilesResultRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SetLocationResultRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *Hotel) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *Hotel) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *GetProfilesRequest) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
-	size += 24 // table
-	size += 4 + len(m.Id)
-	size += 4 + len(m.Name)
-	size += 4 + len(m.PhoneNumber)
-	size += 4 + len(m.Description)
-	if m.Address != nil {
-		nested, _ := m.Address.MarshalSymphony()
-		size += 4 + len(nested)
+	size += 12 // table
+	size += 4  // count for HotelIds
+	for _, item := range m.HotelIds {
+		size += 4 + len(item)
 	}
-	size += 4 // count for Images
-	for _, item := range m.Images {
-		nested, _ := item.MarshalSymphony()
-		size += 4 + len(nested)
+	size += 4 + len(m.Locale)
+	size += 4 // count for Fields
+	for _, item := range m.Fields {
+		size += 4 + len(item)
 	}
 	buf := make([]byte, size)
 	dataLen := 0
@@ -1636,82 +1338,60 @@ func (m *Hotel) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 24
+	payloadStart := tableStart + 12
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (Id): variable-length
+	// Field 1 (HotelIds): repeated variable-length
 	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.Id)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.Id)
-	payloadOffset += 4 + len(m.Id)
+	count = len(m.HotelIds)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	currentOffset = payloadStart + payloadOffset + 4
+	for _, item := range m.HotelIds {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	payloadOffset += 4 // count
+	for _, item := range m.HotelIds {
+		payloadOffset += 4 + len(item)
+	}
 
-	// Field 2 (Name): variable-length
+	// Field 2 (Locale): variable-length
 	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.Name)
+	dataLen = len(m.Locale)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.Name)
-	payloadOffset += 4 + len(m.Name)
+	copy(buf[payloadStart+payloadOffset+4:], m.Locale)
+	payloadOffset += 4 + len(m.Locale)
 
-	// Field 3 (PhoneNumber): variable-length
+	// Field 3 (Fields): repeated variable-length
 	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.PhoneNumber)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.PhoneNumber)
-	payloadOffset += 4 + len(m.PhoneNumber)
-
-	// Field 4 (Description): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+12:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.Description)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.Description)
-	payloadOffset += 4 + len(m.Description)
-
-	// Field 5 (Address): nested message
-	if m.Address != nil {
-		binary.LittleEndian.PutUint32(buf[tableStart+16:], uint32(payloadStart+payloadOffset))
-		nestedData, err := m.Address.MarshalSymphony()
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
-		}
-		nestedSize := len(nestedData)
-		binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(nestedSize))
-		copy(buf[payloadStart+payloadOffset+4:], nestedData)
-		payloadOffset += 4 + nestedSize
-	} else {
-		binary.LittleEndian.PutUint32(buf[tableStart+16:], 0)
-	}
-
-	// Field 6 (Images): repeated nested message
-	binary.LittleEndian.PutUint32(buf[tableStart+20:], uint32(payloadStart+payloadOffset))
-	count = len(m.Images)
+	count = len(m.Fields)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
-	payloadOffset += 4
-	currentOffset = payloadStart + payloadOffset
-	for _, item := range m.Images {
-		nestedData, err := item.MarshalSymphony()
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
-		}
-		nestedSize := len(nestedData)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
-		copy(buf[currentOffset+4:], nestedData)
-		currentOffset += 4 + nestedSize
-		payloadOffset += 4 + nestedSize
+	currentOffset = payloadStart + payloadOffset + 4
+	for _, item := range m.Fields {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	payloadOffset += 4 // count
+	for _, item := range m.Fields {
+		payloadOffset += 4 + len(item)
 	}
 
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *Hotel) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *GetProfilesRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *Hotel) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *GetProfilesRequest) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -1723,80 +1403,48 @@ func (m *Hotel) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (Id): variable-length
+	// Field 1 (HotelIds): repeated variable-length
 	if len(data) >= tableStart+0+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Id = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.HotelIds = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
 			}
 		}
 	}
 
-	// Field 2 (Name): variable-length
+	// Field 2 (Locale): variable-length
 	if len(data) >= tableStart+4+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.Name = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Locale = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 3 (PhoneNumber): variable-length
+	// Field 3 (Fields): repeated variable-length
 	if len(data) >= tableStart+8+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.PhoneNumber = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 4 (Description): variable-length
-	if len(data) >= tableStart+12+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+12:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Description = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 5 (Address): nested message
-	if len(data) >= tableStart+16+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+16:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Address = &Address{}
-				if err := m.Address.UnmarshalSymphony(data[payloadOffset+4 : payloadOffset+4+dataLen]); err != nil {
-					return fmt.Errorf("failed to unmarshal nested message: %w", err)
-				}
-			}
-		}
-	}
-
-	// Field 6 (Images): repeated nested message
-	if len(data) >= tableStart+20+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+20:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.Images = make([]*Image, 0, count)
+			m.Fields = make([]string, 0, count)
 			currentOffset = payloadOffset + 4
 			for i := 0; i < count; i++ {
 				if len(data) >= currentOffset+4 {
 					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
 					if len(data) >= currentOffset+4+itemLen {
-						item := &Image{}
-						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
-							return fmt.Errorf("failed to unmarshal nested message: %w", err)
-						}
-						m.Images = append(m.Images, item)
+						m.Fields = append(m.Fields, string(data[currentOffset+4:currentOffset+4+itemLen]))
 						currentOffset += 4 + itemLen
 					}
 				}
@@ -1807,61 +1455,31 @@ func (m *Hotel) UnmarshalSymphonyPrivate(data []byte) error {
 	return nil
 }
 
-func (m *Hotel) MarshalSymphony() ([]byte, error) {
-	size := 0
-	// Public segment:
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *GetProfilesRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *GetProfilesRequest) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
-	// Private segment:
-	size += 1  // version byte
-	size += 24 // table entries
-	// Field 1 (Id): variable-length payload
-	size += 4 + len(m.Id) // 4 bytes length prefix + data
-	// Field 2 (Name): variable-length payload
-	size += 4 + len(m.Name) // 4 bytes length prefix + data
-	// Field 3 (PhoneNumber): variable-length payload
-	size += 4 + len(m.PhoneNumber) // 4 bytes length prefix + data
-	// Field 4 (Description): variable-length payload
-	size += 4 + len(m.Description) // 4 bytes length prefix + data
-	// Field 5 (Address): nested message payload
-	if m.Address != nil {
-		nestedSize1 := 0
-		// Public segment:
-		nestedSize1 += 1  // version byte
-		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
-		// Private segment:
-		nestedSize1 += 1  // version byte
-		nestedSize1 += 32 // table entries
-		// Field 1 (StreetNumber): variable-length payload
-		nestedSize1 += 4 + len(m.Address.StreetNumber) // 4 bytes length prefix + data
-		// Field 2 (StreetName): variable-length payload
-		nestedSize1 += 4 + len(m.Address.StreetName) // 4 bytes length prefix + data
-		// Field 3 (City): variable-length payload
-		nestedSize1 += 4 + len(m.Address.City) // 4 bytes length prefix + data
-		// Field 4 (State): variable-length payload
-		nestedSize1 += 4 + len(m.Address.State) // 4 bytes length prefix + data
-		// Field 5 (Country): variable-length payload
-		nestedSize1 += 4 + len(m.Address.Country) // 4 bytes length prefix + data
-		// Field 6 (PostalCode): variable-length payload
-		nestedSize1 += 4 + len(m.Address.PostalCode) // 4 bytes length prefix + data
-
-		size += 4 + nestedSize1 // 4 bytes size + message data
+	size += 12 // table entries
+	// Field 1 (HotelIds): repeated variable-length payload
+	size += 4 // count
+	for _, item := range m.HotelIds {
+		size += 4 + len(item) // 4 bytes length prefix + data
 	}
-	// Field 6 (Images): repeated nested message payload
+	// Field 2 (Locale): variable-length payload
+	size += 4 + len(m.Locale) // 4 bytes length prefix + data
+	// Field 3 (Fields): repeated variable-length payload
 	size += 4 // count
-	for _, item := range m.Images {
-		nestedSize1 := 0
-		// Public segment:
-		nestedSize1 += 1  // version byte
-		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
-		// Private segment:
-		nestedSize1 += 1 // version byte
-		nestedSize1 += 5 // table entries
-		// Field 1 (Url): variable-length payload
-		nestedSize1 += 4 + len(item.Url) // 4 bytes length prefix + data
-
-		size += 4 + nestedSize1 // 4 bytes size + message data
+	for _, item := range m.Fields {
+		size += 4 + len(item) // 4 bytes length prefix + data
 	}
+	// Private segment:
+	size += 1 // version byte
 
 	buf := make([]byte, size)
 
@@ -1877,6 +1495,18 @@ func (m *Hotel) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // field 1 count
+	for _, item := range m.HotelIds {
+		publicSegmentSize += 4 + len(item)
+	}
+	publicSegmentSize += 4 + len(m.Locale) // field 2 payload
+	publicSegmentSize += 4                 // field 3 count
+	for _, item := range m.Fields {
+		publicSegmentSize += 4 + len(item)
+	}
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -1885,88 +1515,66 @@ func (m *Hotel) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 12
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (HotelIds): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.HotelIds)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	currentOffset = publicPayloadStart + publicPayloadOffset + 4
+	for _, item := range m.HotelIds {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	publicPayloadOffset += 4 // count
+	for _, item := range m.HotelIds {
+		publicPayloadOffset += 4 + len(item)
+	}
+
+	// Field 2 (Locale): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Locale)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Locale)
+	publicPayloadOffset += 4 + len(m.Locale)
+
+	// Field 3 (Fields): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+8:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.Fields)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	currentOffset = publicPayloadStart + publicPayloadOffset + 4
+	for _, item := range m.Fields {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	publicPayloadOffset += 4 // count
+	for _, item := range m.Fields {
+		publicPayloadOffset += 4 + len(item)
+	}
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 24 bytes table
-	privatePayloadStart := privateTableStart + 24
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (Id): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Id)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Id)
-	privatePayloadOffset += 4 + len(m.Id)
-
-	// Field 2 (Name): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Name)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Name)
-	privatePayloadOffset += 4 + len(m.Name)
-
-	// Field 3 (PhoneNumber): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+8:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.PhoneNumber)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.PhoneNumber)
-	privatePayloadOffset += 4 + len(m.PhoneNumber)
-
-	// Field 4 (Description): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+12:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Description)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Description)
-	privatePayloadOffset += 4 + len(m.Description)
-
-	// Field 5 (Address): nested message
-	if m.Address != nil {
-		binary.LittleEndian.PutUint32(buf[privateTableStart+16:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-		nestedData, err := m.Address.MarshalSymphony()
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
-		}
-		nestedSize := len(nestedData)
-		binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(nestedSize))
-		copy(buf[privatePayloadStart+privatePayloadOffset+4:], nestedData)
-		privatePayloadOffset += 4 + nestedSize
-	} else {
-		binary.LittleEndian.PutUint32(buf[privateTableStart+16:], 0)
-	}
-
-	// Field 6 (Images): repeated nested message
-	binary.LittleEndian.PutUint32(buf[privateTableStart+20:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	count = len(m.Images)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(count))
-	privatePayloadOffset += 4
-	currentOffset = privatePayloadStart + privatePayloadOffset
-	for _, item := range m.Images {
-		nestedData, err := item.MarshalSymphony()
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
-		}
-		nestedSize := len(nestedData)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
-		copy(buf[currentOffset+4:], nestedData)
-		currentOffset += 4 + nestedSize
-		privatePayloadOffset += 4 + nestedSize
-	}
-
 	return buf, nil
 }
 
-func (m *Hotel) UnmarshalSymphony(data []byte) error {
+func (m *GetProfilesRequest) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -1998,102 +1606,48 @@ func (m *Hotel) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (Id): variable-length
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Id = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 2 (Name): variable-length
-	if len(data) >= privateTableStart+4+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Name = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 3 (PhoneNumber): variable-length
-	if len(data) >= privateTableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+8:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.PhoneNumber = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 4 (Description): variable-length
-	if len(data) >= privateTableStart+12+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+12:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	// Field 1 (HotelIds): repeated variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Description = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.HotelIds = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
 			}
 		}
 	}
 
-	// Field 5 (Address): nested message
-	if len(data) >= privateTableStart+16+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+16:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	// Field 2 (Locale): variable-length
+	if len(data) >= publicTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.Address = &Address{}
-				if err := m.Address.UnmarshalSymphony(data[payloadOffset+4 : payloadOffset+4+dataLen]); err != nil {
-					return fmt.Errorf("failed to unmarshal nested message: %w", err)
-				}
+				m.Locale = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 6 (Images): repeated nested message
-	if len(data) >= privateTableStart+20+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+20:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	// Field 3 (Fields): repeated variable-length
+	if len(data) >= publicTableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+8:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.Images = make([]*Image, 0, count)
+			m.Fields = make([]string, 0, count)
 			currentOffset = payloadOffset + 4
 			for i := 0; i < count; i++ {
 				if len(data) >= currentOffset+4 {
 					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
 					if len(data) >= currentOffset+4+itemLen {
-						item := &Image{}
-						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
-							return fmt.Errorf("failed to unmarshal nested message: %w", err)
-						}
-						m.Images = append(m.Images, item)
+						m.Fields = append(m.Fields, string(data[currentOffset+4:currentOffset+4+itemLen]))
 						currentOffset += 4 + itemLen
 					}
 				}
@@ -2101,138 +1655,62 @@ func (m *Hotel) UnmarshalSymphony(data []byte) error {
 		}
 	}
 
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
-type HotelRaw []byte
+type GetProfilesRequestRaw []byte
 
-func (m HotelRaw) MarshalSymphony() ([]byte, error) {
+func (m GetProfilesRequestRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *HotelRaw) UnmarshalSymphony(data []byte) error {
-	*m = HotelRaw(data)
+func (m *GetProfilesRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = GetProfilesRequestRaw(data)
 	return nil
 }
 
-func (m HotelRaw) GetId() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Id called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Id called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (Id): variable-length
-	if len(m) < offsetToPrivate+1+4 {
-		return ""
+func (m GetProfilesRequestRaw) GetHotelIds() []string {
+	// Field 1 (HotelIds): repeated variable-length
+	if len(m) < 13+4 {
+		return nil
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
 	if payloadOffset == 0 {
-		return ""
+		return nil
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
+		return nil
 	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
-}
-
-func (m HotelRaw) GetName() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Name called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]string, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
 		}
-		panic(fmt.Sprintf("private getter Name called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 2 (Name): variable-length
-	if len(m) < offsetToPrivate+5+4 {
-		return ""
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
-	if payloadOffset == 0 {
-		return ""
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
-	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
-}
-
-func (m HotelRaw) GetPhoneNumber() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter PhoneNumber called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
+		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+itemLen {
+			return nil
 		}
-		panic(fmt.Sprintf("private getter PhoneNumber called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 3 (PhoneNumber): variable-length
-	if len(m) < offsetToPrivate+9+4 {
-		return ""
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+9:]))
-	if payloadOffset == 0 {
-		return ""
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
+		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
+		currentOffset += 4 + itemLen
 	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+	return result
 }
 
-func (m HotelRaw) GetDescription() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Description called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Description called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 4 (Description): variable-length
-	if len(m) < offsetToPrivate+13+4 {
+func (m GetProfilesRequestRaw) GetLocale() string {
+	// Field 2 (Locale): variable-length
+	if len(m) < 17+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+13:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[17:]))
 	if payloadOffset == 0 {
 		return ""
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
 		return ""
 	}
@@ -2243,147 +1721,128 @@ func (m HotelRaw) GetDescription() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m HotelRaw) GetAddress() AddressRaw {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Address called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Address called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 5 (Address): nested message
-	if len(m) < offsetToPrivate+17+4 {
-		return nil
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+17:]))
-	if payloadOffset == 0 {
-		return nil
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return nil
-	}
-	nestedSize := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+nestedSize {
-		return nil
-	}
-	return AddressRaw(m[payloadOffset+4 : payloadOffset+4+nestedSize])
-}
-
-func (m HotelRaw) GetImages() []ImageRaw {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Images called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Images called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 6 (Images): repeated nested message
-	if len(m) < offsetToPrivate+21+4 {
+func (m GetProfilesRequestRaw) GetFields() []string {
+	// Field 3 (Fields): repeated variable-length
+	if len(m) < 21+4 {
 		return nil
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+21:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[21:]))
 	if payloadOffset == 0 {
 		return nil
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
 		return nil
 	}
 	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	result := make([]ImageRaw, count)
+	result := make([]string, count)
 	currentOffset := payloadOffset + 4
 	for i := 0; i < count; i++ {
 		if len(m) < currentOffset+4 {
 			return nil
 		}
-		nestedSize := int(binary.LittleEndian.Uint32(m[currentOffset:]))
-		if len(m) < currentOffset+4+nestedSize {
+		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+itemLen {
 			return nil
 		}
-		result[i] = ImageRaw(m[currentOffset+4 : currentOffset+4+nestedSize])
-		currentOffset += 4 + nestedSize
+		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
+		currentOffset += 4 + itemLen
 	}
 	return result
 }
 
-func (m *HotelRaw) SetId(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Id called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *GetProfilesRequestRaw) SetHotelIds(v []string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelIds called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Id called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 1 (Id): variable-length
-	if len(*m) < offsetToPrivate+1+4 {
+	// Field 1 (HotelIds): repeated variable-length
+	if len(*m) < 13+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldCount int
+	var oldDataSize int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemLen
+			currentOffset += 4 + itemLen
+		}
 	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+	newCount := len(v)
+	newDataSize := 4 // count
+	for _, item := range v {
+		newDataSize += 4 + len(item) // 4 bytes length + data
+	}
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
 		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		currentOffset := oldPayloadOffset + 4
+		for _, item := range v {
+			itemLen := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
+			copy((*m)[currentOffset+4:], item)
+			currentOffset += 4 + itemLen
+		}
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Hotel
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp GetProfilesRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.Id = v
-	newData, err := temp.MarshalSymphony()
+	temp.HotelIds = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = HotelRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = GetProfilesRequestRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
-func (m *HotelRaw) SetName(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Name called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *GetProfilesRequestRaw) SetLocale(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Locale called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Name called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 2 (Name): variable-length
-	if len(*m) < offsetToPrivate+5+4 {
+	// Field 2 (Locale): variable-length
+	if len(*m) < 17+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+5:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[17:]))
 	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
 		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
@@ -2395,252 +1854,129 @@ func (m *HotelRaw) SetName(v string) error {
 		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Hotel
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp GetProfilesRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.Name = v
-	newData, err := temp.MarshalSymphony()
+	temp.Locale = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = HotelRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = GetProfilesRequestRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
-func (m *HotelRaw) SetPhoneNumber(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter PhoneNumber called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *GetProfilesRequestRaw) SetFields(v []string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Fields called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter PhoneNumber called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 3 (PhoneNumber): variable-length
-	if len(*m) < offsetToPrivate+9+4 {
+	// Field 3 (Fields): repeated variable-length
+	if len(*m) < 21+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+9:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[21:]))
+	var oldCount int
+	var oldDataSize int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
-	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Hotel
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
-	}
-	temp.PhoneNumber = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
-	}
-	*m = HotelRaw(newData)
-	return nil
-}
-
-func (m *HotelRaw) SetDescription(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Description called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter Description called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 4 (Description): variable-length
-	if len(*m) < offsetToPrivate+13+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+13:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
-	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Hotel
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
-	}
-	temp.Description = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
-	}
-	*m = HotelRaw(newData)
-	return nil
-}
-
-func (m *HotelRaw) SetAddress(v AddressRaw) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Address called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter Address called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 5 (Address): nested message
-	if len(*m) < offsetToPrivate+17+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+17:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldNestedSize int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldNestedSize = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-	}
-	newNestedSize := len(v)
-	if oldPayloadOffset > 0 && newNestedSize <= oldNestedSize {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newNestedSize))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
-	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Hotel
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
-	}
-	if temp.Address == nil {
-		temp.Address = &Address{}
-	}
-	if err := temp.Address.UnmarshalSymphony([]byte(v)); err != nil {
-		return fmt.Errorf("failed to unmarshal nested message: %w", err)
-	}
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
-	}
-	*m = HotelRaw(newData)
-	return nil
-}
-
-func (m *HotelRaw) SetImages(v []ImageRaw) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Images called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter Images called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 6 (Images): repeated nested message
-	if len(*m) < offsetToPrivate+21+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+21:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldCount int
-	var oldDataSize int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-		// Calculate old data size: 4 bytes count + for each item: 4 bytes size + data
-		oldDataSize = 4
-		currentOffset := oldPayloadOffset + 4
-		for i := 0; i < oldCount; i++ {
-			if len(*m) < currentOffset+4 {
-				break
-			}
-			itemSize := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
-			oldDataSize += 4 + itemSize
-			currentOffset += 4 + itemSize
-		}
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemLen
+			currentOffset += 4 + itemLen
+		}
 	}
 	newCount := len(v)
 	newDataSize := 4 // count
 	for _, item := range v {
-		newDataSize += 4 + len(item) // 4 bytes size + data
+		newDataSize += 4 + len(item) // 4 bytes length + data
 	}
 	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
 		// Update in-place (waste space)
 		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
 		currentOffset := oldPayloadOffset + 4
 		for _, item := range v {
-			itemSize := len(item)
-			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemSize))
+			itemLen := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
 			copy((*m)[currentOffset+4:], item)
-			currentOffset += 4 + itemSize
+			currentOffset += 4 + itemLen
 		}
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Hotel
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
 	}
-	temp.Images = make([]*Image, len(v))
-	for i, rawItem := range v {
-		temp.Images[i] = &Image{}
-		if err := temp.Images[i].UnmarshalSymphony([]byte(rawItem)); err != nil {
-			return fmt.Errorf("failed to unmarshal nested message: %w", err)
-		}
+	var temp GetProfilesRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	newData, err := temp.MarshalSymphony()
+	temp.Fields = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = HotelRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = GetProfilesRequestRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *Address) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *Address) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *GetProfilesResult) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
-	size += 32 // table
-	size += 4 + len(m.StreetNumber)
-	size += 4 + len(m.StreetName)
-	size += 4 + len(m.City)
-	size += 4 + len(m.State)
-	size += 4 + len(m.Country)
-	size += 4 + len(m.PostalCode)
+	size += 4 // table
+	size += 4 // count for Hotels
+	for _, item := range m.Hotels {
+		nested, _ := item.MarshalSymphony()
+		size += 4 + len(nested)
+	}
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -2649,69 +1985,39 @@ func (m *Address) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 32
+	payloadStart := tableStart + 4
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (StreetNumber): variable-length
+	// Field 1 (Hotels): repeated nested message
 	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.StreetNumber)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.StreetNumber)
-	payloadOffset += 4 + len(m.StreetNumber)
-
-	// Field 2 (StreetName): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.StreetName)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.StreetName)
-	payloadOffset += 4 + len(m.StreetName)
-
-	// Field 3 (City): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.City)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.City)
-	payloadOffset += 4 + len(m.City)
-
-	// Field 4 (State): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+12:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.State)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.State)
-	payloadOffset += 4 + len(m.State)
-
-	// Field 5 (Country): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+16:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.Country)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.Country)
-	payloadOffset += 4 + len(m.Country)
-
-	// Field 6 (PostalCode): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+20:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.PostalCode)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.PostalCode)
-	payloadOffset += 4 + len(m.PostalCode)
-
-	// Field 7 (Lat): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[tableStart+24:], math.Float32bits(m.Lat))
-
-	// Field 8 (Lon): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[tableStart+28:], math.Float32bits(m.Lon))
+	count = len(m.Hotels)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	payloadOffset += 4
+	currentOffset = payloadStart + payloadOffset
+	for _, item := range m.Hotels {
+		nestedData, err := item.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
+		copy(buf[currentOffset+4:], nestedData)
+		currentOffset += 4 + nestedSize
+		payloadOffset += 4 + nestedSize
+	}
 
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *Address) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *GetProfilesResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *Address) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *GetProfilesResult) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -2723,107 +2029,105 @@ func (m *Address) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (StreetNumber): variable-length
+	// Field 1 (Hotels): repeated nested message
 	if len(data) >= tableStart+0+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.StreetNumber = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 2 (StreetName): variable-length
-	if len(data) >= tableStart+4+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.StreetName = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 3 (City): variable-length
-	if len(data) >= tableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.City = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 4 (State): variable-length
-	if len(data) >= tableStart+12+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+12:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.State = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 5 (Country): variable-length
-	if len(data) >= tableStart+16+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+16:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Country = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 6 (PostalCode): variable-length
-	if len(data) >= tableStart+20+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+20:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.PostalCode = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.Hotels = make([]*Hotel, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						item := &Hotel{}
+						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
+							return fmt.Errorf("failed to unmarshal nested message: %w", err)
+						}
+						m.Hotels = append(m.Hotels, item)
+						currentOffset += 4 + itemLen
+					}
+				}
 			}
 		}
 	}
 
-	// Field 7 (Lat): fixed-length (4 bytes)
-	if len(data) < tableStart+28 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[tableStart+24:]))
-
-	// Field 8 (Lon): fixed-length (4 bytes)
-	if len(data) < tableStart+32 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[tableStart+28:]))
+	return nil
+}
 
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *GetProfilesResult) UnmarshalSymphonyPrivate(data []byte) error {
 	return nil
 }
 
-func (m *Address) MarshalSymphony() ([]byte, error) {
+func (m *GetProfilesResult) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 4  // table entries
+	// Field 1 (Hotels): repeated nested message payload
+	size += 4 // count
+	for _, item := range m.Hotels {
+		nestedSize1 := 0
+		// Public segment:
+		nestedSize1 += 1  // version byte
+		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
+		nestedSize1 += 24 // table entries
+		// Field 1 (Id): variable-length payload
+		nestedSize1 += 4 + len(item.Id) // 4 bytes length prefix + data
+		// Field 2 (Name): variable-length payload
+		nestedSize1 += 4 + len(item.Name) // 4 bytes length prefix + data
+		// Field 3 (PhoneNumber): variable-length payload
+		nestedSize1 += 4 + len(item.PhoneNumber) // 4 bytes length prefix + data
+		// Field 4 (Description): variable-length payload
+		nestedSize1 += 4 + len(item.Description) // 4 bytes length prefix + data
+		// Field 5 (Address): nested message payload
+		if item.Address != nil {
+			nestedSize2 := 0
+			// Public segment:
+			nestedSize2 += 1  // version byte
+			nestedSize2 += 12 // reserved: offset_to_private, service_name, method_name
+			nestedSize2 += 32 // table entries
+			// Field 1 (StreetNumber): variable-length payload
+			nestedSize2 += 4 + len(item.Address.StreetNumber) // 4 bytes length prefix + data
+			// Field 2 (StreetName): variable-length payload
+			nestedSize2 += 4 + len(item.Address.StreetName) // 4 bytes length prefix + data
+			// Field 3 (City): variable-length payload
+			nestedSize2 += 4 + len(item.Address.City) // 4 bytes length prefix + data
+			// Field 4 (State): variable-length payload
+			nestedSize2 += 4 + len(item.Address.State) // 4 bytes length prefix + data
+			// Field 5 (Country): variable-length payload
+			nestedSize2 += 4 + len(item.Address.Country) // 4 bytes length prefix + data
+			// Field 6 (PostalCode): variable-length payload
+			nestedSize2 += 4 + len(item.Address.PostalCode) // 4 bytes length prefix + data
+			// Private segment:
+			nestedSize2 += 1 // version byte
+
+			nestedSize1 += 4 + nestedSize2 // 4 bytes size + message data
+		}
+		// Field 6 (Images): repeated nested message payload
+		nestedSize1 += 4 // count
+		for _, item := range item.Images {
+			nestedSize2 := 0
+			// Public segment:
+			nestedSize2 += 1  // version byte
+			nestedSize2 += 12 // reserved: offset_to_private, service_name, method_name
+			nestedSize2 += 5  // table entries
+			// Field 1 (Url): variable-length payload
+			nestedSize2 += 4 + len(item.Url) // 4 bytes length prefix + data
+			// Private segment:
+			nestedSize2 += 1 // version byte
+
+			nestedSize1 += 4 + nestedSize2 // 4 bytes size + message data
+		}
+		// Private segment:
+		nestedSize1 += 1 // version byte
+
+		size += 4 + nestedSize1 // 4 bytes size + message data
+	}
 	// Private segment:
-	size += 1  // version byte
-	size += 32 // table entries
-	// Field 1 (StreetNumber): variable-length payload
-	size += 4 + len(m.StreetNumber) // 4 bytes length prefix + data
-	// Field 2 (StreetName): variable-length payload
-	size += 4 + len(m.StreetName) // 4 bytes length prefix + data
-	// Field 3 (City): variable-length payload
-	size += 4 + len(m.City) // 4 bytes length prefix + data
-	// Field 4 (State): variable-length payload
-	size += 4 + len(m.State) // 4 bytes length prefix + data
-	// Field 5 (Country): variable-length payload
-	size += 4 + len(m.Country) // 4 bytes length prefix + data
-	// Field 6 (PostalCode): variable-length payload
-	size += 4 + len(m.PostalCode) // 4 bytes length prefix + data
+	size += 1 // version byte
 
 	buf := make([]byte, size)
 
@@ -2839,6 +2143,12 @@ func (m *Address) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // field 1 count
+	for _, item := range m.Hotels {
+		nestedData, _ := item.MarshalSymphony()
+		publicSegmentSize += 4 + len(nestedData)
+	}
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -2847,75 +2157,45 @@ func (m *Address) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 4
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (Hotels): repeated nested message
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.Hotels)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	publicPayloadOffset += 4
+	currentOffset = publicPayloadStart + publicPayloadOffset
+	for _, item := range m.Hotels {
+		nestedData, err := item.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
+		copy(buf[currentOffset+4:], nestedData)
+		currentOffset += 4 + nestedSize
+		publicPayloadOffset += 4 + nestedSize
+	}
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 32 bytes table
-	privatePayloadStart := privateTableStart + 32
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (StreetNumber): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.StreetNumber)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.StreetNumber)
-	privatePayloadOffset += 4 + len(m.StreetNumber)
-
-	// Field 2 (StreetName): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.StreetName)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.StreetName)
-	privatePayloadOffset += 4 + len(m.StreetName)
-
-	// Field 3 (City): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+8:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.City)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.City)
-	privatePayloadOffset += 4 + len(m.City)
-
-	// Field 4 (State): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+12:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.State)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.State)
-	privatePayloadOffset += 4 + len(m.State)
-
-	// Field 5 (Country): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+16:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Country)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Country)
-	privatePayloadOffset += 4 + len(m.Country)
-
-	// Field 6 (PostalCode): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+20:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.PostalCode)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.PostalCode)
-	privatePayloadOffset += 4 + len(m.PostalCode)
-
-	// Field 7 (Lat): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[privateTableStart+24:], math.Float32bits(m.Lat))
-
-	// Field 8 (Lon): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[privateTableStart+28:], math.Float32bits(m.Lon))
-
 	return buf, nil
 }
 
-func (m *Address) UnmarshalSymphony(data []byte) error {
+func (m *GetProfilesResult) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -2947,270 +2227,9075 @@ func (m *Address) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (StreetNumber): variable-length
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.StreetNumber = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 2 (StreetName): variable-length
-	if len(data) >= privateTableStart+4+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	// Field 1 (Hotels): repeated nested message
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.StreetName = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.Hotels = make([]*Hotel, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						item := &Hotel{}
+						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
+							return fmt.Errorf("failed to unmarshal nested message: %w", err)
+						}
+						m.Hotels = append(m.Hotels, item)
+						currentOffset += 4 + itemLen
+					}
+				}
 			}
 		}
 	}
 
-	// Field 3 (City): variable-length
-	if len(data) >= privateTableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+8:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.City = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 4 (State): variable-length
-	if len(data) >= privateTableStart+12+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+12:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.State = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 5 (Country): variable-length
-	if len(data) >= privateTableStart+16+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+16:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Country = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 6 (PostalCode): variable-length
-	if len(data) >= privateTableStart+20+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+20:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.PostalCode = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 7 (Lat): fixed-length (4 bytes)
-	if len(data) < privateTableStart+28 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[privateTableStart+24:]))
-
-	// Field 8 (Lon): fixed-length (4 bytes)
-	if len(data) < privateTableStart+32 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[privateTableStart+28:]))
-
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
-type AddressRaw []byte
+type GetProfilesResultRaw []byte
 
-func (m AddressRaw) MarshalSymphony() ([]byte, error) {
+func (m GetProfilesResultRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *AddressRaw) UnmarshalSymphony(data []byte) error {
-	*m = AddressRaw(data)
+func (m *GetProfilesResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = GetProfilesResultRaw(data)
 	return nil
 }
 
-func (m AddressRaw) GetStreetNumber() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter StreetNumber called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter StreetNumber called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (StreetNumber): variable-length
-	if len(m) < offsetToPrivate+1+4 {
-		return ""
+func (m GetProfilesResultRaw) GetHotels() []HotelRaw {
+	// Field 1 (Hotels): repeated nested message
+	if len(m) < 13+4 {
+		return nil
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
 	if payloadOffset == 0 {
-		return ""
+		return nil
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
-	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
-}
-
-func (m AddressRaw) GetStreetName() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter StreetName called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+		return nil
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]HotelRaw, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
 		}
-		panic(fmt.Sprintf("private getter StreetName called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 2 (StreetName): variable-length
-	if len(m) < offsetToPrivate+5+4 {
-		return ""
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
-	if payloadOffset == 0 {
-		return ""
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
+		nestedSize := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+nestedSize {
+			return nil
+		}
+		result[i] = HotelRaw(m[currentOffset+4 : currentOffset+4+nestedSize])
+		currentOffset += 4 + nestedSize
 	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+	return result
 }
 
-func (m AddressRaw) GetCity() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter City called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
+func (m *GetProfilesResultRaw) SetHotels(v []HotelRaw) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Hotels called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private getter City called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 3 (City): variable-length
-	if len(m) < offsetToPrivate+9+4 {
-		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+9:]))
-	if payloadOffset == 0 {
-		return ""
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
+	// Field 1 (Hotels): repeated nested message
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
 	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldCount int
+	var oldDataSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes size + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemSize := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemSize
+			currentOffset += 4 + itemSize
+		}
 	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
-}
-
-func (m AddressRaw) GetState() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter State called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+	newCount := len(v)
+	newDataSize := 4 // count
+	for _, item := range v {
+		newDataSize += 4 + len(item) // 4 bytes size + data
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		currentOffset := oldPayloadOffset + 4
+		for _, item := range v {
+			itemSize := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemSize))
+			copy((*m)[currentOffset+4:], item)
+			currentOffset += 4 + itemSize
 		}
-		panic(fmt.Sprintf("private getter State called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 4 (State): variable-length
-	if len(m) < offsetToPrivate+13+4 {
-		return ""
+		return nil
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+13:]))
-	if payloadOffset == 0 {
-		return ""
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	var temp GetProfilesResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
+	temp.Hotels = make([]*Hotel, len(v))
+	for i, rawItem := range v {
+		temp.Hotels[i] = &Hotel{}
+		if err := temp.Hotels[i].UnmarshalSymphony([]byte(rawItem)); err != nil {
+			return fmt.Errorf("failed to unmarshal nested message: %w", err)
+		}
 	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = GetProfilesResultRaw(fullData[:offsetToPrivate])
+	return nil
 }
 
-func (m AddressRaw) GetCountry() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Country called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Country called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *Hotel) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 24 // table
+	size += 4 + len(m.Id)
+	size += 4 + len(m.Name)
+	size += 4 + len(m.PhoneNumber)
+	size += 4 + len(m.Description)
+	if m.Address != nil {
+		nested, _ := m.Address.MarshalSymphony()
+		size += 4 + len(nested)
 	}
-	// Field 5 (Country): variable-length
-	if len(m) < offsetToPrivate+17+4 {
-		return ""
+	size += 4 // count for Images
+	for _, item := range m.Images {
+		nested, _ := item.MarshalSymphony()
+		size += 4 + len(nested)
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+17:]))
-	if payloadOffset == 0 {
-		return ""
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 24
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (Id): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Id)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Id)
+	payloadOffset += 4 + len(m.Id)
+
+	// Field 2 (Name): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Name)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Name)
+	payloadOffset += 4 + len(m.Name)
+
+	// Field 3 (PhoneNumber): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.PhoneNumber)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.PhoneNumber)
+	payloadOffset += 4 + len(m.PhoneNumber)
+
+	// Field 4 (Description): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+12:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Description)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Description)
+	payloadOffset += 4 + len(m.Description)
+
+	// Field 5 (Address): nested message
+	if m.Address != nil {
+		binary.LittleEndian.PutUint32(buf[tableStart+16:], uint32(payloadStart+payloadOffset))
+		nestedData, err := m.Address.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(nestedSize))
+		copy(buf[payloadStart+payloadOffset+4:], nestedData)
+		payloadOffset += 4 + nestedSize
+	} else {
+		binary.LittleEndian.PutUint32(buf[tableStart+16:], 0)
+	}
+
+	// Field 6 (Images): repeated nested message
+	binary.LittleEndian.PutUint32(buf[tableStart+20:], uint32(payloadStart+payloadOffset))
+	count = len(m.Images)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	payloadOffset += 4
+	currentOffset = payloadStart + payloadOffset
+	for _, item := range m.Images {
+		nestedData, err := item.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
+		copy(buf[currentOffset+4:], nestedData)
+		currentOffset += 4 + nestedSize
+		payloadOffset += 4 + nestedSize
+	}
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *Hotel) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *Hotel) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (Id): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Id = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (Name): variable-length
+	if len(data) >= tableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Name = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 3 (PhoneNumber): variable-length
+	if len(data) >= tableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.PhoneNumber = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 4 (Description): variable-length
+	if len(data) >= tableStart+12+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+12:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Description = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 5 (Address): nested message
+	if len(data) >= tableStart+16+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+16:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Address = &Address{}
+				if err := m.Address.UnmarshalSymphony(data[payloadOffset+4 : payloadOffset+4+dataLen]); err != nil {
+					return fmt.Errorf("failed to unmarshal nested message: %w", err)
+				}
+			}
+		}
+	}
+
+	// Field 6 (Images): repeated nested message
+	if len(data) >= tableStart+20+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+20:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.Images = make([]*Image, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						item := &Image{}
+						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
+							return fmt.Errorf("failed to unmarshal nested message: %w", err)
+						}
+						m.Images = append(m.Images, item)
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *Hotel) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *Hotel) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 24 // table entries
+	// Field 1 (Id): variable-length payload
+	size += 4 + len(m.Id) // 4 bytes length prefix + data
+	// Field 2 (Name): variable-length payload
+	size += 4 + len(m.Name) // 4 bytes length prefix + data
+	// Field 3 (PhoneNumber): variable-length payload
+	size += 4 + len(m.PhoneNumber) // 4 bytes length prefix + data
+	// Field 4 (Description): variable-length payload
+	size += 4 + len(m.Description) // 4 bytes length prefix + data
+	// Field 5 (Address): nested message payload
+	if m.Address != nil {
+		nestedSize1 := 0
+		// Public segment:
+		nestedSize1 += 1  // version byte
+		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
+		nestedSize1 += 32 // table entries
+		// Field 1 (StreetNumber): variable-length payload
+		nestedSize1 += 4 + len(m.Address.StreetNumber) // 4 bytes length prefix + data
+		// Field 2 (StreetName): variable-length payload
+		nestedSize1 += 4 + len(m.Address.StreetName) // 4 bytes length prefix + data
+		// Field 3 (City): variable-length payload
+		nestedSize1 += 4 + len(m.Address.City) // 4 bytes length prefix + data
+		// Field 4 (State): variable-length payload
+		nestedSize1 += 4 + len(m.Address.State) // 4 bytes length prefix + data
+		// Field 5 (Country): variable-length payload
+		nestedSize1 += 4 + len(m.Address.Country) // 4 bytes length prefix + data
+		// Field 6 (PostalCode): variable-length payload
+		nestedSize1 += 4 + len(m.Address.PostalCode) // 4 bytes length prefix + data
+		// Private segment:
+		nestedSize1 += 1 // version byte
+
+		size += 4 + nestedSize1 // 4 bytes size + message data
+	}
+	// Field 6 (Images): repeated nested message payload
+	size += 4 // count
+	for _, item := range m.Images {
+		nestedSize1 := 0
+		// Public segment:
+		nestedSize1 += 1  // version byte
+		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
+		nestedSize1 += 5  // table entries
+		// Field 1 (Url): variable-length payload
+		nestedSize1 += 4 + len(item.Url) // 4 bytes length prefix + data
+		// Private segment:
+		nestedSize1 += 1 // version byte
+
+		size += 4 + nestedSize1 // 4 bytes size + message data
+	}
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4                      // offset placeholder
+	publicSegmentSize += 4                      // offset placeholder
+	publicSegmentSize += 4                      // offset placeholder
+	publicSegmentSize += 4                      // offset placeholder
+	publicSegmentSize += 4                      // offset placeholder
+	publicSegmentSize += 4                      // offset placeholder
+	publicSegmentSize += 4 + len(m.Id)          // field 1 payload
+	publicSegmentSize += 4 + len(m.Name)        // field 2 payload
+	publicSegmentSize += 4 + len(m.PhoneNumber) // field 3 payload
+	publicSegmentSize += 4 + len(m.Description) // field 4 payload
+	if m.Address != nil {
+		nestedData5, _ := m.Address.MarshalSymphony()
+		publicSegmentSize += 4 + len(nestedData5) // field 5 payload
+	}
+	publicSegmentSize += 4 // field 6 count
+	for _, item := range m.Images {
+		nestedData, _ := item.MarshalSymphony()
+		publicSegmentSize += 4 + len(nestedData)
+	}
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 24
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (Id): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Id)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Id)
+	publicPayloadOffset += 4 + len(m.Id)
+
+	// Field 2 (Name): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Name)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Name)
+	publicPayloadOffset += 4 + len(m.Name)
+
+	// Field 3 (PhoneNumber): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+8:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.PhoneNumber)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.PhoneNumber)
+	publicPayloadOffset += 4 + len(m.PhoneNumber)
+
+	// Field 4 (Description): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+12:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Description)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Description)
+	publicPayloadOffset += 4 + len(m.Description)
+
+	// Field 5 (Address): nested message
+	if m.Address != nil {
+		binary.LittleEndian.PutUint32(buf[publicTableStart+16:], uint32(publicPayloadStart+publicPayloadOffset))
+		nestedData, err := m.Address.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(nestedSize))
+		copy(buf[publicPayloadStart+publicPayloadOffset+4:], nestedData)
+		publicPayloadOffset += 4 + nestedSize
+	} else {
+		binary.LittleEndian.PutUint32(buf[publicTableStart+16:], 0)
+	}
+
+	// Field 6 (Images): repeated nested message
+	binary.LittleEndian.PutUint32(buf[publicTableStart+20:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.Images)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	publicPayloadOffset += 4
+	currentOffset = publicPayloadStart + publicPayloadOffset
+	for _, item := range m.Images {
+		nestedData, err := item.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
+		copy(buf[currentOffset+4:], nestedData)
+		currentOffset += 4 + nestedSize
+		publicPayloadOffset += 4 + nestedSize
+	}
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *Hotel) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (Id): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Id = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (Name): variable-length
+	if len(data) >= publicTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Name = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 3 (PhoneNumber): variable-length
+	if len(data) >= publicTableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+8:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.PhoneNumber = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 4 (Description): variable-length
+	if len(data) >= publicTableStart+12+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+12:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Description = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 5 (Address): nested message
+	if len(data) >= publicTableStart+16+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+16:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Address = &Address{}
+				if err := m.Address.UnmarshalSymphony(data[payloadOffset+4 : payloadOffset+4+dataLen]); err != nil {
+					return fmt.Errorf("failed to unmarshal nested message: %w", err)
+				}
+			}
+		}
+	}
+
+	// Field 6 (Images): repeated nested message
+	if len(data) >= publicTableStart+20+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+20:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.Images = make([]*Image, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						item := &Image{}
+						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
+							return fmt.Errorf("failed to unmarshal nested message: %w", err)
+						}
+						m.Images = append(m.Images, item)
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type HotelRaw []byte
+
+func (m HotelRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *HotelRaw) UnmarshalSymphony(data []byte) error {
+	*m = HotelRaw(data)
+	return nil
+}
+
+func (m HotelRaw) GetId() string {
+	// Field 1 (Id): variable-length
+	if len(m) < 13+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m HotelRaw) GetName() string {
+	// Field 2 (Name): variable-length
+	if len(m) < 17+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[17:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m HotelRaw) GetPhoneNumber() string {
+	// Field 3 (PhoneNumber): variable-length
+	if len(m) < 21+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[21:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m HotelRaw) GetDescription() string {
+	// Field 4 (Description): variable-length
+	if len(m) < 25+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[25:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m HotelRaw) GetAddress() AddressRaw {
+	// Field 5 (Address): nested message
+	if len(m) < 29+4 {
+		return nil
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[29:]))
+	if payloadOffset == 0 {
+		return nil
+	}
+	if len(m) < payloadOffset+4 {
+		return nil
+	}
+	nestedSize := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+nestedSize {
+		return nil
+	}
+	return AddressRaw(m[payloadOffset+4 : payloadOffset+4+nestedSize])
+}
+
+func (m HotelRaw) GetImages() []ImageRaw {
+	// Field 6 (Images): repeated nested message
+	if len(m) < 33+4 {
+		return nil
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[33:]))
+	if payloadOffset == 0 {
+		return nil
+	}
+	if len(m) < payloadOffset+4 {
+		return nil
+	}
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]ImageRaw, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
+		}
+		nestedSize := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+nestedSize {
+			return nil
+		}
+		result[i] = ImageRaw(m[currentOffset+4 : currentOffset+4+nestedSize])
+		currentOffset += 4 + nestedSize
+	}
+	return result
+}
+
+func (m *HotelRaw) SetId(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Id called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (Id): variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp Hotel
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Id = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = HotelRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *HotelRaw) SetName(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Name called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (Name): variable-length
+	if len(*m) < 17+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[17:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp Hotel
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Name = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = HotelRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *HotelRaw) SetPhoneNumber(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter PhoneNumber called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 3 (PhoneNumber): variable-length
+	if len(*m) < 21+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[21:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp Hotel
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.PhoneNumber = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = HotelRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *HotelRaw) SetDescription(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Description called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 4 (Description): variable-length
+	if len(*m) < 25+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[25:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp Hotel
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Description = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = HotelRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *HotelRaw) SetAddress(v AddressRaw) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Address called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 5 (Address): nested message
+	if len(*m) < 29+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[29:]))
+	var oldNestedSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldNestedSize = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newNestedSize := len(v)
+	if oldPayloadOffset > 0 && newNestedSize <= oldNestedSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newNestedSize))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	var temp Hotel
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	if temp.Address == nil {
+		temp.Address = &Address{}
+	}
+	if err := temp.Address.UnmarshalSymphony([]byte(v)); err != nil {
+		return fmt.Errorf("failed to unmarshal nested message: %w", err)
+	}
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = HotelRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *HotelRaw) SetImages(v []ImageRaw) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Images called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 6 (Images): repeated nested message
+	if len(*m) < 33+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[33:]))
+	var oldCount int
+	var oldDataSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes size + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemSize := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemSize
+			currentOffset += 4 + itemSize
+		}
+	}
+	newCount := len(v)
+	newDataSize := 4 // count
+	for _, item := range v {
+		newDataSize += 4 + len(item) // 4 bytes size + data
+	}
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		currentOffset := oldPayloadOffset + 4
+		for _, item := range v {
+			itemSize := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemSize))
+			copy((*m)[currentOffset+4:], item)
+			currentOffset += 4 + itemSize
+		}
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	var temp Hotel
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Images = make([]*Image, len(v))
+	for i, rawItem := range v {
+		temp.Images[i] = &Image{}
+		if err := temp.Images[i].UnmarshalSymphony([]byte(rawItem)); err != nil {
+			return fmt.Errorf("failed to unmarshal nested message: %w", err)
+		}
+	}
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = HotelRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *Address) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 32 // table
+	size += 4 + len(m.StreetNumber)
+	size += 4 + len(m.StreetName)
+	size += 4 + len(m.City)
+	size += 4 + len(m.State)
+	size += 4 + len(m.Country)
+	size += 4 + len(m.PostalCode)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 32
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (StreetNumber): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.StreetNumber)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.StreetNumber)
+	payloadOffset += 4 + len(m.StreetNumber)
+
+	// Field 2 (StreetName): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.StreetName)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.StreetName)
+	payloadOffset += 4 + len(m.StreetName)
+
+	// Field 3 (City): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.City)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.City)
+	payloadOffset += 4 + len(m.City)
+
+	// Field 4 (State): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+12:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.State)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.State)
+	payloadOffset += 4 + len(m.State)
+
+	// Field 5 (Country): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+16:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Country)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Country)
+	payloadOffset += 4 + len(m.Country)
+
+	// Field 6 (PostalCode): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+20:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.PostalCode)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.PostalCode)
+	payloadOffset += 4 + len(m.PostalCode)
+
+	// Field 7 (Lat): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+24:], math.Float32bits(m.Lat))
+
+	// Field 8 (Lon): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+28:], math.Float32bits(m.Lon))
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *Address) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *Address) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (StreetNumber): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.StreetNumber = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (StreetName): variable-length
+	if len(data) >= tableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.StreetName = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 3 (City): variable-length
+	if len(data) >= tableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.City = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 4 (State): variable-length
+	if len(data) >= tableStart+12+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+12:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.State = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 5 (Country): variable-length
+	if len(data) >= tableStart+16+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+16:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Country = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 6 (PostalCode): variable-length
+	if len(data) >= tableStart+20+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+20:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.PostalCode = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 7 (Lat): fixed-length (4 bytes)
+	if len(data) < tableStart+28 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[tableStart+24:]))
+
+	// Field 8 (Lon): fixed-length (4 bytes)
+	if len(data) < tableStart+32 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[tableStart+28:]))
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *Address) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *Address) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 32 // table entries
+	// Field 1 (StreetNumber): variable-length payload
+	size += 4 + len(m.StreetNumber) // 4 bytes length prefix + data
+	// Field 2 (StreetName): variable-length payload
+	size += 4 + len(m.StreetName) // 4 bytes length prefix + data
+	// Field 3 (City): variable-length payload
+	size += 4 + len(m.City) // 4 bytes length prefix + data
+	// Field 4 (State): variable-length payload
+	size += 4 + len(m.State) // 4 bytes length prefix + data
+	// Field 5 (Country): variable-length payload
+	size += 4 + len(m.Country) // 4 bytes length prefix + data
+	// Field 6 (PostalCode): variable-length payload
+	size += 4 + len(m.PostalCode) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4                       // offset placeholder
+	publicSegmentSize += 4                       // offset placeholder
+	publicSegmentSize += 4                       // offset placeholder
+	publicSegmentSize += 4                       // offset placeholder
+	publicSegmentSize += 4                       // offset placeholder
+	publicSegmentSize += 4                       // offset placeholder
+	publicSegmentSize += 4                       // field Lat
+	publicSegmentSize += 4                       // field Lon
+	publicSegmentSize += 4 + len(m.StreetNumber) // field 1 payload
+	publicSegmentSize += 4 + len(m.StreetName)   // field 2 payload
+	publicSegmentSize += 4 + len(m.City)         // field 3 payload
+	publicSegmentSize += 4 + len(m.State)        // field 4 payload
+	publicSegmentSize += 4 + len(m.Country)      // field 5 payload
+	publicSegmentSize += 4 + len(m.PostalCode)   // field 6 payload
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 32
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (StreetNumber): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.StreetNumber)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.StreetNumber)
+	publicPayloadOffset += 4 + len(m.StreetNumber)
+
+	// Field 2 (StreetName): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.StreetName)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.StreetName)
+	publicPayloadOffset += 4 + len(m.StreetName)
+
+	// Field 3 (City): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+8:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.City)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.City)
+	publicPayloadOffset += 4 + len(m.City)
+
+	// Field 4 (State): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+12:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.State)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.State)
+	publicPayloadOffset += 4 + len(m.State)
+
+	// Field 5 (Country): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+16:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Country)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Country)
+	publicPayloadOffset += 4 + len(m.Country)
+
+	// Field 6 (PostalCode): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+20:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.PostalCode)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.PostalCode)
+	publicPayloadOffset += 4 + len(m.PostalCode)
+
+	// Field 7 (Lat): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+24:], math.Float32bits(m.Lat))
+
+	// Field 8 (Lon): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+28:], math.Float32bits(m.Lon))
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *Address) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (StreetNumber): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.StreetNumber = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (StreetName): variable-length
+	if len(data) >= publicTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.StreetName = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 3 (City): variable-length
+	if len(data) >= publicTableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+8:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.City = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 4 (State): variable-length
+	if len(data) >= publicTableStart+12+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+12:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.State = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 5 (Country): variable-length
+	if len(data) >= publicTableStart+16+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+16:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Country = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 6 (PostalCode): variable-length
+	if len(data) >= publicTableStart+20+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+20:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.PostalCode = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 7 (Lat): fixed-length (4 bytes)
+	if len(data) < publicTableStart+28 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[publicTableStart+24:]))
+
+	// Field 8 (Lon): fixed-length (4 bytes)
+	if len(data) < publicTableStart+32 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[publicTableStart+28:]))
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type AddressRaw []byte
+
+func (m AddressRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *AddressRaw) UnmarshalSymphony(data []byte) error {
+	*m = AddressRaw(data)
+	return nil
+}
+
+func (m AddressRaw) GetStreetNumber() string {
+	// Field 1 (StreetNumber): variable-length
+	if len(m) < 13+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m AddressRaw) GetStreetName() string {
+	// Field 2 (StreetName): variable-length
+	if len(m) < 17+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[17:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m AddressRaw) GetCity() string {
+	// Field 3 (City): variable-length
+	if len(m) < 21+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[21:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m AddressRaw) GetState() string {
+	// Field 4 (State): variable-length
+	if len(m) < 25+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[25:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m AddressRaw) GetCountry() string {
+	// Field 5 (Country): variable-length
+	if len(m) < 29+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[29:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m AddressRaw) GetPostalCode() string {
+	// Field 6 (PostalCode): variable-length
+	if len(m) < 33+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[33:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m AddressRaw) GetLat() float32 {
+	// Field 7 (Lat): fixed-length (4 bytes)
+	if len(m) < 37+4 {
+		return 0
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(m[37:]))
+}
+
+func (m AddressRaw) GetLon() float32 {
+	// Field 8 (Lon): fixed-length (4 bytes)
+	if len(m) < 41+4 {
+		return 0
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(m[41:]))
+}
+
+func (m *AddressRaw) SetStreetNumber(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter StreetNumber called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (StreetNumber): variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp Address
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.StreetNumber = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = AddressRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *AddressRaw) SetStreetName(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter StreetName called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (StreetName): variable-length
+	if len(*m) < 17+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[17:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp Address
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.StreetName = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = AddressRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *AddressRaw) SetCity(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter City called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 3 (City): variable-length
+	if len(*m) < 21+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[21:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp Address
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.City = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = AddressRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *AddressRaw) SetState(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter State called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 4 (State): variable-length
+	if len(*m) < 25+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[25:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp Address
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.State = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = AddressRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *AddressRaw) SetCountry(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Country called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 5 (Country): variable-length
+	if len(*m) < 29+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[29:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp Address
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Country = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = AddressRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *AddressRaw) SetPostalCode(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter PostalCode called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 6 (PostalCode): variable-length
+	if len(*m) < 33+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[33:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp Address
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.PostalCode = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = AddressRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *AddressRaw) SetLat(v float32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lat called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 7 (Lat): fixed-length (4 bytes)
+	if len(*m) < 37+4 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint32((*m)[37:], math.Float32bits(v))
+	return nil
+}
+
+func (m *AddressRaw) SetLon(v float32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lon called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 8 (Lon): fixed-length (4 bytes)
+	if len(*m) < 41+4 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint32((*m)[41:], math.Float32bits(v))
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *Image) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 5 // table
+	size += 4 + len(m.Url)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 5
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (Url): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Url)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Url)
+	payloadOffset += 4 + len(m.Url)
+
+	// Field 2 (Default): fixed-length (1 bytes)
+	if m.Default {
+		buf[tableStart+4] = 1
+	} else {
+		buf[tableStart+4] = 0
+	}
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *Image) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *Image) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (Url): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Url = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (Default): fixed-length (1 bytes)
+	if len(data) < tableStart+5 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Default = data[tableStart+4] != 0
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *Image) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *Image) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 5  // table entries
+	// Field 1 (Url): variable-length payload
+	size += 4 + len(m.Url) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4              // offset placeholder
+	publicSegmentSize += 1              // field Default
+	publicSegmentSize += 4 + len(m.Url) // field 1 payload
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 5
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (Url): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Url)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Url)
+	publicPayloadOffset += 4 + len(m.Url)
+
+	// Field 2 (Default): fixed-length (1 bytes)
+	if m.Default {
+		buf[publicTableStart+4] = 1
+	} else {
+		buf[publicTableStart+4] = 0
+	}
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *Image) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (Url): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Url = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (Default): fixed-length (1 bytes)
+	if len(data) < publicTableStart+5 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Default = data[publicTableStart+4] != 0
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type ImageRaw []byte
+
+func (m ImageRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *ImageRaw) UnmarshalSymphony(data []byte) error {
+	*m = ImageRaw(data)
+	return nil
+}
+
+func (m ImageRaw) GetUrl() string {
+	// Field 1 (Url): variable-length
+	if len(m) < 13+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m ImageRaw) GetDefault() bool {
+	// Field 2 (Default): fixed-length (1 bytes)
+	if len(m) < 17+1 {
+		return false
+	}
+	return m[17] != 0
+}
+
+func (m *ImageRaw) SetUrl(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Url called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (Url): variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp Image
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Url = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = ImageRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *ImageRaw) SetDefault(v bool) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Default called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (Default): fixed-length (1 bytes)
+	if len(*m) < 17+1 {
+		return fmt.Errorf("buffer too short")
+	}
+	if v {
+		(*m)[17] = 1
+	} else {
+		(*m)[17] = 0
+	}
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *HotelRequest) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 28 // table
+	if m.Hotel != nil {
+		nested, _ := m.Hotel.MarshalSymphony()
+		size += 4 + len(nested)
+	}
+	size += 4 // count for RatePlans
+	for _, item := range m.RatePlans {
+		nested, _ := item.MarshalSymphony()
+		size += 4 + len(nested)
+	}
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 28
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (Hotel): nested message
+	if m.Hotel != nil {
+		binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+		nestedData, err := m.Hotel.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(nestedSize))
+		copy(buf[payloadStart+payloadOffset+4:], nestedData)
+		payloadOffset += 4 + nestedSize
+	} else {
+		binary.LittleEndian.PutUint32(buf[tableStart+0:], 0)
+	}
+
+	// Field 2 (RatePlans): repeated nested message
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
+	count = len(m.RatePlans)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	payloadOffset += 4
+	currentOffset = payloadStart + payloadOffset
+	for _, item := range m.RatePlans {
+		nestedData, err := item.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
+		copy(buf[currentOffset+4:], nestedData)
+		currentOffset += 4 + nestedSize
+		payloadOffset += 4 + nestedSize
+	}
+
+	// Field 3 (Rate): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+8:], math.Float64bits(m.Rate))
+
+	// Field 4 (Price): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+16:], math.Float64bits(m.Price))
+
+	// Field 5 (Capacity): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+24:], uint32(m.Capacity))
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *HotelRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *HotelRequest) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (Hotel): nested message
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Hotel = &Hotel{}
+				if err := m.Hotel.UnmarshalSymphony(data[payloadOffset+4 : payloadOffset+4+dataLen]); err != nil {
+					return fmt.Errorf("failed to unmarshal nested message: %w", err)
+				}
+			}
+		}
+	}
+
+	// Field 2 (RatePlans): repeated nested message
+	if len(data) >= tableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.RatePlans = make([]*RatePlan, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						item := &RatePlan{}
+						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
+							return fmt.Errorf("failed to unmarshal nested message: %w", err)
+						}
+						m.RatePlans = append(m.RatePlans, item)
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	// Field 3 (Rate): fixed-length (8 bytes)
+	if len(data) < tableStart+16 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Rate = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+8:]))
+
+	// Field 4 (Price): fixed-length (8 bytes)
+	if len(data) < tableStart+24 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Price = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+16:]))
+
+	// Field 5 (Capacity): fixed-length (4 bytes)
+	if len(data) < tableStart+28 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Capacity = int32(binary.LittleEndian.Uint32(data[tableStart+24:]))
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *HotelRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *HotelRequest) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 28 // table entries
+	// Field 1 (Hotel): nested message payload
+	if m.Hotel != nil {
+		nestedSize1 := 0
+		// Public segment:
+		nestedSize1 += 1  // version byte
+		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
+		nestedSize1 += 24 // table entries
+		// Field 1 (Id): variable-length payload
+		nestedSize1 += 4 + len(m.Hotel.Id) // 4 bytes length prefix + data
+		// Field 2 (Name): variable-length payload
+		nestedSize1 += 4 + len(m.Hotel.Name) // 4 bytes length prefix + data
+		// Field 3 (PhoneNumber): variable-length payload
+		nestedSize1 += 4 + len(m.Hotel.PhoneNumber) // 4 bytes length prefix + data
+		// Field 4 (Description): variable-length payload
+		nestedSize1 += 4 + len(m.Hotel.Description) // 4 bytes length prefix + data
+		// Field 5 (Address): nested message payload
+		if m.Hotel.Address != nil {
+			nestedSize2 := 0
+			// Public segment:
+			nestedSize2 += 1  // version byte
+			nestedSize2 += 12 // reserved: offset_to_private, service_name, method_name
+			nestedSize2 += 32 // table entries
+			// Field 1 (StreetNumber): variable-length payload
+			nestedSize2 += 4 + len(m.Hotel.Address.StreetNumber) // 4 bytes length prefix + data
+			// Field 2 (StreetName): variable-length payload
+			nestedSize2 += 4 + len(m.Hotel.Address.StreetName) // 4 bytes length prefix + data
+			// Field 3 (City): variable-length payload
+			nestedSize2 += 4 + len(m.Hotel.Address.City) // 4 bytes length prefix + data
+			// Field 4 (State): variable-length payload
+			nestedSize2 += 4 + len(m.Hotel.Address.State) // 4 bytes length prefix + data
+			// Field 5 (Country): variable-length payload
+			nestedSize2 += 4 + len(m.Hotel.Address.Country) // 4 bytes length prefix + data
+			// Field 6 (PostalCode): variable-length payload
+			nestedSize2 += 4 + len(m.Hotel.Address.PostalCode) // 4 bytes length prefix + data
+			// Private segment:
+			nestedSize2 += 1 // version byte
+
+			nestedSize1 += 4 + nestedSize2 // 4 bytes size + message data
+		}
+		// Field 6 (Images): repeated nested message payload
+		nestedSize1 += 4 // count
+		for _, item := range m.Hotel.Images {
+			nestedSize2 := 0
+			// Public segment:
+			nestedSize2 += 1  // version byte
+			nestedSize2 += 12 // reserved: offset_to_private, service_name, method_name
+			nestedSize2 += 5  // table entries
+			// Field 1 (Url): variable-length payload
+			nestedSize2 += 4 + len(item.Url) // 4 bytes length prefix + data
+			// Private segment:
+			nestedSize2 += 1 // version byte
+
+			nestedSize1 += 4 + nestedSize2 // 4 bytes size + message data
+		}
+		// Private segment:
+		nestedSize1 += 1 // version byte
+
+		size += 4 + nestedSize1 // 4 bytes size + message data
+	}
+	// Field 2 (RatePlans): repeated nested message payload
+	size += 4 // count
+	for _, item := range m.RatePlans {
+		nestedSize1 := 0
+		// Public segment:
+		nestedSize1 += 1  // version byte
+		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
+		nestedSize1 += 20 // table entries
+		// Field 1 (HotelId): variable-length payload
+		nestedSize1 += 4 + len(item.HotelId) // 4 bytes length prefix + data
+		// Field 2 (Code): variable-length payload
+		nestedSize1 += 4 + len(item.Code) // 4 bytes length prefix + data
+		// Field 3 (InDate): variable-length payload
+		nestedSize1 += 4 + len(item.InDate) // 4 bytes length prefix + data
+		// Field 4 (OutDate): variable-length payload
+		nestedSize1 += 4 + len(item.OutDate) // 4 bytes length prefix + data
+		// Field 5 (RoomType): nested message payload
+		if item.RoomType != nil {
+			nestedSize2 := 0
+			// Public segment:
+			nestedSize2 += 1  // version byte
+			nestedSize2 += 12 // reserved: offset_to_private, service_name, method_name
+			nestedSize2 += 36 // table entries
+			// Field 4 (Code): variable-length payload
+			nestedSize2 += 4 + len(item.RoomType.Code) // 4 bytes length prefix + data
+			// Field 5 (Currency): variable-length payload
+			nestedSize2 += 4 + len(item.RoomType.Currency) // 4 bytes length prefix + data
+			// Field 6 (RoomDescription): variable-length payload
+			nestedSize2 += 4 + len(item.RoomType.RoomDescription) // 4 bytes length prefix + data
+			// Private segment:
+			nestedSize2 += 1 // version byte
+
+			nestedSize1 += 4 + nestedSize2 // 4 bytes size + message data
+		}
+		// Private segment:
+		nestedSize1 += 1 // version byte
+
+		size += 4 + nestedSize1 // 4 bytes size + message data
+	}
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 8 // field Rate
+	publicSegmentSize += 8 // field Price
+	publicSegmentSize += 4 // field Capacity
+	if m.Hotel != nil {
+		nestedData1, _ := m.Hotel.MarshalSymphony()
+		publicSegmentSize += 4 + len(nestedData1) // field 1 payload
+	}
+	publicSegmentSize += 4 // field 2 count
+	for _, item := range m.RatePlans {
+		nestedData, _ := item.MarshalSymphony()
+		publicSegmentSize += 4 + len(nestedData)
+	}
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 28
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (Hotel): nested message
+	if m.Hotel != nil {
+		binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+		nestedData, err := m.Hotel.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(nestedSize))
+		copy(buf[publicPayloadStart+publicPayloadOffset+4:], nestedData)
+		publicPayloadOffset += 4 + nestedSize
+	} else {
+		binary.LittleEndian.PutUint32(buf[publicTableStart+0:], 0)
+	}
+
+	// Field 2 (RatePlans): repeated nested message
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.RatePlans)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	publicPayloadOffset += 4
+	currentOffset = publicPayloadStart + publicPayloadOffset
+	for _, item := range m.RatePlans {
+		nestedData, err := item.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
+		copy(buf[currentOffset+4:], nestedData)
+		currentOffset += 4 + nestedSize
+		publicPayloadOffset += 4 + nestedSize
+	}
+
+	// Field 3 (Rate): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+8:], math.Float64bits(m.Rate))
+
+	// Field 4 (Price): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+16:], math.Float64bits(m.Price))
+
+	// Field 5 (Capacity): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+24:], uint32(m.Capacity))
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *HotelRequest) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (Hotel): nested message
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Hotel = &Hotel{}
+				if err := m.Hotel.UnmarshalSymphony(data[payloadOffset+4 : payloadOffset+4+dataLen]); err != nil {
+					return fmt.Errorf("failed to unmarshal nested message: %w", err)
+				}
+			}
+		}
+	}
+
+	// Field 2 (RatePlans): repeated nested message
+	if len(data) >= publicTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.RatePlans = make([]*RatePlan, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						item := &RatePlan{}
+						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
+							return fmt.Errorf("failed to unmarshal nested message: %w", err)
+						}
+						m.RatePlans = append(m.RatePlans, item)
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	// Field 3 (Rate): fixed-length (8 bytes)
+	if len(data) < publicTableStart+16 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Rate = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+8:]))
+
+	// Field 4 (Price): fixed-length (8 bytes)
+	if len(data) < publicTableStart+24 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Price = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+16:]))
+
+	// Field 5 (Capacity): fixed-length (4 bytes)
+	if len(data) < publicTableStart+28 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Capacity = int32(binary.LittleEndian.Uint32(data[publicTableStart+24:]))
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type HotelRequestRaw []byte
+
+func (m HotelRequestRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *HotelRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = HotelRequestRaw(data)
+	return nil
+}
+
+func (m HotelRequestRaw) GetHotel() HotelRaw {
+	// Field 1 (Hotel): nested message
+	if len(m) < 13+4 {
+		return nil
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return nil
+	}
+	if len(m) < payloadOffset+4 {
+		return nil
+	}
+	nestedSize := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+nestedSize {
+		return nil
+	}
+	return HotelRaw(m[payloadOffset+4 : payloadOffset+4+nestedSize])
+}
+
+func (m HotelRequestRaw) GetRatePlans() []RatePlanRaw {
+	// Field 2 (RatePlans): repeated nested message
+	if len(m) < 17+4 {
+		return nil
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[17:]))
+	if payloadOffset == 0 {
+		return nil
+	}
+	if len(m) < payloadOffset+4 {
+		return nil
+	}
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]RatePlanRaw, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
+		}
+		nestedSize := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+nestedSize {
+			return nil
+		}
+		result[i] = RatePlanRaw(m[currentOffset+4 : currentOffset+4+nestedSize])
+		currentOffset += 4 + nestedSize
+	}
+	return result
+}
+
+func (m HotelRequestRaw) GetRate() float64 {
+	// Field 3 (Rate): fixed-length (8 bytes)
+	if len(m) < 21+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[21:]))
+}
+
+func (m HotelRequestRaw) GetPrice() float64 {
+	// Field 4 (Price): fixed-length (8 bytes)
+	if len(m) < 29+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[29:]))
+}
+
+func (m HotelRequestRaw) GetCapacity() int32 {
+	// Field 5 (Capacity): fixed-length (4 bytes)
+	if len(m) < 37+4 {
+		return 0
+	}
+	return int32(binary.LittleEndian.Uint32(m[37:]))
+}
+
+func (m *HotelRequestRaw) SetHotel(v HotelRaw) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Hotel called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (Hotel): nested message
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldNestedSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldNestedSize = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newNestedSize := len(v)
+	if oldPayloadOffset > 0 && newNestedSize <= oldNestedSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newNestedSize))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	var temp HotelRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	if temp.Hotel == nil {
+		temp.Hotel = &Hotel{}
+	}
+	if err := temp.Hotel.UnmarshalSymphony([]byte(v)); err != nil {
+		return fmt.Errorf("failed to unmarshal nested message: %w", err)
+	}
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = HotelRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *HotelRequestRaw) SetRatePlans(v []RatePlanRaw) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter RatePlans called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (RatePlans): repeated nested message
+	if len(*m) < 17+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[17:]))
+	var oldCount int
+	var oldDataSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes size + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemSize := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemSize
+			currentOffset += 4 + itemSize
+		}
+	}
+	newCount := len(v)
+	newDataSize := 4 // count
+	for _, item := range v {
+		newDataSize += 4 + len(item) // 4 bytes size + data
+	}
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		currentOffset := oldPayloadOffset + 4
+		for _, item := range v {
+			itemSize := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemSize))
+			copy((*m)[currentOffset+4:], item)
+			currentOffset += 4 + itemSize
+		}
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	var temp HotelRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.RatePlans = make([]*RatePlan, len(v))
+	for i, rawItem := range v {
+		temp.RatePlans[i] = &RatePlan{}
+		if err := temp.RatePlans[i].UnmarshalSymphony([]byte(rawItem)); err != nil {
+			return fmt.Errorf("failed to unmarshal nested message: %w", err)
+		}
+	}
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = HotelRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *HotelRequestRaw) SetRate(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Rate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 3 (Rate): fixed-length (8 bytes)
+	if len(*m) < 21+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[21:], math.Float64bits(v))
+	return nil
+}
+
+func (m *HotelRequestRaw) SetPrice(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Price called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 4 (Price): fixed-length (8 bytes)
+	if len(*m) < 29+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[29:], math.Float64bits(v))
+	return nil
+}
+
+func (m *HotelRequestRaw) SetCapacity(v int32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Capacity called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 5 (Capacity): fixed-length (4 bytes)
+	if len(*m) < 37+4 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint32((*m)[37:], uint32(v))
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *HotelResult) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 4 // table
+	size += 4 + len(m.HotelId)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 4
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.HotelId)
+	payloadOffset += 4 + len(m.HotelId)
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *HotelResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *HotelResult) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (HotelId): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *HotelResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *HotelResult) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 4  // table entries
+	// Field 1 (HotelId): variable-length payload
+	size += 4 + len(m.HotelId) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4 + len(m.HotelId) // field 1 payload
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 4
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.HotelId)
+	publicPayloadOffset += 4 + len(m.HotelId)
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *HotelResult) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (HotelId): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type HotelResultRaw []byte
+
+func (m HotelResultRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *HotelResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = HotelResultRaw(data)
+	return nil
+}
+
+func (m HotelResultRaw) GetHotelId() string {
+	// Field 1 (HotelId): variable-length
+	if len(m) < 13+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m *HotelResultRaw) SetHotelId(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (HotelId): variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp HotelResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = HotelResultRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *GetRecommendationsRequest) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 48 // table
+	size += 4 + len(m.Require)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 48
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (Require): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Require)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Require)
+	payloadOffset += 4 + len(m.Require)
+
+	// Field 2 (Lat): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+4:], math.Float64bits(m.Lat))
+
+	// Field 3 (Lon): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+12:], math.Float64bits(m.Lon))
+
+	// Field 4 (K): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+20:], uint32(m.K))
+
+	// Field 5 (DisWeight): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+24:], math.Float64bits(m.DisWeight))
+
+	// Field 6 (RateWeight): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+32:], math.Float64bits(m.RateWeight))
+
+	// Field 7 (PriceWeight): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+40:], math.Float64bits(m.PriceWeight))
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *GetRecommendationsRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	size := 0
+	size += 4 // table
+	size += 4 + len(m.Username)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 4
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 8 (Username): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Username)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Username)
+	payloadOffset += 4 + len(m.Username)
+
+	return buf, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *GetRecommendationsRequest) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (Require): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Require = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (Lat): fixed-length (8 bytes)
+	if len(data) < tableStart+12 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lat = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+4:]))
+
+	// Field 3 (Lon): fixed-length (8 bytes)
+	if len(data) < tableStart+20 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lon = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+12:]))
+
+	// Field 4 (K): fixed-length (4 bytes)
+	if len(data) < tableStart+24 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.K = int32(binary.LittleEndian.Uint32(data[tableStart+20:]))
+
+	// Field 5 (DisWeight): fixed-length (8 bytes)
+	if len(data) < tableStart+32 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.DisWeight = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+24:]))
+
+	// Field 6 (RateWeight): fixed-length (8 bytes)
+	if len(data) < tableStart+40 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.RateWeight = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+32:]))
+
+	// Field 7 (PriceWeight): fixed-length (8 bytes)
+	if len(data) < tableStart+48 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.PriceWeight = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+40:]))
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *GetRecommendationsRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 8 (Username): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Username = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *GetRecommendationsRequest) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 48 // table entries
+	// Field 1 (Require): variable-length payload
+	size += 4 + len(m.Require) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
+	size += 4 // table entries
+	// Field 8 (Username): variable-length payload
+	size += 4 + len(m.Username) // 4 bytes length prefix + data
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 8                  // field Lat
+	publicSegmentSize += 8                  // field Lon
+	publicSegmentSize += 4                  // field K
+	publicSegmentSize += 8                  // field DisWeight
+	publicSegmentSize += 8                  // field RateWeight
+	publicSegmentSize += 8                  // field PriceWeight
+	publicSegmentSize += 4 + len(m.Require) // field 1 payload
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 48
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (Require): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Require)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Require)
+	publicPayloadOffset += 4 + len(m.Require)
+
+	// Field 2 (Lat): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+4:], math.Float64bits(m.Lat))
+
+	// Field 3 (Lon): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+12:], math.Float64bits(m.Lon))
+
+	// Field 4 (K): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+20:], uint32(m.K))
+
+	// Field 5 (DisWeight): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+24:], math.Float64bits(m.DisWeight))
+
+	// Field 6 (RateWeight): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+32:], math.Float64bits(m.RateWeight))
+
+	// Field 7 (PriceWeight): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+40:], math.Float64bits(m.PriceWeight))
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 4 bytes table
+	privatePayloadStart := privateTableStart + 4
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	// Field 8 (Username): variable-length
+	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
+	dataLen = len(m.Username)
+	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
+	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Username)
+	privatePayloadOffset += 4 + len(m.Username)
+
+	return buf, nil
+}
+
+func (m *GetRecommendationsRequest) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (Require): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Require = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (Lat): fixed-length (8 bytes)
+	if len(data) < publicTableStart+12 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lat = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+4:]))
+
+	// Field 3 (Lon): fixed-length (8 bytes)
+	if len(data) < publicTableStart+20 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lon = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+12:]))
+
+	// Field 4 (K): fixed-length (4 bytes)
+	if len(data) < publicTableStart+24 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.K = int32(binary.LittleEndian.Uint32(data[publicTableStart+20:]))
+
+	// Field 5 (DisWeight): fixed-length (8 bytes)
+	if len(data) < publicTableStart+32 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.DisWeight = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+24:]))
+
+	// Field 6 (RateWeight): fixed-length (8 bytes)
+	if len(data) < publicTableStart+40 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.RateWeight = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+32:]))
+
+	// Field 7 (PriceWeight): fixed-length (8 bytes)
+	if len(data) < publicTableStart+48 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.PriceWeight = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+40:]))
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	// Field 8 (Username): variable-length
+	if len(data) >= privateTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
+		if payloadOffset > 0 {
+			payloadOffset += offsetToPrivate // convert relative offset to absolute
+		}
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Username = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	return nil
+}
+
+type GetRecommendationsRequestRaw []byte
+
+func (m GetRecommendationsRequestRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *GetRecommendationsRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = GetRecommendationsRequestRaw(data)
+	return nil
+}
+
+func (m GetRecommendationsRequestRaw) GetRequire() string {
+	// Field 1 (Require): variable-length
+	if len(m) < 13+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m GetRecommendationsRequestRaw) GetLat() float64 {
+	// Field 2 (Lat): fixed-length (8 bytes)
+	if len(m) < 17+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[17:]))
+}
+
+func (m GetRecommendationsRequestRaw) GetLon() float64 {
+	// Field 3 (Lon): fixed-length (8 bytes)
+	if len(m) < 25+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[25:]))
+}
+
+func (m GetRecommendationsRequestRaw) GetK() int32 {
+	// Field 4 (K): fixed-length (4 bytes)
+	if len(m) < 33+4 {
+		return 0
+	}
+	return int32(binary.LittleEndian.Uint32(m[33:]))
+}
+
+func (m GetRecommendationsRequestRaw) GetDisWeight() float64 {
+	// Field 5 (DisWeight): fixed-length (8 bytes)
+	if len(m) < 37+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[37:]))
+}
+
+func (m GetRecommendationsRequestRaw) GetRateWeight() float64 {
+	// Field 6 (RateWeight): fixed-length (8 bytes)
+	if len(m) < 45+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[45:]))
+}
+
+func (m GetRecommendationsRequestRaw) GetPriceWeight() float64 {
+	// Field 7 (PriceWeight): fixed-length (8 bytes)
+	if len(m) < 53+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[53:]))
+}
+
+func (m GetRecommendationsRequestRaw) GetUsername() string {
+	// ASSERT: Private field requires complete buffer
+	if len(m) < 5 {
+		panic(fmt.Sprintf("private getter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
+	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
+		marker := byte(0)
+		if offsetToPrivate < len(m) {
+			marker = m[offsetToPrivate]
+		}
+		panic(fmt.Sprintf("private getter Username called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+	}
+	// Field 8 (Username): variable-length
+	if len(m) < offsetToPrivate+1+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	payloadOffset += offsetToPrivate // convert relative offset to absolute
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m *GetRecommendationsRequestRaw) SetRequire(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Require called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (Require): variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp GetRecommendationsRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 4                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Require = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = GetRecommendationsRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *GetRecommendationsRequestRaw) SetLat(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lat called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (Lat): fixed-length (8 bytes)
+	if len(*m) < 17+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[17:], math.Float64bits(v))
+	return nil
+}
+
+func (m *GetRecommendationsRequestRaw) SetLon(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lon called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 3 (Lon): fixed-length (8 bytes)
+	if len(*m) < 25+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[25:], math.Float64bits(v))
+	return nil
+}
+
+func (m *GetRecommendationsRequestRaw) SetK(v int32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter K called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 4 (K): fixed-length (4 bytes)
+	if len(*m) < 33+4 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint32((*m)[33:], uint32(v))
+	return nil
+}
+
+func (m *GetRecommendationsRequestRaw) SetDisWeight(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter DisWeight called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 5 (DisWeight): fixed-length (8 bytes)
+	if len(*m) < 37+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[37:], math.Float64bits(v))
+	return nil
+}
+
+func (m *GetRecommendationsRequestRaw) SetRateWeight(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter RateWeight called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 6 (RateWeight): fixed-length (8 bytes)
+	if len(*m) < 45+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[45:], math.Float64bits(v))
+	return nil
+}
+
+func (m *GetRecommendationsRequestRaw) SetPriceWeight(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter PriceWeight called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 7 (PriceWeight): fixed-length (8 bytes)
+	if len(*m) < 53+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[53:], math.Float64bits(v))
+	return nil
+}
+
+func (m *GetRecommendationsRequestRaw) SetUsername(v string) error {
+	// ASSERT: Private field setter requires complete buffer
+	if len(*m) < 5 {
+		panic(fmt.Sprintf("private setter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
+		marker := byte(0)
+		if offsetToPrivate < len(*m) {
+			marker = (*m)[offsetToPrivate]
+		}
+		panic(fmt.Sprintf("private setter Username called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+	}
+	// Field 8 (Username): variable-length
+	if len(*m) < offsetToPrivate+1+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
+	if oldPayloadOffset > 0 {
+		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
+	}
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal
+	var temp GetRecommendationsRequest
+	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Username = v
+	newData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	*m = GetRecommendationsRequestRaw(newData)
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *GetRecommendationsResult) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 8 // table
+	size += 4 // count for HotelIds
+	for _, item := range m.HotelIds {
+		size += 4 + len(item)
+	}
+	size += 4 + 8*len(m.Scores)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 8
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (HotelIds): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	count = len(m.HotelIds)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	currentOffset = payloadStart + payloadOffset + 4
+	for _, item := range m.HotelIds {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	payloadOffset += 4 // count
+	for _, item := range m.HotelIds {
+		payloadOffset += 4 + len(item)
+	}
+
+	// Field 2 (Scores): repeated fixed-length
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
+	count = len(m.Scores)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	for i, v := range m.Scores {
+		binary.LittleEndian.PutUint64(buf[payloadStart+payloadOffset+4+8*i:], math.Float64bits(v))
+	}
+	payloadOffset += 4 + 8*len(m.Scores)
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *GetRecommendationsResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *GetRecommendationsResult) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (HotelIds): repeated variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.HotelIds = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	// Field 2 (Scores): repeated fixed-length
+	if len(data) >= tableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+count*8 {
+				m.Scores = make([]float64, count)
+				for i := 0; i < count; i++ {
+					m.Scores[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[payloadOffset+4+8*i:]))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *GetRecommendationsResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *GetRecommendationsResult) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 8  // table entries
+	// Field 1 (HotelIds): repeated variable-length payload
+	size += 4 // count
+	for _, item := range m.HotelIds {
+		size += 4 + len(item) // 4 bytes length prefix + data
+	}
+	// Field 2 (Scores): repeated fixed-length payload
+	size += 4 + 8*len(m.Scores) // 4 bytes count + data
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // field 1 count
+	for _, item := range m.HotelIds {
+		publicSegmentSize += 4 + len(item)
+	}
+	publicSegmentSize += 4 + 8*len(m.Scores) // field 2 payload
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 8
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (HotelIds): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.HotelIds)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	currentOffset = publicPayloadStart + publicPayloadOffset + 4
+	for _, item := range m.HotelIds {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	publicPayloadOffset += 4 // count
+	for _, item := range m.HotelIds {
+		publicPayloadOffset += 4 + len(item)
+	}
+
+	// Field 2 (Scores): repeated fixed-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.Scores)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	for i, v := range m.Scores {
+		binary.LittleEndian.PutUint64(buf[publicPayloadStart+publicPayloadOffset+4+8*i:], math.Float64bits(v))
+	}
+	publicPayloadOffset += 4 + 8*len(m.Scores)
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *GetRecommendationsResult) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (HotelIds): repeated variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.HotelIds = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	// Field 2 (Scores): repeated fixed-length
+	if len(data) >= publicTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+count*8 {
+				m.Scores = make([]float64, count)
+				for i := 0; i < count; i++ {
+					m.Scores[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[payloadOffset+4+8*i:]))
+				}
+			}
+		}
+	}
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type GetRecommendationsResultRaw []byte
+
+func (m GetRecommendationsResultRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *GetRecommendationsResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = GetRecommendationsResultRaw(data)
+	return nil
+}
+
+func (m GetRecommendationsResultRaw) GetHotelIds() []string {
+	// Field 1 (HotelIds): repeated variable-length
+	if len(m) < 13+4 {
+		return nil
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return nil
+	}
+	if len(m) < payloadOffset+4 {
+		return nil
+	}
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]string, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
+		}
+		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+itemLen {
+			return nil
+		}
+		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
+		currentOffset += 4 + itemLen
+	}
+	return result
+}
+
+func (m GetRecommendationsResultRaw) GetScores() []float64 {
+	// Field 2 (Scores): repeated fixed-length
+	if len(m) < 17+4 {
+		return nil
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[17:]))
+	if payloadOffset == 0 {
+		return nil
+	}
+	if len(m) < payloadOffset+4 {
+		return nil
+	}
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+8*count {
+		return nil
+	}
+	result := make([]float64, count)
+	for i := 0; i < count; i++ {
+		result[i] = math.Float64frombits(binary.LittleEndian.Uint64(m[payloadOffset+4+8*i:]))
+	}
+	return result
+}
+
+func (m *GetRecommendationsResultRaw) SetHotelIds(v []string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelIds called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (HotelIds): repeated variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldCount int
+	var oldDataSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemLen
+			currentOffset += 4 + itemLen
+		}
+	}
+	newCount := len(v)
+	newDataSize := 4 // count
+	for _, item := range v {
+		newDataSize += 4 + len(item) // 4 bytes length + data
+	}
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		currentOffset := oldPayloadOffset + 4
+		for _, item := range v {
+			itemLen := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
+			copy((*m)[currentOffset+4:], item)
+			currentOffset += 4 + itemLen
+		}
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp GetRecommendationsResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.HotelIds = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = GetRecommendationsResultRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *GetRecommendationsResultRaw) SetScores(v []float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Scores called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (Scores): repeated fixed-length
+	if len(*m) < 17+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[17:]))
+	var oldCount int
+	var oldDataSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		oldDataSize = 4 + 8*oldCount // 4 bytes count + data
+	}
+	newCount := len(v)
+	newDataSize := 4 + 8*newCount // 4 bytes count + data
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		for i, val := range v {
+			binary.LittleEndian.PutUint64((*m)[oldPayloadOffset+4+8*i:], math.Float64bits(val))
+		}
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp GetRecommendationsResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Scores = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = GetRecommendationsResultRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *SetHotelRequest) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 36 // table
+	size += 4 + len(m.HotelId)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 36
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.HotelId)
+	payloadOffset += 4 + len(m.HotelId)
+
+	// Field 2 (Lat): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+4:], math.Float64bits(m.Lat))
+
+	// Field 3 (Lon): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+12:], math.Float64bits(m.Lon))
+
+	// Field 4 (Rate): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+20:], math.Float64bits(m.Rate))
+
+	// Field 5 (Price): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+28:], math.Float64bits(m.Price))
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *SetHotelRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *SetHotelRequest) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (HotelId): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (Lat): fixed-length (8 bytes)
+	if len(data) < tableStart+12 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lat = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+4:]))
+
+	// Field 3 (Lon): fixed-length (8 bytes)
+	if len(data) < tableStart+20 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lon = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+12:]))
+
+	// Field 4 (Rate): fixed-length (8 bytes)
+	if len(data) < tableStart+28 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Rate = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+20:]))
+
+	// Field 5 (Price): fixed-length (8 bytes)
+	if len(data) < tableStart+36 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Price = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+28:]))
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *SetHotelRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *SetHotelRequest) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 36 // table entries
+	// Field 1 (HotelId): variable-length payload
+	size += 4 + len(m.HotelId) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 8                  // field Lat
+	publicSegmentSize += 8                  // field Lon
+	publicSegmentSize += 8                  // field Rate
+	publicSegmentSize += 8                  // field Price
+	publicSegmentSize += 4 + len(m.HotelId) // field 1 payload
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 36
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.HotelId)
+	publicPayloadOffset += 4 + len(m.HotelId)
+
+	// Field 2 (Lat): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+4:], math.Float64bits(m.Lat))
+
+	// Field 3 (Lon): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+12:], math.Float64bits(m.Lon))
+
+	// Field 4 (Rate): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+20:], math.Float64bits(m.Rate))
+
+	// Field 5 (Price): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+28:], math.Float64bits(m.Price))
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *SetHotelRequest) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (HotelId): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (Lat): fixed-length (8 bytes)
+	if len(data) < publicTableStart+12 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lat = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+4:]))
+
+	// Field 3 (Lon): fixed-length (8 bytes)
+	if len(data) < publicTableStart+20 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lon = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+12:]))
+
+	// Field 4 (Rate): fixed-length (8 bytes)
+	if len(data) < publicTableStart+28 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Rate = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+20:]))
+
+	// Field 5 (Price): fixed-length (8 bytes)
+	if len(data) < publicTableStart+36 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Price = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+28:]))
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type SetHotelRequestRaw []byte
+
+func (m SetHotelRequestRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *SetHotelRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = SetHotelRequestRaw(data)
+	return nil
+}
+
+func (m SetHotelRequestRaw) GetHotelId() string {
+	// Field 1 (HotelId): variable-length
+	if len(m) < 13+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m SetHotelRequestRaw) GetLat() float64 {
+	// Field 2 (Lat): fixed-length (8 bytes)
+	if len(m) < 17+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[17:]))
+}
+
+func (m SetHotelRequestRaw) GetLon() float64 {
+	// Field 3 (Lon): fixed-length (8 bytes)
+	if len(m) < 25+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[25:]))
+}
+
+func (m SetHotelRequestRaw) GetRate() float64 {
+	// Field 4 (Rate): fixed-length (8 bytes)
+	if len(m) < 33+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[33:]))
+}
+
+func (m SetHotelRequestRaw) GetPrice() float64 {
+	// Field 5 (Price): fixed-length (8 bytes)
+	if len(m) < 41+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[41:]))
+}
+
+func (m *SetHotelRequestRaw) SetHotelId(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (HotelId): variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp SetHotelRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SetHotelRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *SetHotelRequestRaw) SetLat(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lat called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (Lat): fixed-length (8 bytes)
+	if len(*m) < 17+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[17:], math.Float64bits(v))
+	return nil
+}
+
+func (m *SetHotelRequestRaw) SetLon(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lon called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 3 (Lon): fixed-length (8 bytes)
+	if len(*m) < 25+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[25:], math.Float64bits(v))
+	return nil
+}
+
+func (m *SetHotelRequestRaw) SetRate(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Rate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 4 (Rate): fixed-length (8 bytes)
+	if len(*m) < 33+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[33:], math.Float64bits(v))
+	return nil
+}
+
+func (m *SetHotelRequestRaw) SetPrice(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Price called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 5 (Price): fixed-length (8 bytes)
+	if len(*m) < 41+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[41:], math.Float64bits(v))
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *SetHotelResult) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 4 // table
+	size += 4 + len(m.HotelId)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 4
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.HotelId)
+	payloadOffset += 4 + len(m.HotelId)
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *SetHotelResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *SetHotelResult) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (HotelId): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *SetHotelResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *SetHotelResult) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 4  // table entries
+	// Field 1 (HotelId): variable-length payload
+	size += 4 + len(m.HotelId) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4 + len(m.HotelId) // field 1 payload
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 4
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.HotelId)
+	publicPayloadOffset += 4 + len(m.HotelId)
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *SetHotelResult) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (HotelId): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type SetHotelResultRaw []byte
+
+func (m SetHotelResultRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *SetHotelResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = SetHotelResultRaw(data)
+	return nil
+}
+
+func (m SetHotelResultRaw) GetHotelId() string {
+	// Field 1 (HotelId): variable-length
+	if len(m) < 13+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m *SetHotelResultRaw) SetHotelId(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (HotelId): variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp SetHotelResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SetHotelResultRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *ReloadHotelsRequest) MarshalSymphonyPublic() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *ReloadHotelsRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *ReloadHotelsRequest) UnmarshalSymphonyPublic(data []byte) error {
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *ReloadHotelsRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *ReloadHotelsRequest) MarshalSymphony() ([]byte, error) {
+	// Empty message - public segment with header only, empty private segment
+	buf := make([]byte, 14)                     // 1 version + 12 reserved + 1 version for private
+	buf[0] = 0x01                               // public version
+	binary.LittleEndian.PutUint32(buf[1:5], 13) // offset_to_private
+	// service_name and method_name stay 0
+	buf[13] = 0x01 // private version
+	return buf, nil
+}
+
+func (m *ReloadHotelsRequest) UnmarshalSymphony(data []byte) error {
+	// Empty message - just validate version bytes
+	if len(data) < 14 {
+		return fmt.Errorf("invalid data: too short")
+	}
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+	return nil
+}
+
+type ReloadHotelsRequestRaw []byte
+
+func (m ReloadHotelsRequestRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *ReloadHotelsRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = ReloadHotelsRequestRaw(data)
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *ReloadHotelsResult) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 4 // table
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 4
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (Count): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(m.Count))
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *ReloadHotelsResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *ReloadHotelsResult) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (Count): fixed-length (4 bytes)
+	if len(data) < tableStart+4 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Count = int32(binary.LittleEndian.Uint32(data[tableStart+0:]))
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *ReloadHotelsResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *ReloadHotelsResult) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 4  // table entries
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4 // field Count
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 4
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (Count): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(m.Count))
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *ReloadHotelsResult) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (Count): fixed-length (4 bytes)
+	if len(data) < publicTableStart+4 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Count = int32(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type ReloadHotelsResultRaw []byte
+
+func (m ReloadHotelsResultRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *ReloadHotelsResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = ReloadHotelsResultRaw(data)
+	return nil
+}
+
+func (m ReloadHotelsResultRaw) GetCount() int32 {
+	// Field 1 (Count): fixed-length (4 bytes)
+	if len(m) < 13+4 {
+		return 0
+	}
+	return int32(binary.LittleEndian.Uint32(m[13:]))
+}
+
+func (m *ReloadHotelsResultRaw) SetCount(v int32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Count called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (Count): fixed-length (4 bytes)
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint32((*m)[13:], uint32(v))
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *GetRatesRequest) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 12 // table
+	size += 4  // count for HotelIds
+	for _, item := range m.HotelIds {
+		size += 4 + len(item)
+	}
+	size += 4 + len(m.InDate)
+	size += 4 + len(m.OutDate)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 12
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (HotelIds): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	count = len(m.HotelIds)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	currentOffset = payloadStart + payloadOffset + 4
+	for _, item := range m.HotelIds {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	payloadOffset += 4 // count
+	for _, item := range m.HotelIds {
+		payloadOffset += 4 + len(item)
+	}
+
+	// Field 2 (InDate): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.InDate)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.InDate)
+	payloadOffset += 4 + len(m.InDate)
+
+	// Field 3 (OutDate): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.OutDate)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.OutDate)
+	payloadOffset += 4 + len(m.OutDate)
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *GetRatesRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *GetRatesRequest) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (HotelIds): repeated variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.HotelIds = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	// Field 2 (InDate): variable-length
+	if len(data) >= tableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 3 (OutDate): variable-length
+	if len(data) >= tableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *GetRatesRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *GetRatesRequest) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 12 // table entries
+	// Field 1 (HotelIds): repeated variable-length payload
+	size += 4 // count
+	for _, item := range m.HotelIds {
+		size += 4 + len(item) // 4 bytes length prefix + data
+	}
+	// Field 2 (InDate): variable-length payload
+	size += 4 + len(m.InDate) // 4 bytes length prefix + data
+	// Field 3 (OutDate): variable-length payload
+	size += 4 + len(m.OutDate) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // field 1 count
+	for _, item := range m.HotelIds {
+		publicSegmentSize += 4 + len(item)
+	}
+	publicSegmentSize += 4 + len(m.InDate)  // field 2 payload
+	publicSegmentSize += 4 + len(m.OutDate) // field 3 payload
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 12
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (HotelIds): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.HotelIds)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	currentOffset = publicPayloadStart + publicPayloadOffset + 4
+	for _, item := range m.HotelIds {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	publicPayloadOffset += 4 // count
+	for _, item := range m.HotelIds {
+		publicPayloadOffset += 4 + len(item)
+	}
+
+	// Field 2 (InDate): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.InDate)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.InDate)
+	publicPayloadOffset += 4 + len(m.InDate)
+
+	// Field 3 (OutDate): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+8:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.OutDate)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.OutDate)
+	publicPayloadOffset += 4 + len(m.OutDate)
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *GetRatesRequest) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (HotelIds): repeated variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.HotelIds = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	// Field 2 (InDate): variable-length
+	if len(data) >= publicTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 3 (OutDate): variable-length
+	if len(data) >= publicTableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+8:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type GetRatesRequestRaw []byte
+
+func (m GetRatesRequestRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *GetRatesRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = GetRatesRequestRaw(data)
+	return nil
+}
+
+func (m GetRatesRequestRaw) GetHotelIds() []string {
+	// Field 1 (HotelIds): repeated variable-length
+	if len(m) < 13+4 {
+		return nil
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return nil
+	}
+	if len(m) < payloadOffset+4 {
+		return nil
+	}
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]string, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
+		}
+		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+itemLen {
+			return nil
+		}
+		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
+		currentOffset += 4 + itemLen
+	}
+	return result
+}
+
+func (m GetRatesRequestRaw) GetInDate() string {
+	// Field 2 (InDate): variable-length
+	if len(m) < 17+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[17:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m GetRatesRequestRaw) GetOutDate() string {
+	// Field 3 (OutDate): variable-length
+	if len(m) < 21+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[21:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m *GetRatesRequestRaw) SetHotelIds(v []string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelIds called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (HotelIds): repeated variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldCount int
+	var oldDataSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemLen
+			currentOffset += 4 + itemLen
+		}
+	}
+	newCount := len(v)
+	newDataSize := 4 // count
+	for _, item := range v {
+		newDataSize += 4 + len(item) // 4 bytes length + data
+	}
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		currentOffset := oldPayloadOffset + 4
+		for _, item := range v {
+			itemLen := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
+			copy((*m)[currentOffset+4:], item)
+			currentOffset += 4 + itemLen
+		}
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp GetRatesRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.HotelIds = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = GetRatesRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *GetRatesRequestRaw) SetInDate(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter InDate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (InDate): variable-length
+	if len(*m) < 17+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[17:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp GetRatesRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.InDate = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = GetRatesRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *GetRatesRequestRaw) SetOutDate(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter OutDate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 3 (OutDate): variable-length
+	if len(*m) < 21+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[21:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp GetRatesRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.OutDate = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = GetRatesRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *GetRatesResult) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 4 // table
+	size += 4 // count for RatePlans
+	for _, item := range m.RatePlans {
+		nested, _ := item.MarshalSymphony()
+		size += 4 + len(nested)
+	}
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 4
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (RatePlans): repeated nested message
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	count = len(m.RatePlans)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	payloadOffset += 4
+	currentOffset = payloadStart + payloadOffset
+	for _, item := range m.RatePlans {
+		nestedData, err := item.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
+		copy(buf[currentOffset+4:], nestedData)
+		currentOffset += 4 + nestedSize
+		payloadOffset += 4 + nestedSize
+	}
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *GetRatesResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *GetRatesResult) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (RatePlans): repeated nested message
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.RatePlans = make([]*RatePlan, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						item := &RatePlan{}
+						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
+							return fmt.Errorf("failed to unmarshal nested message: %w", err)
+						}
+						m.RatePlans = append(m.RatePlans, item)
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *GetRatesResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *GetRatesResult) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 4  // table entries
+	// Field 1 (RatePlans): repeated nested message payload
+	size += 4 // count
+	for _, item := range m.RatePlans {
+		nestedSize1 := 0
+		// Public segment:
+		nestedSize1 += 1  // version byte
+		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
+		nestedSize1 += 20 // table entries
+		// Field 1 (HotelId): variable-length payload
+		nestedSize1 += 4 + len(item.HotelId) // 4 bytes length prefix + data
+		// Field 2 (Code): variable-length payload
+		nestedSize1 += 4 + len(item.Code) // 4 bytes length prefix + data
+		// Field 3 (InDate): variable-length payload
+		nestedSize1 += 4 + len(item.InDate) // 4 bytes length prefix + data
+		// Field 4 (OutDate): variable-length payload
+		nestedSize1 += 4 + len(item.OutDate) // 4 bytes length prefix + data
+		// Field 5 (RoomType): nested message payload
+		if item.RoomType != nil {
+			nestedSize2 := 0
+			// Public segment:
+			nestedSize2 += 1  // version byte
+			nestedSize2 += 12 // reserved: offset_to_private, service_name, method_name
+			nestedSize2 += 36 // table entries
+			// Field 4 (Code): variable-length payload
+			nestedSize2 += 4 + len(item.RoomType.Code) // 4 bytes length prefix + data
+			// Field 5 (Currency): variable-length payload
+			nestedSize2 += 4 + len(item.RoomType.Currency) // 4 bytes length prefix + data
+			// Field 6 (RoomDescription): variable-length payload
+			nestedSize2 += 4 + len(item.RoomType.RoomDescription) // 4 bytes length prefix + data
+			// Private segment:
+			nestedSize2 += 1 // version byte
+
+			nestedSize1 += 4 + nestedSize2 // 4 bytes size + message data
+		}
+		// Private segment:
+		nestedSize1 += 1 // version byte
+
+		size += 4 + nestedSize1 // 4 bytes size + message data
+	}
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // field 1 count
+	for _, item := range m.RatePlans {
+		nestedData, _ := item.MarshalSymphony()
+		publicSegmentSize += 4 + len(nestedData)
+	}
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 4
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (RatePlans): repeated nested message
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.RatePlans)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	publicPayloadOffset += 4
+	currentOffset = publicPayloadStart + publicPayloadOffset
+	for _, item := range m.RatePlans {
+		nestedData, err := item.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
+		copy(buf[currentOffset+4:], nestedData)
+		currentOffset += 4 + nestedSize
+		publicPayloadOffset += 4 + nestedSize
+	}
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *GetRatesResult) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (RatePlans): repeated nested message
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.RatePlans = make([]*RatePlan, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						item := &RatePlan{}
+						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
+							return fmt.Errorf("failed to unmarshal nested message: %w", err)
+						}
+						m.RatePlans = append(m.RatePlans, item)
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type GetRatesResultRaw []byte
+
+func (m GetRatesResultRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *GetRatesResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = GetRatesResultRaw(data)
+	return nil
+}
+
+func (m GetRatesResultRaw) GetRatePlans() []RatePlanRaw {
+	// Field 1 (RatePlans): repeated nested message
+	if len(m) < 13+4 {
+		return nil
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return nil
+	}
+	if len(m) < payloadOffset+4 {
+		return nil
+	}
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]RatePlanRaw, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
+		}
+		nestedSize := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+nestedSize {
+			return nil
+		}
+		result[i] = RatePlanRaw(m[currentOffset+4 : currentOffset+4+nestedSize])
+		currentOffset += 4 + nestedSize
+	}
+	return result
+}
+
+func (m *GetRatesResultRaw) SetRatePlans(v []RatePlanRaw) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter RatePlans called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (RatePlans): repeated nested message
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldCount int
+	var oldDataSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes size + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemSize := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemSize
+			currentOffset += 4 + itemSize
+		}
+	}
+	newCount := len(v)
+	newDataSize := 4 // count
+	for _, item := range v {
+		newDataSize += 4 + len(item) // 4 bytes size + data
+	}
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		currentOffset := oldPayloadOffset + 4
+		for _, item := range v {
+			itemSize := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemSize))
+			copy((*m)[currentOffset+4:], item)
+			currentOffset += 4 + itemSize
+		}
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	var temp GetRatesResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.RatePlans = make([]*RatePlan, len(v))
+	for i, rawItem := range v {
+		temp.RatePlans[i] = &RatePlan{}
+		if err := temp.RatePlans[i].UnmarshalSymphony([]byte(rawItem)); err != nil {
+			return fmt.Errorf("failed to unmarshal nested message: %w", err)
+		}
+	}
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = GetRatesResultRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *RatePlan) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 20 // table
+	size += 4 + len(m.HotelId)
+	size += 4 + len(m.Code)
+	size += 4 + len(m.InDate)
+	size += 4 + len(m.OutDate)
+	if m.RoomType != nil {
+		nested, _ := m.RoomType.MarshalSymphony()
+		size += 4 + len(nested)
+	}
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 20
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.HotelId)
+	payloadOffset += 4 + len(m.HotelId)
+
+	// Field 2 (Code): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Code)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Code)
+	payloadOffset += 4 + len(m.Code)
+
+	// Field 3 (InDate): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.InDate)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.InDate)
+	payloadOffset += 4 + len(m.InDate)
+
+	// Field 4 (OutDate): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+12:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.OutDate)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.OutDate)
+	payloadOffset += 4 + len(m.OutDate)
+
+	// Field 5 (RoomType): nested message
+	if m.RoomType != nil {
+		binary.LittleEndian.PutUint32(buf[tableStart+16:], uint32(payloadStart+payloadOffset))
+		nestedData, err := m.RoomType.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(nestedSize))
+		copy(buf[payloadStart+payloadOffset+4:], nestedData)
+		payloadOffset += 4 + nestedSize
+	} else {
+		binary.LittleEndian.PutUint32(buf[tableStart+16:], 0)
+	}
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *RatePlan) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *RatePlan) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (HotelId): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (Code): variable-length
+	if len(data) >= tableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Code = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 3 (InDate): variable-length
+	if len(data) >= tableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 4 (OutDate): variable-length
+	if len(data) >= tableStart+12+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+12:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 5 (RoomType): nested message
+	if len(data) >= tableStart+16+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+16:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.RoomType = &RoomType{}
+				if err := m.RoomType.UnmarshalSymphony(data[payloadOffset+4 : payloadOffset+4+dataLen]); err != nil {
+					return fmt.Errorf("failed to unmarshal nested message: %w", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *RatePlan) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *RatePlan) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 20 // table entries
+	// Field 1 (HotelId): variable-length payload
+	size += 4 + len(m.HotelId) // 4 bytes length prefix + data
+	// Field 2 (Code): variable-length payload
+	size += 4 + len(m.Code) // 4 bytes length prefix + data
+	// Field 3 (InDate): variable-length payload
+	size += 4 + len(m.InDate) // 4 bytes length prefix + data
+	// Field 4 (OutDate): variable-length payload
+	size += 4 + len(m.OutDate) // 4 bytes length prefix + data
+	// Field 5 (RoomType): nested message payload
+	if m.RoomType != nil {
+		nestedSize1 := 0
+		// Public segment:
+		nestedSize1 += 1  // version byte
+		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
+		nestedSize1 += 36 // table entries
+		// Field 4 (Code): variable-length payload
+		nestedSize1 += 4 + len(m.RoomType.Code) // 4 bytes length prefix + data
+		// Field 5 (Currency): variable-length payload
+		nestedSize1 += 4 + len(m.RoomType.Currency) // 4 bytes length prefix + data
+		// Field 6 (RoomDescription): variable-length payload
+		nestedSize1 += 4 + len(m.RoomType.RoomDescription) // 4 bytes length prefix + data
+		// Private segment:
+		nestedSize1 += 1 // version byte
+
+		size += 4 + nestedSize1 // 4 bytes size + message data
+	}
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4 + len(m.HotelId) // field 1 payload
+	publicSegmentSize += 4 + len(m.Code)    // field 2 payload
+	publicSegmentSize += 4 + len(m.InDate)  // field 3 payload
+	publicSegmentSize += 4 + len(m.OutDate) // field 4 payload
+	if m.RoomType != nil {
+		nestedData5, _ := m.RoomType.MarshalSymphony()
+		publicSegmentSize += 4 + len(nestedData5) // field 5 payload
+	}
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 20
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.HotelId)
+	publicPayloadOffset += 4 + len(m.HotelId)
+
+	// Field 2 (Code): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Code)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Code)
+	publicPayloadOffset += 4 + len(m.Code)
+
+	// Field 3 (InDate): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+8:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.InDate)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.InDate)
+	publicPayloadOffset += 4 + len(m.InDate)
+
+	// Field 4 (OutDate): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+12:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.OutDate)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.OutDate)
+	publicPayloadOffset += 4 + len(m.OutDate)
+
+	// Field 5 (RoomType): nested message
+	if m.RoomType != nil {
+		binary.LittleEndian.PutUint32(buf[publicTableStart+16:], uint32(publicPayloadStart+publicPayloadOffset))
+		nestedData, err := m.RoomType.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(nestedSize))
+		copy(buf[publicPayloadStart+publicPayloadOffset+4:], nestedData)
+		publicPayloadOffset += 4 + nestedSize
+	} else {
+		binary.LittleEndian.PutUint32(buf[publicTableStart+16:], 0)
+	}
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *RatePlan) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (HotelId): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (Code): variable-length
+	if len(data) >= publicTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Code = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 3 (InDate): variable-length
+	if len(data) >= publicTableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+8:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 4 (OutDate): variable-length
+	if len(data) >= publicTableStart+12+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+12:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 5 (RoomType): nested message
+	if len(data) >= publicTableStart+16+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+16:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.RoomType = &RoomType{}
+				if err := m.RoomType.UnmarshalSymphony(data[payloadOffset+4 : payloadOffset+4+dataLen]); err != nil {
+					return fmt.Errorf("failed to unmarshal nested message: %w", err)
+				}
+			}
+		}
+	}
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type RatePlanRaw []byte
+
+func (m RatePlanRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *RatePlanRaw) UnmarshalSymphony(data []byte) error {
+	*m = RatePlanRaw(data)
+	return nil
+}
+
+func (m RatePlanRaw) GetHotelId() string {
+	// Field 1 (HotelId): variable-length
+	if len(m) < 13+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m RatePlanRaw) GetCode() string {
+	// Field 2 (Code): variable-length
+	if len(m) < 17+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[17:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m RatePlanRaw) GetInDate() string {
+	// Field 3 (InDate): variable-length
+	if len(m) < 21+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[21:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m RatePlanRaw) GetOutDate() string {
+	// Field 4 (OutDate): variable-length
+	if len(m) < 25+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[25:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m RatePlanRaw) GetRoomType() RoomTypeRaw {
+	// Field 5 (RoomType): nested message
+	if len(m) < 29+4 {
+		return nil
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[29:]))
+	if payloadOffset == 0 {
+		return nil
+	}
+	if len(m) < payloadOffset+4 {
+		return nil
+	}
+	nestedSize := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+nestedSize {
+		return nil
+	}
+	return RoomTypeRaw(m[payloadOffset+4 : payloadOffset+4+nestedSize])
+}
+
+func (m *RatePlanRaw) SetHotelId(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (HotelId): variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp RatePlan
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = RatePlanRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *RatePlanRaw) SetCode(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Code called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (Code): variable-length
+	if len(*m) < 17+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[17:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp RatePlan
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Code = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = RatePlanRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *RatePlanRaw) SetInDate(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter InDate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 3 (InDate): variable-length
+	if len(*m) < 21+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[21:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp RatePlan
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.InDate = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = RatePlanRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *RatePlanRaw) SetOutDate(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter OutDate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 4 (OutDate): variable-length
+	if len(*m) < 25+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[25:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp RatePlan
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.OutDate = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = RatePlanRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *RatePlanRaw) SetRoomType(v RoomTypeRaw) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter RoomType called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 5 (RoomType): nested message
+	if len(*m) < 29+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[29:]))
+	var oldNestedSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldNestedSize = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newNestedSize := len(v)
+	if oldPayloadOffset > 0 && newNestedSize <= oldNestedSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newNestedSize))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	var temp RatePlan
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	if temp.RoomType == nil {
+		temp.RoomType = &RoomType{}
+	}
+	if err := temp.RoomType.UnmarshalSymphony([]byte(v)); err != nil {
+		return fmt.Errorf("failed to unmarshal nested message: %w", err)
+	}
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = RatePlanRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *SetRatePlansRequest) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 8 // table
+	size += 4 + len(m.HotelId)
+	size += 4 // count for RatePlans
+	for _, item := range m.RatePlans {
+		nested, _ := item.MarshalSymphony()
+		size += 4 + len(nested)
+	}
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 8
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.HotelId)
+	payloadOffset += 4 + len(m.HotelId)
+
+	// Field 2 (RatePlans): repeated nested message
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
+	count = len(m.RatePlans)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	payloadOffset += 4
+	currentOffset = payloadStart + payloadOffset
+	for _, item := range m.RatePlans {
+		nestedData, err := item.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
+		copy(buf[currentOffset+4:], nestedData)
+		currentOffset += 4 + nestedSize
+		payloadOffset += 4 + nestedSize
+	}
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *SetRatePlansRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *SetRatePlansRequest) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (HotelId): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (RatePlans): repeated nested message
+	if len(data) >= tableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.RatePlans = make([]*RatePlan, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						item := &RatePlan{}
+						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
+							return fmt.Errorf("failed to unmarshal nested message: %w", err)
+						}
+						m.RatePlans = append(m.RatePlans, item)
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *SetRatePlansRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *SetRatePlansRequest) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 8  // table entries
+	// Field 1 (HotelId): variable-length payload
+	size += 4 + len(m.HotelId) // 4 bytes length prefix + data
+	// Field 2 (RatePlans): repeated nested message payload
+	size += 4 // count
+	for _, item := range m.RatePlans {
+		nestedSize1 := 0
+		// Public segment:
+		nestedSize1 += 1  // version byte
+		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
+		nestedSize1 += 20 // table entries
+		// Field 1 (HotelId): variable-length payload
+		nestedSize1 += 4 + len(item.HotelId) // 4 bytes length prefix + data
+		// Field 2 (Code): variable-length payload
+		nestedSize1 += 4 + len(item.Code) // 4 bytes length prefix + data
+		// Field 3 (InDate): variable-length payload
+		nestedSize1 += 4 + len(item.InDate) // 4 bytes length prefix + data
+		// Field 4 (OutDate): variable-length payload
+		nestedSize1 += 4 + len(item.OutDate) // 4 bytes length prefix + data
+		// Field 5 (RoomType): nested message payload
+		if item.RoomType != nil {
+			nestedSize2 := 0
+			// Public segment:
+			nestedSize2 += 1  // version byte
+			nestedSize2 += 12 // reserved: offset_to_private, service_name, method_name
+			nestedSize2 += 36 // table entries
+			// Field 4 (Code): variable-length payload
+			nestedSize2 += 4 + len(item.RoomType.Code) // 4 bytes length prefix + data
+			// Field 5 (Currency): variable-length payload
+			nestedSize2 += 4 + len(item.RoomType.Currency) // 4 bytes length prefix + data
+			// Field 6 (RoomDescription): variable-length payload
+			nestedSize2 += 4 + len(item.RoomType.RoomDescription) // 4 bytes length prefix + data
+			// Private segment:
+			nestedSize2 += 1 // version byte
+
+			nestedSize1 += 4 + nestedSize2 // 4 bytes size + message data
+		}
+		// Private segment:
+		nestedSize1 += 1 // version byte
+
+		size += 4 + nestedSize1 // 4 bytes size + message data
+	}
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4 + len(m.HotelId) // field 1 payload
+	publicSegmentSize += 4                  // field 2 count
+	for _, item := range m.RatePlans {
+		nestedData, _ := item.MarshalSymphony()
+		publicSegmentSize += 4 + len(nestedData)
+	}
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 8
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.HotelId)
+	publicPayloadOffset += 4 + len(m.HotelId)
+
+	// Field 2 (RatePlans): repeated nested message
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.RatePlans)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	publicPayloadOffset += 4
+	currentOffset = publicPayloadStart + publicPayloadOffset
+	for _, item := range m.RatePlans {
+		nestedData, err := item.MarshalSymphony()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
+		}
+		nestedSize := len(nestedData)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
+		copy(buf[currentOffset+4:], nestedData)
+		currentOffset += 4 + nestedSize
+		publicPayloadOffset += 4 + nestedSize
+	}
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *SetRatePlansRequest) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (HotelId): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (RatePlans): repeated nested message
+	if len(data) >= publicTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.RatePlans = make([]*RatePlan, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						item := &RatePlan{}
+						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
+							return fmt.Errorf("failed to unmarshal nested message: %w", err)
+						}
+						m.RatePlans = append(m.RatePlans, item)
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type SetRatePlansRequestRaw []byte
+
+func (m SetRatePlansRequestRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *SetRatePlansRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = SetRatePlansRequestRaw(data)
+	return nil
+}
+
+func (m SetRatePlansRequestRaw) GetHotelId() string {
+	// Field 1 (HotelId): variable-length
+	if len(m) < 13+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m SetRatePlansRequestRaw) GetRatePlans() []RatePlanRaw {
+	// Field 2 (RatePlans): repeated nested message
+	if len(m) < 17+4 {
+		return nil
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[17:]))
+	if payloadOffset == 0 {
+		return nil
+	}
+	if len(m) < payloadOffset+4 {
+		return nil
+	}
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]RatePlanRaw, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
+		}
+		nestedSize := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+nestedSize {
+			return nil
+		}
+		result[i] = RatePlanRaw(m[currentOffset+4 : currentOffset+4+nestedSize])
+		currentOffset += 4 + nestedSize
+	}
+	return result
+}
+
+func (m *SetRatePlansRequestRaw) SetHotelId(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (HotelId): variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp SetRatePlansRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SetRatePlansRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *SetRatePlansRequestRaw) SetRatePlans(v []RatePlanRaw) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter RatePlans called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (RatePlans): repeated nested message
+	if len(*m) < 17+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[17:]))
+	var oldCount int
+	var oldDataSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes size + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemSize := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemSize
+			currentOffset += 4 + itemSize
+		}
+	}
+	newCount := len(v)
+	newDataSize := 4 // count
+	for _, item := range v {
+		newDataSize += 4 + len(item) // 4 bytes size + data
+	}
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		currentOffset := oldPayloadOffset + 4
+		for _, item := range v {
+			itemSize := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemSize))
+			copy((*m)[currentOffset+4:], item)
+			currentOffset += 4 + itemSize
+		}
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	var temp SetRatePlansRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.RatePlans = make([]*RatePlan, len(v))
+	for i, rawItem := range v {
+		temp.RatePlans[i] = &RatePlan{}
+		if err := temp.RatePlans[i].UnmarshalSymphony([]byte(rawItem)); err != nil {
+			return fmt.Errorf("failed to unmarshal nested message: %w", err)
+		}
+	}
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SetRatePlansRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *SetRatePlansResult) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 4 // table
+	size += 4 + len(m.HotelId)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 4
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.HotelId)
+	payloadOffset += 4 + len(m.HotelId)
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *SetRatePlansResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *SetRatePlansResult) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (HotelId): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *SetRatePlansResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *SetRatePlansResult) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 4  // table entries
+	// Field 1 (HotelId): variable-length payload
+	size += 4 + len(m.HotelId) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4 + len(m.HotelId) // field 1 payload
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 4
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.HotelId)
+	publicPayloadOffset += 4 + len(m.HotelId)
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *SetRatePlansResult) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (HotelId): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type SetRatePlansResultRaw []byte
+
+func (m SetRatePlansResultRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *SetRatePlansResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = SetRatePlansResultRaw(data)
+	return nil
+}
+
+func (m SetRatePlansResultRaw) GetHotelId() string {
+	// Field 1 (HotelId): variable-length
+	if len(m) < 13+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m *SetRatePlansResultRaw) SetHotelId(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (HotelId): variable-length
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp SetRatePlansResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SetRatePlansResultRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *RoomType) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 36 // table
+	size += 4 + len(m.Code)
+	size += 4 + len(m.Currency)
+	size += 4 + len(m.RoomDescription)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 36
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (BookableRate): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+0:], math.Float64bits(m.BookableRate))
+
+	// Field 2 (TotalRate): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+8:], math.Float64bits(m.TotalRate))
+
+	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+16:], math.Float64bits(m.TotalRateInclusive))
+
+	// Field 4 (Code): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+24:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Code)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Code)
+	payloadOffset += 4 + len(m.Code)
+
+	// Field 5 (Currency): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+28:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Currency)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Currency)
+	payloadOffset += 4 + len(m.Currency)
+
+	// Field 6 (RoomDescription): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+32:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.RoomDescription)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.RoomDescription)
+	payloadOffset += 4 + len(m.RoomDescription)
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *RoomType) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *RoomType) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (BookableRate): fixed-length (8 bytes)
+	if len(data) < tableStart+8 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.BookableRate = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+0:]))
+
+	// Field 2 (TotalRate): fixed-length (8 bytes)
+	if len(data) < tableStart+16 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.TotalRate = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+8:]))
+
+	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
+	if len(data) < tableStart+24 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.TotalRateInclusive = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+16:]))
+
+	// Field 4 (Code): variable-length
+	if len(data) >= tableStart+24+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+24:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Code = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 5 (Currency): variable-length
+	if len(data) >= tableStart+28+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+28:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Currency = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 6 (RoomDescription): variable-length
+	if len(data) >= tableStart+32+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+32:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.RoomDescription = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *RoomType) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *RoomType) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 36 // table entries
+	// Field 4 (Code): variable-length payload
+	size += 4 + len(m.Code) // 4 bytes length prefix + data
+	// Field 5 (Currency): variable-length payload
+	size += 4 + len(m.Currency) // 4 bytes length prefix + data
+	// Field 6 (RoomDescription): variable-length payload
+	size += 4 + len(m.RoomDescription) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 8                          // field BookableRate
+	publicSegmentSize += 8                          // field TotalRate
+	publicSegmentSize += 8                          // field TotalRateInclusive
+	publicSegmentSize += 4                          // offset placeholder
+	publicSegmentSize += 4                          // offset placeholder
+	publicSegmentSize += 4                          // offset placeholder
+	publicSegmentSize += 4 + len(m.Code)            // field 4 payload
+	publicSegmentSize += 4 + len(m.Currency)        // field 5 payload
+	publicSegmentSize += 4 + len(m.RoomDescription) // field 6 payload
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 36
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (BookableRate): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+0:], math.Float64bits(m.BookableRate))
+
+	// Field 2 (TotalRate): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+8:], math.Float64bits(m.TotalRate))
+
+	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+16:], math.Float64bits(m.TotalRateInclusive))
+
+	// Field 4 (Code): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+24:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Code)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Code)
+	publicPayloadOffset += 4 + len(m.Code)
+
+	// Field 5 (Currency): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+28:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Currency)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Currency)
+	publicPayloadOffset += 4 + len(m.Currency)
+
+	// Field 6 (RoomDescription): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+32:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.RoomDescription)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.RoomDescription)
+	publicPayloadOffset += 4 + len(m.RoomDescription)
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *RoomType) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (BookableRate): fixed-length (8 bytes)
+	if len(data) < publicTableStart+8 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.BookableRate = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+0:]))
+
+	// Field 2 (TotalRate): fixed-length (8 bytes)
+	if len(data) < publicTableStart+16 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.TotalRate = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+8:]))
+
+	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
+	if len(data) < publicTableStart+24 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.TotalRateInclusive = math.Float64frombits(binary.LittleEndian.Uint64(data[publicTableStart+16:]))
+
+	// Field 4 (Code): variable-length
+	if len(data) >= publicTableStart+24+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+24:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Code = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 5 (Currency): variable-length
+	if len(data) >= publicTableStart+28+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+28:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Currency = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 6 (RoomDescription): variable-length
+	if len(data) >= publicTableStart+32+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+32:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.RoomDescription = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type RoomTypeRaw []byte
+
+func (m RoomTypeRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *RoomTypeRaw) UnmarshalSymphony(data []byte) error {
+	*m = RoomTypeRaw(data)
+	return nil
+}
+
+func (m RoomTypeRaw) GetBookableRate() float64 {
+	// Field 1 (BookableRate): fixed-length (8 bytes)
+	if len(m) < 13+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[13:]))
+}
+
+func (m RoomTypeRaw) GetTotalRate() float64 {
+	// Field 2 (TotalRate): fixed-length (8 bytes)
+	if len(m) < 21+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[21:]))
+}
+
+func (m RoomTypeRaw) GetTotalRateInclusive() float64 {
+	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
+	if len(m) < 29+8 {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(m[29:]))
+}
+
+func (m RoomTypeRaw) GetCode() string {
+	// Field 4 (Code): variable-length
+	if len(m) < 37+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[37:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m RoomTypeRaw) GetCurrency() string {
+	// Field 5 (Currency): variable-length
+	if len(m) < 41+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[41:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m RoomTypeRaw) GetRoomDescription() string {
+	// Field 6 (RoomDescription): variable-length
+	if len(m) < 45+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[45:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m *RoomTypeRaw) SetBookableRate(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter BookableRate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (BookableRate): fixed-length (8 bytes)
+	if len(*m) < 13+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[13:], math.Float64bits(v))
+	return nil
+}
+
+func (m *RoomTypeRaw) SetTotalRate(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter TotalRate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (TotalRate): fixed-length (8 bytes)
+	if len(*m) < 21+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[21:], math.Float64bits(v))
+	return nil
+}
+
+func (m *RoomTypeRaw) SetTotalRateInclusive(v float64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter TotalRateInclusive called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
+	if len(*m) < 29+8 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint64((*m)[29:], math.Float64bits(v))
+	return nil
+}
+
+func (m *RoomTypeRaw) SetCode(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Code called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 4 (Code): variable-length
+	if len(*m) < 37+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[37:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp RoomType
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Code = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = RoomTypeRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *RoomTypeRaw) SetCurrency(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Currency called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 5 (Currency): variable-length
+	if len(*m) < 41+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[41:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp RoomType
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Currency = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = RoomTypeRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *RoomTypeRaw) SetRoomDescription(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter RoomDescription called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 6 (RoomDescription): variable-length
+	if len(*m) < 45+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[45:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp RoomType
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.RoomDescription = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = RoomTypeRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *ReservationRequest) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 16 // table
+	size += 4  // count for HotelId
+	for _, item := range m.HotelId {
+		size += 4 + len(item)
+	}
+	size += 4 + len(m.InDate)
+	size += 4 + len(m.OutDate)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 16
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 2 (HotelId): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	count = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	currentOffset = payloadStart + payloadOffset + 4
+	for _, item := range m.HotelId {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	payloadOffset += 4 // count
+	for _, item := range m.HotelId {
+		payloadOffset += 4 + len(item)
+	}
+
+	// Field 3 (InDate): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.InDate)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.InDate)
+	payloadOffset += 4 + len(m.InDate)
+
+	// Field 4 (OutDate): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.OutDate)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.OutDate)
+	payloadOffset += 4 + len(m.OutDate)
+
+	// Field 5 (RoomNumber): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+12:], uint32(m.RoomNumber))
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *ReservationRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	size := 0
+	size += 4 // table
+	size += 4 + len(m.CustomerName)
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 4
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (CustomerName): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.CustomerName)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.CustomerName)
+	payloadOffset += 4 + len(m.CustomerName)
+
+	return buf, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *ReservationRequest) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 2 (HotelId): repeated variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.HotelId = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.HotelId = append(m.HotelId, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	// Field 3 (InDate): variable-length
+	if len(data) >= tableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 4 (OutDate): variable-length
+	if len(data) >= tableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 5 (RoomNumber): fixed-length (4 bytes)
+	if len(data) < tableStart+16 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.RoomNumber = int32(binary.LittleEndian.Uint32(data[tableStart+12:]))
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *ReservationRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (CustomerName): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.CustomerName = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *ReservationRequest) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 16 // table entries
+	// Field 2 (HotelId): repeated variable-length payload
+	size += 4 // count
+	for _, item := range m.HotelId {
+		size += 4 + len(item) // 4 bytes length prefix + data
+	}
+	// Field 3 (InDate): variable-length payload
+	size += 4 + len(m.InDate) // 4 bytes length prefix + data
+	// Field 4 (OutDate): variable-length payload
+	size += 4 + len(m.OutDate) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
+	size += 4 // table entries
+	// Field 1 (CustomerName): variable-length payload
+	size += 4 + len(m.CustomerName) // 4 bytes length prefix + data
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // field RoomNumber
+	publicSegmentSize += 4 // field 2 count
+	for _, item := range m.HotelId {
+		publicSegmentSize += 4 + len(item)
+	}
+	publicSegmentSize += 4 + len(m.InDate)  // field 3 payload
+	publicSegmentSize += 4 + len(m.OutDate) // field 4 payload
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 16
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 2 (HotelId): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	currentOffset = publicPayloadStart + publicPayloadOffset + 4
+	for _, item := range m.HotelId {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	publicPayloadOffset += 4 // count
+	for _, item := range m.HotelId {
+		publicPayloadOffset += 4 + len(item)
+	}
+
+	// Field 3 (InDate): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.InDate)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.InDate)
+	publicPayloadOffset += 4 + len(m.InDate)
+
+	// Field 4 (OutDate): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+8:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.OutDate)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.OutDate)
+	publicPayloadOffset += 4 + len(m.OutDate)
+
+	// Field 5 (RoomNumber): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+12:], uint32(m.RoomNumber))
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 4 bytes table
+	privatePayloadStart := privateTableStart + 4
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	// Field 1 (CustomerName): variable-length
+	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
+	dataLen = len(m.CustomerName)
+	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
+	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.CustomerName)
+	privatePayloadOffset += 4 + len(m.CustomerName)
+
+	return buf, nil
+}
+
+func (m *ReservationRequest) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 2 (HotelId): repeated variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.HotelId = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.HotelId = append(m.HotelId, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
+	// Field 3 (InDate): variable-length
+	if len(data) >= publicTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 4 (OutDate): variable-length
+	if len(data) >= publicTableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+8:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 5 (RoomNumber): fixed-length (4 bytes)
+	if len(data) < publicTableStart+16 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.RoomNumber = int32(binary.LittleEndian.Uint32(data[publicTableStart+12:]))
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	// Field 1 (CustomerName): variable-length
+	if len(data) >= privateTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
+		if payloadOffset > 0 {
+			payloadOffset += offsetToPrivate // convert relative offset to absolute
+		}
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.CustomerName = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	return nil
+}
+
+type ReservationRequestRaw []byte
+
+func (m ReservationRequestRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *ReservationRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = ReservationRequestRaw(data)
+	return nil
+}
+
+func (m ReservationRequestRaw) GetCustomerName() string {
+	// ASSERT: Private field requires complete buffer
+	if len(m) < 5 {
+		panic(fmt.Sprintf("private getter CustomerName called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
+	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
+		marker := byte(0)
+		if offsetToPrivate < len(m) {
+			marker = m[offsetToPrivate]
+		}
+		panic(fmt.Sprintf("private getter CustomerName called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+	}
+	// Field 1 (CustomerName): variable-length
+	if len(m) < offsetToPrivate+1+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	payloadOffset += offsetToPrivate // convert relative offset to absolute
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m ReservationRequestRaw) GetHotelId() []string {
+	// Field 2 (HotelId): repeated variable-length
+	if len(m) < 13+4 {
+		return nil
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
+		return nil
+	}
+	if len(m) < payloadOffset+4 {
+		return nil
+	}
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]string, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
+		}
+		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+itemLen {
+			return nil
+		}
+		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
+		currentOffset += 4 + itemLen
+	}
+	return result
+}
+
+func (m ReservationRequestRaw) GetInDate() string {
+	// Field 3 (InDate): variable-length
+	if len(m) < 17+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[17:]))
+	if payloadOffset == 0 {
+		return ""
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
 		return ""
 	}
@@ -3221,28 +11306,15 @@ func (m AddressRaw) GetCountry() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m AddressRaw) GetPostalCode() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter PostalCode called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter PostalCode called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 6 (PostalCode): variable-length
-	if len(m) < offsetToPrivate+21+4 {
+func (m ReservationRequestRaw) GetOutDate() string {
+	// Field 4 (OutDate): variable-length
+	if len(m) < 21+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+21:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[21:]))
 	if payloadOffset == 0 {
 		return ""
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
 		return ""
 	}
@@ -3253,50 +11325,18 @@ func (m AddressRaw) GetPostalCode() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m AddressRaw) GetLat() float32 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Lat called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Lat called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 7 (Lat): fixed-length (4 bytes)
-	if len(m) < offsetToPrivate+25+4 {
-		return 0
-	}
-	return math.Float32frombits(binary.LittleEndian.Uint32(m[offsetToPrivate+25:]))
-}
-
-func (m AddressRaw) GetLon() float32 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Lon called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Lon called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 8 (Lon): fixed-length (4 bytes)
-	if len(m) < offsetToPrivate+29+4 {
+func (m ReservationRequestRaw) GetRoomNumber() int32 {
+	// Field 5 (RoomNumber): fixed-length (4 bytes)
+	if len(m) < 25+4 {
 		return 0
 	}
-	return math.Float32frombits(binary.LittleEndian.Uint32(m[offsetToPrivate+29:]))
+	return int32(binary.LittleEndian.Uint32(m[25:]))
 }
 
-func (m *AddressRaw) SetStreetNumber(v string) error {
+func (m *ReservationRequestRaw) SetCustomerName(v string) error {
 	// ASSERT: Private field setter requires complete buffer
 	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter StreetNumber called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+		panic(fmt.Sprintf("private setter CustomerName called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
 	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
@@ -3304,9 +11344,9 @@ func (m *AddressRaw) SetStreetNumber(v string) error {
 		if offsetToPrivate < len(*m) {
 			marker = (*m)[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private setter StreetNumber called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+		panic(fmt.Sprintf("private setter CustomerName called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 1 (StreetNumber): variable-length
+	// Field 1 (CustomerName): variable-length
 	if len(*m) < offsetToPrivate+1+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
@@ -3326,40 +11366,112 @@ func (m *AddressRaw) SetStreetNumber(v string) error {
 		return nil
 	}
 	// Need to remarshal: unmarshal, update, marshal
-	var temp Address
+	var temp ReservationRequest
 	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.StreetNumber = v
+	temp.CustomerName = v
 	newData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = AddressRaw(newData)
+	*m = ReservationRequestRaw(newData)
 	return nil
 }
 
-func (m *AddressRaw) SetStreetName(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter StreetName called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *ReservationRequestRaw) SetHotelId(v []string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter StreetName called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 2 (StreetName): variable-length
-	if len(*m) < offsetToPrivate+5+4 {
+	// Field 2 (HotelId): repeated variable-length
+	if len(*m) < 13+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+5:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldCount int
+	var oldDataSize int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemLen
+			currentOffset += 4 + itemLen
+		}
+	}
+	newCount := len(v)
+	newDataSize := 4 // count
+	for _, item := range v {
+		newDataSize += 4 + len(item) // 4 bytes length + data
+	}
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		currentOffset := oldPayloadOffset + 4
+		for _, item := range v {
+			itemLen := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
+			copy((*m)[currentOffset+4:], item)
+			currentOffset += 4 + itemLen
+		}
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp ReservationRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 4                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = ReservationRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *ReservationRequestRaw) SetInDate(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter InDate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 3 (InDate): variable-length
+	if len(*m) < 17+4 {
+		return fmt.Errorf("buffer too short for table entry")
 	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[17:]))
 	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
 		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
@@ -3371,41 +11483,53 @@ func (m *AddressRaw) SetStreetName(v string) error {
 		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Address
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp ReservationRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 4                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.StreetName = v
-	newData, err := temp.MarshalSymphony()
+	temp.InDate = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = AddressRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = ReservationRequestRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
-func (m *AddressRaw) SetCity(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter City called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *ReservationRequestRaw) SetOutDate(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter OutDate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter City called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 3 (City): variable-length
-	if len(*m) < offsetToPrivate+9+4 {
+	// Field 4 (OutDate): variable-length
+	if len(*m) < 21+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+9:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[21:]))
 	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
 		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
@@ -3417,210 +11541,401 @@ func (m *AddressRaw) SetCity(v string) error {
 		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Address
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp ReservationRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 4                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.City = v
-	newData, err := temp.MarshalSymphony()
+	temp.OutDate = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = AddressRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = ReservationRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *ReservationRequestRaw) SetRoomNumber(v int32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter RoomNumber called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 5 (RoomNumber): fixed-length (4 bytes)
+	if len(*m) < 25+4 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint32((*m)[25:], uint32(v))
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *ReservationResult) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 4 // table
+	size += 4 // count for HotelId
+	for _, item := range m.HotelId {
+		size += 4 + len(item)
+	}
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 4
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (HotelId): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	count = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	currentOffset = payloadStart + payloadOffset + 4
+	for _, item := range m.HotelId {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	payloadOffset += 4 // count
+	for _, item := range m.HotelId {
+		payloadOffset += 4 + len(item)
+	}
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *ReservationResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *ReservationResult) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (HotelId): repeated variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.HotelId = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.HotelId = append(m.HotelId, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
-func (m *AddressRaw) SetState(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter State called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *ReservationResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *ReservationResult) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 4  // table entries
+	// Field 1 (HotelId): repeated variable-length payload
+	size += 4 // count
+	for _, item := range m.HotelId {
+		size += 4 + len(item) // 4 bytes length prefix + data
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter State called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // field 1 count
+	for _, item := range m.HotelId {
+		publicSegmentSize += 4 + len(item)
 	}
-	// Field 4 (State): variable-length
-	if len(*m) < offsetToPrivate+13+4 {
-		return fmt.Errorf("buffer too short for table entry")
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 4
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (HotelId): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	currentOffset = publicPayloadStart + publicPayloadOffset + 4
+	for _, item := range m.HotelId {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+13:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
+	publicPayloadOffset += 4 // count
+	for _, item := range m.HotelId {
+		publicPayloadOffset += 4 + len(item)
 	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *ReservationResult) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
 	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Address
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
 	}
-	temp.State = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (HotelId): repeated variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.HotelId = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.HotelId = append(m.HotelId, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
+			}
+		}
 	}
-	*m = AddressRaw(newData)
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
-func (m *AddressRaw) SetCountry(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Country called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter Country called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 5 (Country): variable-length
-	if len(*m) < offsetToPrivate+17+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+17:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+type ReservationResultRaw []byte
+
+func (m ReservationResultRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *ReservationResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = ReservationResultRaw(data)
+	return nil
+}
+
+func (m ReservationResultRaw) GetHotelId() []string {
+	// Field 1 (HotelId): repeated variable-length
+	if len(m) < 13+4 {
+		return nil
 	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
+	if payloadOffset == 0 {
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Address
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
+	if len(m) < payloadOffset+4 {
+		return nil
 	}
-	temp.Country = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]string, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
+		}
+		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+itemLen {
+			return nil
+		}
+		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
+		currentOffset += 4 + itemLen
 	}
-	*m = AddressRaw(newData)
-	return nil
+	return result
 }
 
-func (m *AddressRaw) SetPostalCode(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter PostalCode called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *ReservationResultRaw) SetHotelId(v []string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter PostalCode called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 6 (PostalCode): variable-length
-	if len(*m) < offsetToPrivate+21+4 {
+	// Field 1 (HotelId): repeated variable-length
+	if len(*m) < 13+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+21:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
+	var oldCount int
+	var oldDataSize int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
-	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Address
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
-	}
-	temp.PostalCode = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemLen
+			currentOffset += 4 + itemLen
+		}
 	}
-	*m = AddressRaw(newData)
-	return nil
-}
-
-func (m *AddressRaw) SetLat(v float32) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Lat called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+	newCount := len(v)
+	newDataSize := 4 // count
+	for _, item := range v {
+		newDataSize += 4 + len(item) // 4 bytes length + data
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		currentOffset := oldPayloadOffset + 4
+		for _, item := range v {
+			itemLen := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
+			copy((*m)[currentOffset+4:], item)
+			currentOffset += 4 + itemLen
 		}
-		panic(fmt.Sprintf("private setter Lat called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+		return nil
 	}
-	// Field 7 (Lat): fixed-length (4 bytes)
-	if len(*m) < offsetToPrivate+25+4 {
-		return fmt.Errorf("buffer too short")
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
 	}
-	binary.LittleEndian.PutUint32((*m)[offsetToPrivate+25:], math.Float32bits(v))
-	return nil
-}
-
-func (m *AddressRaw) SetLon(v float32) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Lon called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+	var temp ReservationResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter Lon called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	// Field 8 (Lon): fixed-length (4 bytes)
-	if len(*m) < offsetToPrivate+29+4 {
-		return fmt.Errorf("buffer too short")
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
 	}
-	binary.LittleEndian.PutUint32((*m)[offsetToPrivate+29:], math.Float32bits(v))
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = ReservationResultRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *Image) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *Image) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *SetCapacityRequest) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
-	size += 5 // table
-	size += 4 + len(m.Url)
+	size += 8 // table
+	size += 4 + len(m.HotelId)
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -3629,35 +11944,31 @@ func (m *Image) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 5
+	payloadStart := tableStart + 8
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (Url): variable-length
+	// Field 1 (HotelId): variable-length
 	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.Url)
+	dataLen = len(m.HotelId)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.Url)
-	payloadOffset += 4 + len(m.Url)
+	copy(buf[payloadStart+payloadOffset+4:], m.HotelId)
+	payloadOffset += 4 + len(m.HotelId)
 
-	// Field 2 (Default): fixed-length (1 bytes)
-	if m.Default {
-		buf[tableStart+4] = 1
-	} else {
-		buf[tableStart+4] = 0
-	}
+	// Field 2 (NumberOfRoom): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(m.NumberOfRoom))
 
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *Image) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *SetCapacityRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *Image) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *SetCapacityRequest) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -3669,36 +11980,41 @@ func (m *Image) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (Url): variable-length
+	// Field 1 (HotelId): variable-length
 	if len(data) >= tableStart+0+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.Url = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 2 (Default): fixed-length (1 bytes)
-	if len(data) < tableStart+5 {
+	// Field 2 (NumberOfRoom): fixed-length (4 bytes)
+	if len(data) < tableStart+8 {
 		return fmt.Errorf("invalid data: too short for field")
 	}
-	m.Default = data[tableStart+4] != 0
+	m.NumberOfRoom = int32(binary.LittleEndian.Uint32(data[tableStart+4:]))
 
 	return nil
 }
 
-func (m *Image) MarshalSymphony() ([]byte, error) {
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *SetCapacityRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *SetCapacityRequest) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 8  // table entries
+	// Field 1 (HotelId): variable-length payload
+	size += 4 + len(m.HotelId) // 4 bytes length prefix + data
 	// Private segment:
 	size += 1 // version byte
-	size += 5 // table entries
-	// Field 1 (Url): variable-length payload
-	size += 4 + len(m.Url) // 4 bytes length prefix + data
 
 	buf := make([]byte, size)
 
@@ -3714,6 +12030,9 @@ func (m *Image) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4                  // field NumberOfRoom
+	publicSegmentSize += 4 + len(m.HotelId) // field 1 payload
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -3722,41 +12041,37 @@ func (m *Image) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 8
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.HotelId)
+	publicPayloadOffset += 4 + len(m.HotelId)
+
+	// Field 2 (NumberOfRoom): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], uint32(m.NumberOfRoom))
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 5 bytes table
-	privatePayloadStart := privateTableStart + 5
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (Url): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Url)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Url)
-	privatePayloadOffset += 4 + len(m.Url)
-
-	// Field 2 (Default): fixed-length (1 bytes)
-	if m.Default {
-		buf[privateTableStart+4] = 1
-	} else {
-		buf[privateTableStart+4] = 0
-	}
-
 	return buf, nil
 }
 
-func (m *Image) UnmarshalSymphony(data []byte) error {
+func (m *SetCapacityRequest) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -3788,66 +12103,50 @@ func (m *Image) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (Url): variable-length
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	// Field 1 (HotelId): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.Url = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 2 (Default): fixed-length (1 bytes)
-	if len(data) < privateTableStart+5 {
+	// Field 2 (NumberOfRoom): fixed-length (4 bytes)
+	if len(data) < publicTableStart+8 {
 		return fmt.Errorf("invalid data: too short for field")
 	}
-	m.Default = data[privateTableStart+4] != 0
+	m.NumberOfRoom = int32(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
 
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
-type ImageRaw []byte
+type SetCapacityRequestRaw []byte
 
-func (m ImageRaw) MarshalSymphony() ([]byte, error) {
+func (m SetCapacityRequestRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *ImageRaw) UnmarshalSymphony(data []byte) error {
-	*m = ImageRaw(data)
+func (m *SetCapacityRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = SetCapacityRequestRaw(data)
 	return nil
 }
 
-func (m ImageRaw) GetUrl() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Url called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Url called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (Url): variable-length
-	if len(m) < offsetToPrivate+1+4 {
+func (m SetCapacityRequestRaw) GetHotelId() string {
+	// Field 1 (HotelId): variable-length
+	if len(m) < 13+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
 	if payloadOffset == 0 {
 		return ""
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
 		return ""
 	}
@@ -3858,47 +12157,27 @@ func (m ImageRaw) GetUrl() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m ImageRaw) GetDefault() bool {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Default called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Default called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 2 (Default): fixed-length (1 bytes)
-	if len(m) < offsetToPrivate+5+1 {
-		return false
+func (m SetCapacityRequestRaw) GetNumberOfRoom() int32 {
+	// Field 2 (NumberOfRoom): fixed-length (4 bytes)
+	if len(m) < 17+4 {
+		return 0
 	}
-	return m[offsetToPrivate+5] != 0
+	return int32(binary.LittleEndian.Uint32(m[17:]))
 }
 
-func (m *ImageRaw) SetUrl(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Url called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *SetCapacityRequestRaw) SetHotelId(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Url called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 1 (Url): variable-length
-	if len(*m) < offsetToPrivate+1+4 {
+	// Field 1 (HotelId): variable-length
+	if len(*m) < 13+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
 	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
 		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
@@ -3910,55 +12189,61 @@ func (m *ImageRaw) SetUrl(v string) error {
 		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp Image
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp SetCapacityRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.Url = v
-	newData, err := temp.MarshalSymphony()
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = ImageRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SetCapacityRequestRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
-func (m *ImageRaw) SetDefault(v bool) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Default called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *SetCapacityRequestRaw) SetNumberOfRoom(v int32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter NumberOfRoom called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Default called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 2 (Default): fixed-length (1 bytes)
-	if len(*m) < offsetToPrivate+5+1 {
+	// Field 2 (NumberOfRoom): fixed-length (4 bytes)
+	if len(*m) < 17+4 {
 		return fmt.Errorf("buffer too short")
 	}
-	if v {
-		(*m)[offsetToPrivate+5] = 1
-	} else {
-		(*m)[offsetToPrivate+5] = 0
-	}
+	binary.LittleEndian.PutUint32((*m)[17:], uint32(v))
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *GetRecommendationsRequest) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *GetRecommendationsRequest) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *SetCapacityResult) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
-	size += 20 // table
-	size += 4 + len(m.Require)
+	size += 4 // table
+	size += 4 + len(m.HotelId)
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -3967,34 +12252,28 @@ func (m *GetRecommendationsRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 20
+	payloadStart := tableStart + 4
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (Require): variable-length
+	// Field 1 (HotelId): variable-length
 	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.Require)
+	dataLen = len(m.HotelId)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.Require)
-	payloadOffset += 4 + len(m.Require)
-
-	// Field 2 (Lat): fixed-length (8 bytes)
-	binary.LittleEndian.PutUint64(buf[tableStart+4:], math.Float64bits(m.Lat))
-
-	// Field 3 (Lon): fixed-length (8 bytes)
-	binary.LittleEndian.PutUint64(buf[tableStart+12:], math.Float64bits(m.Lon))
+	copy(buf[payloadStart+payloadOffset+4:], m.HotelId)
+	payloadOffset += 4 + len(m.HotelId)
 
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *GetRecommendationsRequest) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *SetCapacityResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *GetRecommendationsRequest) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *SetCapacityResult) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -4006,42 +12285,35 @@ func (m *GetRecommendationsRequest) UnmarshalSymphonyPrivate(data []byte) error
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (Require): variable-length
+	// Field 1 (HotelId): variable-length
 	if len(data) >= tableStart+0+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.Require = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 2 (Lat): fixed-length (8 bytes)
-	if len(data) < tableStart+12 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lat = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+4:]))
-
-	// Field 3 (Lon): fixed-length (8 bytes)
-	if len(data) < tableStart+20 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lon = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+12:]))
+	return nil
+}
 
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *SetCapacityResult) UnmarshalSymphonyPrivate(data []byte) error {
 	return nil
 }
 
-func (m *GetRecommendationsRequest) MarshalSymphony() ([]byte, error) {
+func (m *SetCapacityResult) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 4  // table entries
+	// Field 1 (HotelId): variable-length payload
+	size += 4 + len(m.HotelId) // 4 bytes length prefix + data
 	// Private segment:
-	size += 1  // version byte
-	size += 20 // table entries
-	// Field 1 (Require): variable-length payload
-	size += 4 + len(m.Require) // 4 bytes length prefix + data
+	size += 1 // version byte
 
 	buf := make([]byte, size)
 
@@ -4057,6 +12329,8 @@ func (m *GetRecommendationsRequest) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4 + len(m.HotelId) // field 1 payload
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -4065,40 +12339,34 @@ func (m *GetRecommendationsRequest) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 4
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (HotelId): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.HotelId)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.HotelId)
+	publicPayloadOffset += 4 + len(m.HotelId)
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 20 bytes table
-	privatePayloadStart := privateTableStart + 20
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (Require): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Require)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Require)
-	privatePayloadOffset += 4 + len(m.Require)
-
-	// Field 2 (Lat): fixed-length (8 bytes)
-	binary.LittleEndian.PutUint64(buf[privateTableStart+4:], math.Float64bits(m.Lat))
-
-	// Field 3 (Lon): fixed-length (8 bytes)
-	binary.LittleEndian.PutUint64(buf[privateTableStart+12:], math.Float64bits(m.Lon))
-
 	return buf, nil
 }
 
-func (m *GetRecommendationsRequest) UnmarshalSymphony(data []byte) error {
+func (m *SetCapacityResult) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -4130,72 +12398,44 @@ func (m *GetRecommendationsRequest) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (Require): variable-length
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	// Field 1 (HotelId): variable-length
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.Require = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 2 (Lat): fixed-length (8 bytes)
-	if len(data) < privateTableStart+12 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lat = math.Float64frombits(binary.LittleEndian.Uint64(data[privateTableStart+4:]))
-
-	// Field 3 (Lon): fixed-length (8 bytes)
-	if len(data) < privateTableStart+20 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lon = math.Float64frombits(binary.LittleEndian.Uint64(data[privateTableStart+12:]))
-
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
-type GetRecommendationsRequestRaw []byte
+type SetCapacityResultRaw []byte
 
-func (m GetRecommendationsRequestRaw) MarshalSymphony() ([]byte, error) {
+func (m SetCapacityResultRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *GetRecommendationsRequestRaw) UnmarshalSymphony(data []byte) error {
-	*m = GetRecommendationsRequestRaw(data)
+func (m *SetCapacityResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = SetCapacityResultRaw(data)
 	return nil
 }
 
-func (m GetRecommendationsRequestRaw) GetRequire() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Require called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Require called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (Require): variable-length
-	if len(m) < offsetToPrivate+1+4 {
+func (m SetCapacityResultRaw) GetHotelId() string {
+	// Field 1 (HotelId): variable-length
+	if len(m) < 13+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
 	if payloadOffset == 0 {
 		return ""
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
 		return ""
 	}
@@ -4206,67 +12446,19 @@ func (m GetRecommendationsRequestRaw) GetRequire() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m GetRecommendationsRequestRaw) GetLat() float64 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Lat called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Lat called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 2 (Lat): fixed-length (8 bytes)
-	if len(m) < offsetToPrivate+5+8 {
-		return 0
-	}
-	return math.Float64frombits(binary.LittleEndian.Uint64(m[offsetToPrivate+5:]))
-}
-
-func (m GetRecommendationsRequestRaw) GetLon() float64 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Lon called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Lon called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 3 (Lon): fixed-length (8 bytes)
-	if len(m) < offsetToPrivate+13+8 {
-		return 0
-	}
-	return math.Float64frombits(binary.LittleEndian.Uint64(m[offsetToPrivate+13:]))
-}
-
-func (m *GetRecommendationsRequestRaw) SetRequire(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Require called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *SetCapacityResultRaw) SetHotelId(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelId called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Require called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 1 (Require): variable-length
-	if len(*m) < offsetToPrivate+1+4 {
+	// Field 1 (HotelId): variable-length
+	if len(*m) < 13+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
 	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
 		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
@@ -4278,75 +12470,46 @@ func (m *GetRecommendationsRequestRaw) SetRequire(v string) error {
 		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp GetRecommendationsRequest
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp SetCapacityResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.Require = v
-	newData, err := temp.MarshalSymphony()
+	temp.HotelId = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = GetRecommendationsRequestRaw(newData)
-	return nil
-}
-
-func (m *GetRecommendationsRequestRaw) SetLat(v float64) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Lat called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter Lat called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 2 (Lat): fixed-length (8 bytes)
-	if len(*m) < offsetToPrivate+5+8 {
-		return fmt.Errorf("buffer too short")
-	}
-	binary.LittleEndian.PutUint64((*m)[offsetToPrivate+5:], math.Float64bits(v))
-	return nil
-}
-
-func (m *GetRecommendationsRequestRaw) SetLon(v float64) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Lon called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter Lon called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 3 (Lon): fixed-length (8 bytes)
-	if len(*m) < offsetToPrivate+13+8 {
-		return fmt.Errorf("buffer too short")
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
 	}
-	binary.LittleEndian.PutUint64((*m)[offsetToPrivate+13:], math.Float64bits(v))
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SetCapacityResultRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *GetRecommendationsResult) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *GetRecommendationsResult) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *SearchRequest) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
-	size += 4 // table
-	size += 4 // count for HotelIds
-	for _, item := range m.HotelIds {
-		size += 4 + len(item)
-	}
+	size += 16 // table
+	size += 4 + len(m.InDate)
+	size += 4 + len(m.OutDate)
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -4355,37 +12518,41 @@ func (m *GetRecommendationsResult) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 4
+	payloadStart := tableStart + 16
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (HotelIds): repeated variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	count = len(m.HotelIds)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
-	currentOffset = payloadStart + payloadOffset + 4
-	for _, item := range m.HotelIds {
-		itemLen := len(item)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
-		copy(buf[currentOffset+4:], item)
-		currentOffset += 4 + itemLen
-	}
-	payloadOffset += 4 // count
-	for _, item := range m.HotelIds {
-		payloadOffset += 4 + len(item)
-	}
+	// Field 1 (Lat): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], math.Float32bits(m.Lat))
+
+	// Field 2 (Lon): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], math.Float32bits(m.Lon))
+
+	// Field 3 (InDate): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.InDate)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.InDate)
+	payloadOffset += 4 + len(m.InDate)
+
+	// Field 4 (OutDate): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+12:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.OutDate)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.OutDate)
+	payloadOffset += 4 + len(m.OutDate)
 
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *GetRecommendationsResult) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *SearchRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *GetRecommendationsResult) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *SearchRequest) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -4397,21 +12564,36 @@ func (m *GetRecommendationsResult) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (HotelIds): repeated variable-length
-	if len(data) >= tableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+	// Field 1 (Lat): fixed-length (4 bytes)
+	if len(data) < tableStart+4 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[tableStart+0:]))
+
+	// Field 2 (Lon): fixed-length (4 bytes)
+	if len(data) < tableStart+8 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[tableStart+4:]))
+
+	// Field 3 (InDate): variable-length
+	if len(data) >= tableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.HotelIds = make([]string, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
-						currentOffset += 4 + itemLen
-					}
-				}
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 4 (OutDate): variable-length
+	if len(data) >= tableStart+12+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+12:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
@@ -4419,19 +12601,23 @@ func (m *GetRecommendationsResult) UnmarshalSymphonyPrivate(data []byte) error {
 	return nil
 }
 
-func (m *GetRecommendationsResult) MarshalSymphony() ([]byte, error) {
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *SearchRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *SearchRequest) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 16 // table entries
+	// Field 3 (InDate): variable-length payload
+	size += 4 + len(m.InDate) // 4 bytes length prefix + data
+	// Field 4 (OutDate): variable-length payload
+	size += 4 + len(m.OutDate) // 4 bytes length prefix + data
 	// Private segment:
 	size += 1 // version byte
-	size += 4 // table entries
-	// Field 1 (HotelIds): repeated variable-length payload
-	size += 4 // count
-	for _, item := range m.HotelIds {
-		size += 4 + len(item) // 4 bytes length prefix + data
-	}
 
 	buf := make([]byte, size)
 
@@ -4447,6 +12633,12 @@ func (m *GetRecommendationsResult) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 4                  // field Lat
+	publicSegmentSize += 4                  // field Lon
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4                  // offset placeholder
+	publicSegmentSize += 4 + len(m.InDate)  // field 3 payload
+	publicSegmentSize += 4 + len(m.OutDate) // field 4 payload
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -4455,43 +12647,47 @@ func (m *GetRecommendationsResult) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 16
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (Lat): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], math.Float32bits(m.Lat))
+
+	// Field 2 (Lon): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+4:], math.Float32bits(m.Lon))
+
+	// Field 3 (InDate): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+8:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.InDate)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.InDate)
+	publicPayloadOffset += 4 + len(m.InDate)
+
+	// Field 4 (OutDate): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+12:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.OutDate)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.OutDate)
+	publicPayloadOffset += 4 + len(m.OutDate)
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 4 bytes table
-	privatePayloadStart := privateTableStart + 4
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (HotelIds): repeated variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	count = len(m.HotelIds)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(count))
-	currentOffset = privatePayloadStart + privatePayloadOffset + 4
-	for _, item := range m.HotelIds {
-		itemLen := len(item)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
-		copy(buf[currentOffset+4:], item)
-		currentOffset += 4 + itemLen
-	}
-	privatePayloadOffset += 4 // count
-	for _, item := range m.HotelIds {
-		privatePayloadOffset += 4 + len(item)
-	}
-
 	return buf, nil
 }
 
-func (m *GetRecommendationsResult) UnmarshalSymphony(data []byte) error {
+func (m *SearchRequest) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -4523,171 +12719,268 @@ func (m *GetRecommendationsResult) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (HotelIds): repeated variable-length
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
+	// Field 1 (Lat): fixed-length (4 bytes)
+	if len(data) < publicTableStart+4 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+
+	// Field 2 (Lon): fixed-length (4 bytes)
+	if len(data) < publicTableStart+8 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[publicTableStart+4:]))
+
+	// Field 3 (InDate): variable-length
+	if len(data) >= publicTableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+8:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
 		}
+	}
+
+	// Field 4 (OutDate): variable-length
+	if len(data) >= publicTableStart+12+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+12:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.HotelIds = make([]string, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
-						currentOffset += 4 + itemLen
-					}
-				}
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
-type GetRecommendationsResultRaw []byte
+type SearchRequestRaw []byte
 
-func (m GetRecommendationsResultRaw) MarshalSymphony() ([]byte, error) {
+func (m SearchRequestRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *GetRecommendationsResultRaw) UnmarshalSymphony(data []byte) error {
-	*m = GetRecommendationsResultRaw(data)
+func (m *SearchRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = SearchRequestRaw(data)
 	return nil
 }
 
-func (m GetRecommendationsResultRaw) GetHotelIds() []string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter HotelIds called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+func (m SearchRequestRaw) GetLat() float32 {
+	// Field 1 (Lat): fixed-length (4 bytes)
+	if len(m) < 13+4 {
+		return 0
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter HotelIds called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+	return math.Float32frombits(binary.LittleEndian.Uint32(m[13:]))
+}
+
+func (m SearchRequestRaw) GetLon() float32 {
+	// Field 2 (Lon): fixed-length (4 bytes)
+	if len(m) < 17+4 {
+		return 0
 	}
-	// Field 1 (HotelIds): repeated variable-length
-	if len(m) < offsetToPrivate+1+4 {
-		return nil
+	return math.Float32frombits(binary.LittleEndian.Uint32(m[17:]))
+}
+
+func (m SearchRequestRaw) GetInDate() string {
+	// Field 3 (InDate): variable-length
+	if len(m) < 21+4 {
+		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[21:]))
 	if payloadOffset == 0 {
-		return nil
+		return ""
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
-		return nil
+		return ""
 	}
-	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	result := make([]string, count)
-	currentOffset := payloadOffset + 4
-	for i := 0; i < count; i++ {
-		if len(m) < currentOffset+4 {
-			return nil
-		}
-		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
-		if len(m) < currentOffset+4+itemLen {
-			return nil
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m SearchRequestRaw) GetOutDate() string {
+	// Field 4 (OutDate): variable-length
+	if len(m) < 25+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[25:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m *SearchRequestRaw) SetLat(v float32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lat called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
-		currentOffset += 4 + itemLen
 	}
-	return result
+	// Field 1 (Lat): fixed-length (4 bytes)
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint32((*m)[13:], math.Float32bits(v))
+	return nil
 }
 
-func (m *GetRecommendationsResultRaw) SetHotelIds(v []string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter HotelIds called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+func (m *SearchRequestRaw) SetLon(v float32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Lon called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+	// Field 2 (Lon): fixed-length (4 bytes)
+	if len(*m) < 17+4 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint32((*m)[17:], math.Float32bits(v))
+	return nil
+}
+
+func (m *SearchRequestRaw) SetInDate(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter InDate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter HotelIds called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 1 (HotelIds): repeated variable-length
-	if len(*m) < offsetToPrivate+1+4 {
+	// Field 3 (InDate): variable-length
+	if len(*m) < 21+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldCount int
-	var oldDataSize int
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[21:]))
+	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
-		oldDataSize = 4
-		currentOffset := oldPayloadOffset + 4
-		for i := 0; i < oldCount; i++ {
-			if len(*m) < currentOffset+4 {
-				break
-			}
-			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
-			oldDataSize += 4 + itemLen
-			currentOffset += 4 + itemLen
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp SearchRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.InDate = v
+	fullData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SearchRequestRaw(fullData[:offsetToPrivate])
+	return nil
+}
+
+func (m *SearchRequestRaw) SetOutDate(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter OutDate called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
 	}
-	newCount := len(v)
-	newDataSize := 4 // count
-	for _, item := range v {
-		newDataSize += 4 + len(item) // 4 bytes length + data
+	// Field 4 (OutDate): variable-length
+	if len(*m) < 25+4 {
+		return fmt.Errorf("buffer too short for table entry")
 	}
-	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[25:]))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
 		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
-		currentOffset := oldPayloadOffset + 4
-		for _, item := range v {
-			itemLen := len(item)
-			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
-			copy((*m)[currentOffset+4:], item)
-			currentOffset += 4 + itemLen
-		}
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp GetRecommendationsResult
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp SearchRequest
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.HotelIds = v
-	newData, err := temp.MarshalSymphony()
+	temp.OutDate = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = GetRecommendationsResultRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SearchRequestRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *GetRatesRequest) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *GetRatesRequest) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *SearchResult) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
-	size += 12 // table
-	size += 4  // count for HotelIds
+	size += 4 // table
+	size += 4 // count for HotelIds
 	for _, item := range m.HotelIds {
 		size += 4 + len(item)
 	}
-	size += 4 + len(m.InDate)
-	size += 4 + len(m.OutDate)
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -4696,7 +12989,7 @@ func (m *GetRatesRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 12
+	payloadStart := tableStart + 4
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
@@ -4717,30 +13010,16 @@ func (m *GetRatesRequest) MarshalSymphonyPrivate() ([]byte, error) {
 		payloadOffset += 4 + len(item)
 	}
 
-	// Field 2 (InDate): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.InDate)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.InDate)
-	payloadOffset += 4 + len(m.InDate)
-
-	// Field 3 (OutDate): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.OutDate)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.OutDate)
-	payloadOffset += 4 + len(m.OutDate)
-
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *GetRatesRequest) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *SearchResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *GetRatesRequest) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *SearchResult) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -4771,48 +13050,27 @@ func (m *GetRatesRequest) UnmarshalSymphonyPrivate(data []byte) error {
 		}
 	}
 
-	// Field 2 (InDate): variable-length
-	if len(data) >= tableStart+4+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 3 (OutDate): variable-length
-	if len(data) >= tableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
+	return nil
+}
 
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *SearchResult) UnmarshalSymphonyPrivate(data []byte) error {
 	return nil
 }
 
-func (m *GetRatesRequest) MarshalSymphony() ([]byte, error) {
+func (m *SearchResult) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
-	// Private segment:
-	size += 1  // version byte
-	size += 12 // table entries
+	size += 4  // table entries
 	// Field 1 (HotelIds): repeated variable-length payload
 	size += 4 // count
 	for _, item := range m.HotelIds {
 		size += 4 + len(item) // 4 bytes length prefix + data
 	}
-	// Field 2 (InDate): variable-length payload
-	size += 4 + len(m.InDate) // 4 bytes length prefix + data
-	// Field 3 (OutDate): variable-length payload
-	size += 4 + len(m.OutDate) // 4 bytes length prefix + data
+	// Private segment:
+	size += 1 // version byte
 
 	buf := make([]byte, size)
 
@@ -4828,6 +13086,11 @@ func (m *GetRatesRequest) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 4 // offset placeholder
+	publicSegmentSize += 4 // field 1 count
+	for _, item := range m.HotelIds {
+		publicSegmentSize += 4 + len(item)
+	}
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -4836,57 +13099,43 @@ func (m *GetRatesRequest) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 4
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
-	// === PRIVATE SEGMENT ===
-	privateStart := publicSegmentSize
-	buf[privateStart] = 0x01 // version byte
-
-	// Write private fields
-	privateTableStart := privateStart + 1 // 12 bytes table
-	privatePayloadStart := privateTableStart + 12
-	privatePayloadOffset := 0
-	_ = privatePayloadStart
-	_ = privatePayloadOffset
-
-	// Private segment offsets are stored relative to privateStart
 	// Field 1 (HotelIds): repeated variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(publicPayloadStart+publicPayloadOffset))
 	count = len(m.HotelIds)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(count))
-	currentOffset = privatePayloadStart + privatePayloadOffset + 4
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	currentOffset = publicPayloadStart + publicPayloadOffset + 4
 	for _, item := range m.HotelIds {
 		itemLen := len(item)
 		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
 		copy(buf[currentOffset+4:], item)
 		currentOffset += 4 + itemLen
 	}
-	privatePayloadOffset += 4 // count
+	publicPayloadOffset += 4 // count
 	for _, item := range m.HotelIds {
-		privatePayloadOffset += 4 + len(item)
+		publicPayloadOffset += 4 + len(item)
 	}
 
-	// Field 2 (InDate): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.InDate)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.InDate)
-	privatePayloadOffset += 4 + len(m.InDate)
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
 
-	// Field 3 (OutDate): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+8:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.OutDate)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.OutDate)
-	privatePayloadOffset += 4 + len(m.OutDate)
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
 
+	// Private segment offsets are stored relative to privateStart
 	return buf, nil
 }
 
-func (m *GetRatesRequest) UnmarshalSymphony(data []byte) error {
+func (m *SearchResult) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -4918,16 +13167,9 @@ func (m *GetRatesRequest) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
 	// Field 1 (HotelIds): repeated variable-length
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	if len(data) >= publicTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			m.HotelIds = make([]string, 0, count)
@@ -4944,175 +13186,66 @@ func (m *GetRatesRequest) UnmarshalSymphony(data []byte) error {
 		}
 	}
 
-	// Field 2 (InDate): variable-length
-	if len(data) >= privateTableStart+4+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 3 (OutDate): variable-length
-	if len(data) >= privateTableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+8:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	return nil
-}
-
-type GetRatesRequestRaw []byte
-
-func (m GetRatesRequestRaw) MarshalSymphony() ([]byte, error) {
-	return []byte(m), nil
-}
-
-func (m *GetRatesRequestRaw) UnmarshalSymphony(data []byte) error {
-	*m = GetRatesRequestRaw(data)
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
-func (m GetRatesRequestRaw) GetHotelIds() []string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter HotelIds called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter HotelIds called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (HotelIds): repeated variable-length
-	if len(m) < offsetToPrivate+1+4 {
-		return nil
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
-	if payloadOffset == 0 {
-		return nil
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return nil
-	}
-	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	result := make([]string, count)
-	currentOffset := payloadOffset + 4
-	for i := 0; i < count; i++ {
-		if len(m) < currentOffset+4 {
-			return nil
-		}
-		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
-		if len(m) < currentOffset+4+itemLen {
-			return nil
-		}
-		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
-		currentOffset += 4 + itemLen
-	}
-	return result
-}
-
-func (m GetRatesRequestRaw) GetInDate() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter InDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter InDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 2 (InDate): variable-length
-	if len(m) < offsetToPrivate+5+4 {
-		return ""
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
-	if payloadOffset == 0 {
-		return ""
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
-	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
-}
+type SearchResultRaw []byte
 
-func (m GetRatesRequestRaw) GetOutDate() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter OutDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter OutDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 3 (OutDate): variable-length
-	if len(m) < offsetToPrivate+9+4 {
-		return ""
+func (m SearchResultRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *SearchResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = SearchResultRaw(data)
+	return nil
+}
+
+func (m SearchResultRaw) GetHotelIds() []string {
+	// Field 1 (HotelIds): repeated variable-length
+	if len(m) < 13+4 {
+		return nil
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+9:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[13:]))
 	if payloadOffset == 0 {
-		return ""
+		return nil
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
-		return ""
+		return nil
 	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]string, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
+		}
+		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+itemLen {
+			return nil
+		}
+		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
+		currentOffset += 4 + itemLen
 	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+	return result
 }
 
-func (m *GetRatesRequestRaw) SetHotelIds(v []string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter HotelIds called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *SearchResultRaw) SetHotelIds(v []string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter HotelIds called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter HotelIds called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
 	// Field 1 (HotelIds): repeated variable-length
-	if len(*m) < offsetToPrivate+1+4 {
+	if len(*m) < 13+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[13:]))
 	var oldCount int
 	var oldDataSize int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
@@ -5146,126 +13279,51 @@ func (m *GetRatesRequestRaw) SetHotelIds(v []string) error {
 		}
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp GetRatesRequest
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
-	}
-	temp.HotelIds = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
-	}
-	*m = GetRatesRequestRaw(newData)
-	return nil
-}
-
-func (m *GetRatesRequestRaw) SetInDate(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter InDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter InDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 2 (InDate): variable-length
-	if len(*m) < offsetToPrivate+5+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+5:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp GetRatesRequest
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	var temp SearchResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.InDate = v
-	newData, err := temp.MarshalSymphony()
+	temp.HotelIds = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = GetRatesRequestRaw(newData)
-	return nil
-}
-
-func (m *GetRatesRequestRaw) SetOutDate(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter OutDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter OutDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 3 (OutDate): variable-length
-	if len(*m) < offsetToPrivate+9+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+9:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
-	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp GetRatesRequest
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
-	}
-	temp.OutDate = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
 	}
-	*m = GetRatesRequestRaw(newData)
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = SearchResultRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *GetRatesResult) MarshalSymphonyPublic() ([]byte, error) {
+func (m *CheckUserRequest) MarshalSymphonyPublic() ([]byte, error) {
 	return []byte{}, nil
 }
 
 // MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *GetRatesResult) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *CheckUserRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	size := 0
-	size += 4 // table
-	size += 4 // count for RatePlans
-	for _, item := range m.RatePlans {
-		nested, _ := item.MarshalSymphony()
-		size += 4 + len(nested)
-	}
+	size += 8 // table
+	size += 4 + len(m.Username)
+	size += 4 + len(m.Password)
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -5274,39 +13332,35 @@ func (m *GetRatesResult) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 4
+	payloadStart := tableStart + 8
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (RatePlans): repeated nested message
+	// Field 1 (Username): variable-length
 	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	count = len(m.RatePlans)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
-	payloadOffset += 4
-	currentOffset = payloadStart + payloadOffset
-	for _, item := range m.RatePlans {
-		nestedData, err := item.MarshalSymphony()
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
-		}
-		nestedSize := len(nestedData)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
-		copy(buf[currentOffset+4:], nestedData)
-		currentOffset += 4 + nestedSize
-		payloadOffset += 4 + nestedSize
-	}
+	dataLen = len(m.Username)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Username)
+	payloadOffset += 4 + len(m.Username)
+
+	// Field 2 (Password): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Password)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
+	copy(buf[payloadStart+payloadOffset+4:], m.Password)
+	payloadOffset += 4 + len(m.Password)
 
 	return buf, nil
 }
 
 // UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *GetRatesResult) UnmarshalSymphonyPublic(data []byte) error {
+func (m *CheckUserRequest) UnmarshalSymphonyPublic(data []byte) error {
 	return nil
 }
 
 // UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *GetRatesResult) UnmarshalSymphonyPrivate(data []byte) error {
+func (m *CheckUserRequest) UnmarshalSymphonyPrivate(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -5318,80 +13372,44 @@ func (m *GetRatesResult) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (RatePlans): repeated nested message
-	if len(data) >= tableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.RatePlans = make([]*RatePlan, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						item := &RatePlan{}
-						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
-							return fmt.Errorf("failed to unmarshal nested message: %w", err)
-						}
-						m.RatePlans = append(m.RatePlans, item)
-						currentOffset += 4 + itemLen
-					}
-				}
-			}
-		}
-	}
-
-	return nil
-}
-
-func (m *GetRatesResult) MarshalSymphony() ([]byte, error) {
-	size := 0
-	// Public segment:
-	size += 1  // version byte
-	size += 12 // reserved: offset_to_private, service_name, method_name
-	// Private segment:
-	size += 1 // version byte
-	size += 4 // table entries
-	// Field 1 (RatePlans): repeated nested message payload
-	size += 4 // count
-	for _, item := range m.RatePlans {
-		nestedSize1 := 0
-		// Public segment:
-		nestedSize1 += 1  // version byte
-		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
-		// Private segment:
-		nestedSize1 += 1  // version byte
-		nestedSize1 += 20 // table entries
-		// Field 1 (HotelId): variable-length payload
-		nestedSize1 += 4 + len(item.HotelId) // 4 bytes length prefix + data
-		// Field 2 (Code): variable-length payload
-		nestedSize1 += 4 + len(item.Code) // 4 bytes length prefix + data
-		// Field 3 (InDate): variable-length payload
-		nestedSize1 += 4 + len(item.InDate) // 4 bytes length prefix + data
-		// Field 4 (OutDate): variable-length payload
-		nestedSize1 += 4 + len(item.OutDate) // 4 bytes length prefix + data
-		// Field 5 (RoomType): nested message payload
-		if item.RoomType != nil {
-			nestedSize2 := 0
-			// Public segment:
-			nestedSize2 += 1  // version byte
-			nestedSize2 += 12 // reserved: offset_to_private, service_name, method_name
-			// Private segment:
-			nestedSize2 += 1  // version byte
-			nestedSize2 += 36 // table entries
-			// Field 4 (Code): variable-length payload
-			nestedSize2 += 4 + len(item.RoomType.Code) // 4 bytes length prefix + data
-			// Field 5 (Currency): variable-length payload
-			nestedSize2 += 4 + len(item.RoomType.Currency) // 4 bytes length prefix + data
-			// Field 6 (RoomDescription): variable-length payload
-			nestedSize2 += 4 + len(item.RoomType.RoomDescription) // 4 bytes length prefix + data
-
-			nestedSize1 += 4 + nestedSize2 // 4 bytes size + message data
+	// Field 1 (Username): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Username = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
 		}
+	}
 
-		size += 4 + nestedSize1 // 4 bytes size + message data
+	// Field 2 (Password): variable-length
+	if len(data) >= tableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Password = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
 	}
 
+	return nil
+}
+
+func (m *CheckUserRequest) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	// Private segment:
+	size += 1 // version byte
+	size += 8 // table entries
+	// Field 1 (Username): variable-length payload
+	size += 4 + len(m.Username) // 4 bytes length prefix + data
+	// Field 2 (Password): variable-length payload
+	size += 4 + len(m.Password) // 4 bytes length prefix + data
+
 	buf := make([]byte, size)
 
 	dataLen := 0 // avoid no new variables warning
@@ -5424,35 +13442,31 @@ func (m *GetRatesResult) MarshalSymphony() ([]byte, error) {
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 4 bytes table
-	privatePayloadStart := privateTableStart + 4
+	privateTableStart := privateStart + 1 // 8 bytes table
+	privatePayloadStart := privateTableStart + 8
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (RatePlans): repeated nested message
+	// Field 1 (Username): variable-length
 	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	count = len(m.RatePlans)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(count))
-	privatePayloadOffset += 4
-	currentOffset = privatePayloadStart + privatePayloadOffset
-	for _, item := range m.RatePlans {
-		nestedData, err := item.MarshalSymphony()
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
-		}
-		nestedSize := len(nestedData)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(nestedSize))
-		copy(buf[currentOffset+4:], nestedData)
-		currentOffset += 4 + nestedSize
-		privatePayloadOffset += 4 + nestedSize
-	}
+	dataLen = len(m.Username)
+	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
+	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Username)
+	privatePayloadOffset += 4 + len(m.Username)
+
+	// Field 2 (Password): variable-length
+	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
+	dataLen = len(m.Password)
+	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
+	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Password)
+	privatePayloadOffset += 4 + len(m.Password)
 
 	return buf, nil
 }
 
-func (m *GetRatesResult) UnmarshalSymphony(data []byte) error {
+func (m *CheckUserRequest) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -5488,28 +13502,30 @@ func (m *GetRatesResult) UnmarshalSymphony(data []byte) error {
 	privateTableStart := offsetToPrivate + 1
 	_ = privateTableStart
 	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (RatePlans): repeated nested message
+	// Field 1 (Username): variable-length
 	if len(data) >= privateTableStart+0+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
 		if payloadOffset > 0 {
 			payloadOffset += offsetToPrivate // convert relative offset to absolute
 		}
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.RatePlans = make([]*RatePlan, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						item := &RatePlan{}
-						if err := item.UnmarshalSymphony(data[currentOffset+4 : currentOffset+4+itemLen]); err != nil {
-							return fmt.Errorf("failed to unmarshal nested message: %w", err)
-						}
-						m.RatePlans = append(m.RatePlans, item)
-						currentOffset += 4 + itemLen
-					}
-				}
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Username = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			}
+		}
+	}
+
+	// Field 2 (Password): variable-length
+	if len(data) >= privateTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
+		if payloadOffset > 0 {
+			payloadOffset += offsetToPrivate // convert relative offset to absolute
+		}
+		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
+			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			if len(data) >= payloadOffset+4+dataLen {
+				m.Password = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
@@ -5517,21 +13533,21 @@ func (m *GetRatesResult) UnmarshalSymphony(data []byte) error {
 	return nil
 }
 
-type GetRatesResultRaw []byte
+type CheckUserRequestRaw []byte
 
-func (m GetRatesResultRaw) MarshalSymphony() ([]byte, error) {
+func (m CheckUserRequestRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *GetRatesResultRaw) UnmarshalSymphony(data []byte) error {
-	*m = GetRatesResultRaw(data)
+func (m *CheckUserRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = CheckUserRequestRaw(data)
 	return nil
 }
 
-func (m GetRatesResultRaw) GetRatePlans() []RatePlanRaw {
+func (m CheckUserRequestRaw) GetUsername() string {
 	// ASSERT: Private field requires complete buffer
 	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter RatePlans called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+		panic(fmt.Sprintf("private getter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
 	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
@@ -5539,41 +13555,63 @@ func (m GetRatesResultRaw) GetRatePlans() []RatePlanRaw {
 		if offsetToPrivate < len(m) {
 			marker = m[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private getter RatePlans called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+		panic(fmt.Sprintf("private getter Username called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
 	}
-	// Field 1 (RatePlans): repeated nested message
+	// Field 1 (Username): variable-length
 	if len(m) < offsetToPrivate+1+4 {
-		return nil
+		return ""
 	}
 	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
 	if payloadOffset == 0 {
-		return nil
+		return ""
 	}
 	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
-		return nil
+		return ""
 	}
-	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	result := make([]RatePlanRaw, count)
-	currentOffset := payloadOffset + 4
-	for i := 0; i < count; i++ {
-		if len(m) < currentOffset+4 {
-			return nil
-		}
-		nestedSize := int(binary.LittleEndian.Uint32(m[currentOffset:]))
-		if len(m) < currentOffset+4+nestedSize {
-			return nil
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+}
+
+func (m CheckUserRequestRaw) GetPassword() string {
+	// ASSERT: Private field requires complete buffer
+	if len(m) < 5 {
+		panic(fmt.Sprintf("private getter Password called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
+	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
+		marker := byte(0)
+		if offsetToPrivate < len(m) {
+			marker = m[offsetToPrivate]
 		}
-		result[i] = RatePlanRaw(m[currentOffset+4 : currentOffset+4+nestedSize])
-		currentOffset += 4 + nestedSize
+		panic(fmt.Sprintf("private getter Password called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
 	}
-	return result
+	// Field 2 (Password): variable-length
+	if len(m) < offsetToPrivate+5+4 {
+		return ""
+	}
+	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
+	if payloadOffset == 0 {
+		return ""
+	}
+	payloadOffset += offsetToPrivate // convert relative offset to absolute
+	if len(m) < payloadOffset+4 {
+		return ""
+	}
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
+	}
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m *GetRatesResultRaw) SetRatePlans(v []RatePlanRaw) error {
+func (m *CheckUserRequestRaw) SetUsername(v string) error {
 	// ASSERT: Private field setter requires complete buffer
 	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter RatePlans called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+		panic(fmt.Sprintf("private setter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
 	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
@@ -5581,9 +13619,9 @@ func (m *GetRatesResultRaw) SetRatePlans(v []RatePlanRaw) error {
 		if offsetToPrivate < len(*m) {
 			marker = (*m)[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private setter RatePlans called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+		panic(fmt.Sprintf("private setter Username called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 1 (RatePlans): repeated nested message
+	// Field 1 (Username): variable-length
 	if len(*m) < offsetToPrivate+1+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
@@ -5591,75 +13629,85 @@ func (m *GetRatesResultRaw) SetRatePlans(v []RatePlanRaw) error {
 	if oldPayloadOffset > 0 {
 		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
 	}
-	var oldCount int
-	var oldDataSize int
+	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-		// Calculate old data size: 4 bytes count + for each item: 4 bytes size + data
-		oldDataSize = 4
-		currentOffset := oldPayloadOffset + 4
-		for i := 0; i < oldCount; i++ {
-			if len(*m) < currentOffset+4 {
-				break
-			}
-			itemSize := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
-			oldDataSize += 4 + itemSize
-			currentOffset += 4 + itemSize
-		}
-	}
-	newCount := len(v)
-	newDataSize := 4 // count
-	for _, item := range v {
-		newDataSize += 4 + len(item) // 4 bytes size + data
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
 	}
-	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
 		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
-		currentOffset := oldPayloadOffset + 4
-		for _, item := range v {
-			itemSize := len(item)
-			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemSize))
-			copy((*m)[currentOffset+4:], item)
-			currentOffset += 4 + itemSize
-		}
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
 	// Need to remarshal: unmarshal, update, marshal
-	var temp GetRatesResult
+	var temp CheckUserRequest
 	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.RatePlans = make([]*RatePlan, len(v))
-	for i, rawItem := range v {
-		temp.RatePlans[i] = &RatePlan{}
-		if err := temp.RatePlans[i].UnmarshalSymphony([]byte(rawItem)); err != nil {
-			return fmt.Errorf("failed to unmarshal nested message: %w", err)
+	temp.Username = v
+	newData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+	*m = CheckUserRequestRaw(newData)
+	return nil
+}
+
+func (m *CheckUserRequestRaw) SetPassword(v string) error {
+	// ASSERT: Private field setter requires complete buffer
+	if len(*m) < 5 {
+		panic(fmt.Sprintf("private setter Password called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
+		marker := byte(0)
+		if offsetToPrivate < len(*m) {
+			marker = (*m)[offsetToPrivate]
 		}
+		panic(fmt.Sprintf("private setter Password called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+	}
+	// Field 2 (Password): variable-length
+	if len(*m) < offsetToPrivate+5+4 {
+		return fmt.Errorf("buffer too short for table entry")
+	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+5:]))
+	if oldPayloadOffset > 0 {
+		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
+	}
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	}
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal
+	var temp CheckUserRequest
+	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
+	temp.Password = v
 	newData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = GetRatesResultRaw(newData)
+	*m = CheckUserRequestRaw(newData)
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *RatePlan) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *RatePlan) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *CheckUserResult) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
-	size += 20 // table
-	size += 4 + len(m.HotelId)
-	size += 4 + len(m.Code)
-	size += 4 + len(m.InDate)
-	size += 4 + len(m.OutDate)
-	if m.RoomType != nil {
-		nested, _ := m.RoomType.MarshalSymphony()
-		size += 4 + len(nested)
+	size += 21 // table
+	size += 4 + len(m.Role)
+	size += 4 // count for Hotels
+	for _, item := range m.Hotels {
+		size += 4 + len(item)
 	}
 	buf := make([]byte, size)
 	dataLen := 0
@@ -5669,64 +13717,57 @@ func (m *RatePlan) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 20
+	payloadStart := tableStart + 21
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (HotelId): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.HotelId)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.HotelId)
-	payloadOffset += 4 + len(m.HotelId)
-
-	// Field 2 (Code): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.Code)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.Code)
-	payloadOffset += 4 + len(m.Code)
+	// Field 1 (Correct): fixed-length (1 bytes)
+	if m.Correct {
+		buf[tableStart+0] = 1
+	} else {
+		buf[tableStart+0] = 0
+	}
 
-	// Field 3 (InDate): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.InDate)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.InDate)
-	payloadOffset += 4 + len(m.InDate)
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+1:], uint32(m.RetryAfter))
 
-	// Field 4 (OutDate): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+12:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.OutDate)
+	// Field 3 (Role): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+5:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Role)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.OutDate)
-	payloadOffset += 4 + len(m.OutDate)
+	copy(buf[payloadStart+payloadOffset+4:], m.Role)
+	payloadOffset += 4 + len(m.Role)
 
-	// Field 5 (RoomType): nested message
-	if m.RoomType != nil {
-		binary.LittleEndian.PutUint32(buf[tableStart+16:], uint32(payloadStart+payloadOffset))
-		nestedData, err := m.RoomType.MarshalSymphony()
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
-		}
-		nestedSize := len(nestedData)
-		binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(nestedSize))
-		copy(buf[payloadStart+payloadOffset+4:], nestedData)
-		payloadOffset += 4 + nestedSize
-	} else {
-		binary.LittleEndian.PutUint32(buf[tableStart+16:], 0)
+	// Field 4 (Hotels): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+9:], uint32(payloadStart+payloadOffset))
+	count = len(m.Hotels)
+	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
+	currentOffset = payloadStart + payloadOffset + 4
+	for _, item := range m.Hotels {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	payloadOffset += 4 // count
+	for _, item := range m.Hotels {
+		payloadOffset += 4 + len(item)
 	}
 
+	// Field 5 (PasswordChanged): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+13:], uint64(m.PasswordChanged))
+
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *RatePlan) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *CheckUserResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *RatePlan) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *CheckUserResult) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -5738,101 +13779,77 @@ func (m *RatePlan) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (HotelId): variable-length
-	if len(data) >= tableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
+	// Field 1 (Correct): fixed-length (1 bytes)
+	if len(data) < tableStart+1 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
+	m.Correct = data[tableStart+0] != 0
 
-	// Field 2 (Code): variable-length
-	if len(data) >= tableStart+4+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Code = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(data) < tableStart+5 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
+	m.RetryAfter = int32(binary.LittleEndian.Uint32(data[tableStart+1:]))
 
-	// Field 3 (InDate): variable-length
-	if len(data) >= tableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
+	// Field 3 (Role): variable-length
+	if len(data) >= tableStart+5+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+5:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Role = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 4 (OutDate): variable-length
-	if len(data) >= tableStart+12+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+12:]))
+	// Field 4 (Hotels): repeated variable-length
+	if len(data) >= tableStart+9+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+9:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.Hotels = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.Hotels = append(m.Hotels, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
 			}
 		}
 	}
 
-	// Field 5 (RoomType): nested message
-	if len(data) >= tableStart+16+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+16:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.RoomType = &RoomType{}
-				if err := m.RoomType.UnmarshalSymphony(data[payloadOffset+4 : payloadOffset+4+dataLen]); err != nil {
-					return fmt.Errorf("failed to unmarshal nested message: %w", err)
-				}
-			}
-		}
+	// Field 5 (PasswordChanged): fixed-length (8 bytes)
+	if len(data) < tableStart+21 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
+	m.PasswordChanged = int64(binary.LittleEndian.Uint64(data[tableStart+13:]))
 
 	return nil
 }
 
-func (m *RatePlan) MarshalSymphony() ([]byte, error) {
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *CheckUserResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *CheckUserResult) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
-	// Private segment:
-	size += 1  // version byte
-	size += 20 // table entries
-	// Field 1 (HotelId): variable-length payload
-	size += 4 + len(m.HotelId) // 4 bytes length prefix + data
-	// Field 2 (Code): variable-length payload
-	size += 4 + len(m.Code) // 4 bytes length prefix + data
-	// Field 3 (InDate): variable-length payload
-	size += 4 + len(m.InDate) // 4 bytes length prefix + data
-	// Field 4 (OutDate): variable-length payload
-	size += 4 + len(m.OutDate) // 4 bytes length prefix + data
-	// Field 5 (RoomType): nested message payload
-	if m.RoomType != nil {
-		nestedSize1 := 0
-		// Public segment:
-		nestedSize1 += 1  // version byte
-		nestedSize1 += 12 // reserved: offset_to_private, service_name, method_name
-		// Private segment:
-		nestedSize1 += 1  // version byte
-		nestedSize1 += 36 // table entries
-		// Field 4 (Code): variable-length payload
-		nestedSize1 += 4 + len(m.RoomType.Code) // 4 bytes length prefix + data
-		// Field 5 (Currency): variable-length payload
-		nestedSize1 += 4 + len(m.RoomType.Currency) // 4 bytes length prefix + data
-		// Field 6 (RoomDescription): variable-length payload
-		nestedSize1 += 4 + len(m.RoomType.RoomDescription) // 4 bytes length prefix + data
-
-		size += 4 + nestedSize1 // 4 bytes size + message data
+	size += 21 // table entries
+	// Field 3 (Role): variable-length payload
+	size += 4 + len(m.Role) // 4 bytes length prefix + data
+	// Field 4 (Hotels): repeated variable-length payload
+	size += 4 // count
+	for _, item := range m.Hotels {
+		size += 4 + len(item) // 4 bytes length prefix + data
 	}
+	// Private segment:
+	size += 1 // version byte
 
 	buf := make([]byte, size)
 
@@ -5848,6 +13865,16 @@ func (m *RatePlan) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 1               // field Correct
+	publicSegmentSize += 4               // field RetryAfter
+	publicSegmentSize += 4               // offset placeholder
+	publicSegmentSize += 4               // offset placeholder
+	publicSegmentSize += 8               // field PasswordChanged
+	publicSegmentSize += 4 + len(m.Role) // field 3 payload
+	publicSegmentSize += 4               // field 4 count
+	for _, item := range m.Hotels {
+		publicSegmentSize += 4 + len(item)
+	}
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -5856,70 +13883,63 @@ func (m *RatePlan) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 21
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (Correct): fixed-length (1 bytes)
+	if m.Correct {
+		buf[publicTableStart+0] = 1
+	} else {
+		buf[publicTableStart+0] = 0
+	}
+
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+1:], uint32(m.RetryAfter))
+
+	// Field 3 (Role): variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+5:], uint32(publicPayloadStart+publicPayloadOffset))
+	dataLen = len(m.Role)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(dataLen))
+	copy(buf[publicPayloadStart+publicPayloadOffset+4:], m.Role)
+	publicPayloadOffset += 4 + len(m.Role)
+
+	// Field 4 (Hotels): repeated variable-length
+	binary.LittleEndian.PutUint32(buf[publicTableStart+9:], uint32(publicPayloadStart+publicPayloadOffset))
+	count = len(m.Hotels)
+	binary.LittleEndian.PutUint32(buf[publicPayloadStart+publicPayloadOffset:], uint32(count))
+	currentOffset = publicPayloadStart + publicPayloadOffset + 4
+	for _, item := range m.Hotels {
+		itemLen := len(item)
+		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
+		copy(buf[currentOffset+4:], item)
+		currentOffset += 4 + itemLen
+	}
+	publicPayloadOffset += 4 // count
+	for _, item := range m.Hotels {
+		publicPayloadOffset += 4 + len(item)
+	}
+
+	// Field 5 (PasswordChanged): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+13:], uint64(m.PasswordChanged))
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 20 bytes table
-	privatePayloadStart := privateTableStart + 20
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (HotelId): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.HotelId)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.HotelId)
-	privatePayloadOffset += 4 + len(m.HotelId)
-
-	// Field 2 (Code): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Code)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Code)
-	privatePayloadOffset += 4 + len(m.Code)
-
-	// Field 3 (InDate): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+8:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.InDate)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.InDate)
-	privatePayloadOffset += 4 + len(m.InDate)
-
-	// Field 4 (OutDate): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+12:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.OutDate)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.OutDate)
-	privatePayloadOffset += 4 + len(m.OutDate)
-
-	// Field 5 (RoomType): nested message
-	if m.RoomType != nil {
-		binary.LittleEndian.PutUint32(buf[privateTableStart+16:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-		nestedData, err := m.RoomType.MarshalSymphony()
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal nested message: %w", err)
-		}
-		nestedSize := len(nestedData)
-		binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(nestedSize))
-		copy(buf[privatePayloadStart+privatePayloadOffset+4:], nestedData)
-		privatePayloadOffset += 4 + nestedSize
-	} else {
-		binary.LittleEndian.PutUint32(buf[privateTableStart+16:], 0)
-	}
-
 	return buf, nil
 }
 
-func (m *RatePlan) UnmarshalSymphony(data []byte) error {
+func (m *CheckUserResult) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -5949,217 +13969,99 @@ func (m *RatePlan) UnmarshalSymphony(data []byte) error {
 	_ = currentOffset
 
 	// === PUBLIC FIELDS ===
-	publicTableStart := 13
-	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (HotelId): variable-length
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.HotelId = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (Correct): fixed-length (1 bytes)
+	if len(data) < publicTableStart+1 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
+	m.Correct = data[publicTableStart+0] != 0
 
-	// Field 2 (Code): variable-length
-	if len(data) >= privateTableStart+4+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Code = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(data) < publicTableStart+5 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
+	m.RetryAfter = int32(binary.LittleEndian.Uint32(data[publicTableStart+1:]))
 
-	// Field 3 (InDate): variable-length
-	if len(data) >= privateTableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+8:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	// Field 3 (Role): variable-length
+	if len(data) >= publicTableStart+5+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+5:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Role = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 4 (OutDate): variable-length
-	if len(data) >= privateTableStart+12+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+12:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
+	// Field 4 (Hotels): repeated variable-length
+	if len(data) >= publicTableStart+9+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[publicTableStart+9:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
+			m.Hotels = make([]string, 0, count)
+			currentOffset = payloadOffset + 4
+			for i := 0; i < count; i++ {
+				if len(data) >= currentOffset+4 {
+					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
+					if len(data) >= currentOffset+4+itemLen {
+						m.Hotels = append(m.Hotels, string(data[currentOffset+4:currentOffset+4+itemLen]))
+						currentOffset += 4 + itemLen
+					}
+				}
 			}
 		}
 	}
 
-	// Field 5 (RoomType): nested message
-	if len(data) >= privateTableStart+16+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+16:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.RoomType = &RoomType{}
-				if err := m.RoomType.UnmarshalSymphony(data[payloadOffset+4 : payloadOffset+4+dataLen]); err != nil {
-					return fmt.Errorf("failed to unmarshal nested message: %w", err)
-				}
-			}
-		}
+	// Field 5 (PasswordChanged): fixed-length (8 bytes)
+	if len(data) < publicTableStart+21 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
+	m.PasswordChanged = int64(binary.LittleEndian.Uint64(data[publicTableStart+13:]))
 
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
 	return nil
 }
 
-type RatePlanRaw []byte
+type CheckUserResultRaw []byte
 
-func (m RatePlanRaw) MarshalSymphony() ([]byte, error) {
+func (m CheckUserResultRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *RatePlanRaw) UnmarshalSymphony(data []byte) error {
-	*m = RatePlanRaw(data)
+func (m *CheckUserResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = CheckUserResultRaw(data)
 	return nil
 }
 
-func (m RatePlanRaw) GetHotelId() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter HotelId called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter HotelId called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (HotelId): variable-length
-	if len(m) < offsetToPrivate+1+4 {
-		return ""
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
-	if payloadOffset == 0 {
-		return ""
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
-	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
-}
-
-func (m RatePlanRaw) GetCode() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Code called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Code called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 2 (Code): variable-length
-	if len(m) < offsetToPrivate+5+4 {
-		return ""
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
-	if payloadOffset == 0 {
-		return ""
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
+func (m CheckUserResultRaw) GetCorrect() bool {
+	// Field 1 (Correct): fixed-length (1 bytes)
+	if len(m) < 13+1 {
+		return false
 	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+	return m[13] != 0
 }
 
-func (m RatePlanRaw) GetInDate() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter InDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter InDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 3 (InDate): variable-length
-	if len(m) < offsetToPrivate+9+4 {
-		return ""
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+9:]))
-	if payloadOffset == 0 {
-		return ""
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
+func (m CheckUserResultRaw) GetRetryAfter() int32 {
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(m) < 14+4 {
+		return 0
 	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+	return int32(binary.LittleEndian.Uint32(m[14:]))
 }
 
-func (m RatePlanRaw) GetOutDate() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter OutDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter OutDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 4 (OutDate): variable-length
-	if len(m) < offsetToPrivate+13+4 {
+func (m CheckUserResultRaw) GetRole() string {
+	// Field 3 (Role): variable-length
+	if len(m) < 18+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+13:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[18:]))
 	if payloadOffset == 0 {
 		return ""
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
 		return ""
 	}
@@ -6170,151 +14072,92 @@ func (m RatePlanRaw) GetOutDate() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m RatePlanRaw) GetRoomType() RoomTypeRaw {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter RoomType called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter RoomType called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 5 (RoomType): nested message
-	if len(m) < offsetToPrivate+17+4 {
+func (m CheckUserResultRaw) GetHotels() []string {
+	// Field 4 (Hotels): repeated variable-length
+	if len(m) < 22+4 {
 		return nil
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+17:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[22:]))
 	if payloadOffset == 0 {
 		return nil
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
 	if len(m) < payloadOffset+4 {
 		return nil
 	}
-	nestedSize := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+nestedSize {
-		return nil
-	}
-	return RoomTypeRaw(m[payloadOffset+4 : payloadOffset+4+nestedSize])
-}
-
-func (m *RatePlanRaw) SetHotelId(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter HotelId called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	result := make([]string, count)
+	currentOffset := payloadOffset + 4
+	for i := 0; i < count; i++ {
+		if len(m) < currentOffset+4 {
+			return nil
 		}
-		panic(fmt.Sprintf("private setter HotelId called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 1 (HotelId): variable-length
-	if len(*m) < offsetToPrivate+1+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
-	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp RatePlan
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
-	}
-	temp.HotelId = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
-	}
-	*m = RatePlanRaw(newData)
-	return nil
-}
-
-func (m *RatePlanRaw) SetCode(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Code called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
+		if len(m) < currentOffset+4+itemLen {
+			return nil
 		}
-		panic(fmt.Sprintf("private setter Code called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 2 (Code): variable-length
-	if len(*m) < offsetToPrivate+5+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+5:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
+		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
+		currentOffset += 4 + itemLen
 	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+	return result
+}
+
+func (m CheckUserResultRaw) GetPasswordChanged() int64 {
+	// Field 5 (PasswordChanged): fixed-length (8 bytes)
+	if len(m) < 26+8 {
+		return 0
 	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
+	return int64(binary.LittleEndian.Uint64(m[26:]))
+}
+
+func (m *CheckUserResultRaw) SetCorrect(v bool) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Correct called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp RatePlan
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
+	// Field 1 (Correct): fixed-length (1 bytes)
+	if len(*m) < 13+1 {
+		return fmt.Errorf("buffer too short")
 	}
-	temp.Code = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+	if v {
+		(*m)[13] = 1
+	} else {
+		(*m)[13] = 0
 	}
-	*m = RatePlanRaw(newData)
 	return nil
 }
 
-func (m *RatePlanRaw) SetInDate(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter InDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+func (m *CheckUserResultRaw) SetRetryAfter(v int32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter RetryAfter called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(*m) < 14+4 {
+		return fmt.Errorf("buffer too short")
+	}
+	binary.LittleEndian.PutUint32((*m)[14:], uint32(v))
+	return nil
+}
+
+func (m *CheckUserResultRaw) SetRole(v string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Role called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter InDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 3 (InDate): variable-length
-	if len(*m) < offsetToPrivate+9+4 {
+	// Field 3 (Role): variable-length
+	if len(*m) < 18+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+9:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[18:]))
 	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
 		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
@@ -6326,129 +14169,147 @@ func (m *RatePlanRaw) SetInDate(v string) error {
 		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp RatePlan
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp CheckUserResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.InDate = v
-	newData, err := temp.MarshalSymphony()
+	temp.Role = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = RatePlanRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = CheckUserResultRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
-func (m *RatePlanRaw) SetOutDate(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter OutDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *CheckUserResultRaw) SetHotels(v []string) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Hotels called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter OutDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 4 (OutDate): variable-length
-	if len(*m) < offsetToPrivate+13+4 {
+	// Field 4 (Hotels): repeated variable-length
+	if len(*m) < 22+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+13:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[22:]))
+	var oldCount int
+	var oldDataSize int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
+		oldDataSize = 4
+		currentOffset := oldPayloadOffset + 4
+		for i := 0; i < oldCount; i++ {
+			if len(*m) < currentOffset+4 {
+				break
+			}
+			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
+			oldDataSize += 4 + itemLen
+			currentOffset += 4 + itemLen
+		}
 	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+	newCount := len(v)
+	newDataSize := 4 // count
+	for _, item := range v {
+		newDataSize += 4 + len(item) // 4 bytes length + data
+	}
+	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
 		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
+		currentOffset := oldPayloadOffset + 4
+		for _, item := range v {
+			itemLen := len(item)
+			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
+			copy((*m)[currentOffset+4:], item)
+			currentOffset += 4 + itemLen
+		}
 		return nil
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp RatePlan
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+	// Need to remarshal: unmarshal, update, marshal, truncate to public-only
+	// Preserve reserved bytes (serviceID at bytes 5-9, methodID at bytes 9-13) from original buffer
+	var originalServiceID, originalMethodID uint32
+	if len(*m) >= 13 {
+		originalServiceID = binary.LittleEndian.Uint32((*m)[5:9])
+		originalMethodID = binary.LittleEndian.Uint32((*m)[9:13])
+	}
+	var temp CheckUserResult
+	// Create a fake complete buffer by appending a minimal private segment
+	// Calculate private table size
+	privateTableSize := 0                                    // bytes needed for empty private table
+	fakeComplete := make([]byte, len(*m)+1+privateTableSize) // version byte + private table
+	copy(fakeComplete, *m)
+	// Update offsetToPrivate to point to the appended private segment
+	binary.LittleEndian.PutUint32(fakeComplete[1:5], uint32(len(*m)))
+	fakeComplete[len(*m)] = 0x01 // private segment version
+	if err := temp.UnmarshalSymphony(fakeComplete); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.OutDate = v
-	newData, err := temp.MarshalSymphony()
+	temp.Hotels = v
+	fullData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = RatePlanRaw(newData)
+	// Restore reserved bytes (serviceID and methodID) in the marshaled payload
+	if len(fullData) >= 13 {
+		binary.LittleEndian.PutUint32(fullData[5:9], originalServiceID)
+		binary.LittleEndian.PutUint32(fullData[9:13], originalMethodID)
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(fullData[1:5]))
+	*m = CheckUserResultRaw(fullData[:offsetToPrivate])
 	return nil
 }
 
-func (m *RatePlanRaw) SetRoomType(v RoomTypeRaw) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter RoomType called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *CheckUserResultRaw) SetPasswordChanged(v int64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter PasswordChanged called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter RoomType called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 5 (RoomType): nested message
-	if len(*m) < offsetToPrivate+17+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+17:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldNestedSize int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldNestedSize = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
 	}
-	newNestedSize := len(v)
-	if oldPayloadOffset > 0 && newNestedSize <= oldNestedSize {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newNestedSize))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
-	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp RatePlan
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
-	}
-	if temp.RoomType == nil {
-		temp.RoomType = &RoomType{}
-	}
-	if err := temp.RoomType.UnmarshalSymphony([]byte(v)); err != nil {
-		return fmt.Errorf("failed to unmarshal nested message: %w", err)
-	}
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+	// Field 5 (PasswordChanged): fixed-length (8 bytes)
+	if len(*m) < 26+8 {
+		return fmt.Errorf("buffer too short")
 	}
-	*m = RatePlanRaw(newData)
+	binary.LittleEndian.PutUint64((*m)[26:], uint64(v))
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *RoomType) MarshalSymphonyPublic() ([]byte, error) {
+func (m *RegisterUserRequest) MarshalSymphonyPublic() ([]byte, error) {
 	return []byte{}, nil
 }
 
 // MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *RoomType) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *RegisterUserRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	size := 0
-	size += 36 // table
-	size += 4 + len(m.Code)
-	size += 4 + len(m.Currency)
-	size += 4 + len(m.RoomDescription)
+	size += 8 // table
+	size += 4 + len(m.Username)
+	size += 4 + len(m.Password)
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -6457,51 +14318,35 @@ func (m *RoomType) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 36
+	payloadStart := tableStart + 8
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (BookableRate): fixed-length (8 bytes)
-	binary.LittleEndian.PutUint64(buf[tableStart+0:], math.Float64bits(m.BookableRate))
-
-	// Field 2 (TotalRate): fixed-length (8 bytes)
-	binary.LittleEndian.PutUint64(buf[tableStart+8:], math.Float64bits(m.TotalRate))
-
-	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
-	binary.LittleEndian.PutUint64(buf[tableStart+16:], math.Float64bits(m.TotalRateInclusive))
-
-	// Field 4 (Code): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+24:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.Code)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.Code)
-	payloadOffset += 4 + len(m.Code)
-
-	// Field 5 (Currency): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+28:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.Currency)
+	// Field 1 (Username): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Username)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.Currency)
-	payloadOffset += 4 + len(m.Currency)
+	copy(buf[payloadStart+payloadOffset+4:], m.Username)
+	payloadOffset += 4 + len(m.Username)
 
-	// Field 6 (RoomDescription): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+32:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.RoomDescription)
+	// Field 2 (Password): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Password)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.RoomDescription)
-	payloadOffset += 4 + len(m.RoomDescription)
+	copy(buf[payloadStart+payloadOffset+4:], m.Password)
+	payloadOffset += 4 + len(m.Password)
 
 	return buf, nil
 }
 
 // UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *RoomType) UnmarshalSymphonyPublic(data []byte) error {
+func (m *RegisterUserRequest) UnmarshalSymphonyPublic(data []byte) error {
 	return nil
 }
 
 // UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *RoomType) UnmarshalSymphonyPrivate(data []byte) error {
+func (m *RegisterUserRequest) UnmarshalSymphonyPrivate(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -6513,53 +14358,24 @@ func (m *RoomType) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (BookableRate): fixed-length (8 bytes)
-	if len(data) < tableStart+8 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.BookableRate = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+0:]))
-
-	// Field 2 (TotalRate): fixed-length (8 bytes)
-	if len(data) < tableStart+16 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.TotalRate = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+8:]))
-
-	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
-	if len(data) < tableStart+24 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.TotalRateInclusive = math.Float64frombits(binary.LittleEndian.Uint64(data[tableStart+16:]))
-
-	// Field 4 (Code): variable-length
-	if len(data) >= tableStart+24+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+24:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Code = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 5 (Currency): variable-length
-	if len(data) >= tableStart+28+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+28:]))
+	// Field 1 (Username): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.Currency = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Username = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 6 (RoomDescription): variable-length
-	if len(data) >= tableStart+32+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+32:]))
+	// Field 2 (Password): variable-length
+	if len(data) >= tableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.RoomDescription = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Password = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
@@ -6567,20 +14383,18 @@ func (m *RoomType) UnmarshalSymphonyPrivate(data []byte) error {
 	return nil
 }
 
-func (m *RoomType) MarshalSymphony() ([]byte, error) {
+func (m *RegisterUserRequest) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
-	size += 1  // version byte
-	size += 12 // reserved: offset_to_private, service_name, method_name
-	// Private segment:
-	size += 1  // version byte
-	size += 36 // table entries
-	// Field 4 (Code): variable-length payload
-	size += 4 + len(m.Code) // 4 bytes length prefix + data
-	// Field 5 (Currency): variable-length payload
-	size += 4 + len(m.Currency) // 4 bytes length prefix + data
-	// Field 6 (RoomDescription): variable-length payload
-	size += 4 + len(m.RoomDescription) // 4 bytes length prefix + data
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	// Private segment:
+	size += 1 // version byte
+	size += 8 // table entries
+	// Field 1 (Username): variable-length payload
+	size += 4 + len(m.Username) // 4 bytes length prefix + data
+	// Field 2 (Password): variable-length payload
+	size += 4 + len(m.Password) // 4 bytes length prefix + data
 
 	buf := make([]byte, size)
 
@@ -6614,47 +14428,31 @@ func (m *RoomType) MarshalSymphony() ([]byte, error) {
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 36 bytes table
-	privatePayloadStart := privateTableStart + 36
+	privateTableStart := privateStart + 1 // 8 bytes table
+	privatePayloadStart := privateTableStart + 8
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (BookableRate): fixed-length (8 bytes)
-	binary.LittleEndian.PutUint64(buf[privateTableStart+0:], math.Float64bits(m.BookableRate))
-
-	// Field 2 (TotalRate): fixed-length (8 bytes)
-	binary.LittleEndian.PutUint64(buf[privateTableStart+8:], math.Float64bits(m.TotalRate))
-
-	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
-	binary.LittleEndian.PutUint64(buf[privateTableStart+16:], math.Float64bits(m.TotalRateInclusive))
-
-	// Field 4 (Code): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+24:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Code)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Code)
-	privatePayloadOffset += 4 + len(m.Code)
-
-	// Field 5 (Currency): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+28:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Currency)
+	// Field 1 (Username): variable-length
+	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
+	dataLen = len(m.Username)
 	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Currency)
-	privatePayloadOffset += 4 + len(m.Currency)
+	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Username)
+	privatePayloadOffset += 4 + len(m.Username)
 
-	// Field 6 (RoomDescription): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+32:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.RoomDescription)
+	// Field 2 (Password): variable-length
+	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
+	dataLen = len(m.Password)
 	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.RoomDescription)
-	privatePayloadOffset += 4 + len(m.RoomDescription)
+	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Password)
+	privatePayloadOffset += 4 + len(m.Password)
 
 	return buf, nil
 }
 
-func (m *RoomType) UnmarshalSymphony(data []byte) error {
+func (m *RegisterUserRequest) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -6690,62 +14488,30 @@ func (m *RoomType) UnmarshalSymphony(data []byte) error {
 	privateTableStart := offsetToPrivate + 1
 	_ = privateTableStart
 	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (BookableRate): fixed-length (8 bytes)
-	if len(data) < privateTableStart+8 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.BookableRate = math.Float64frombits(binary.LittleEndian.Uint64(data[privateTableStart+0:]))
-
-	// Field 2 (TotalRate): fixed-length (8 bytes)
-	if len(data) < privateTableStart+16 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.TotalRate = math.Float64frombits(binary.LittleEndian.Uint64(data[privateTableStart+8:]))
-
-	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
-	if len(data) < privateTableStart+24 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.TotalRateInclusive = math.Float64frombits(binary.LittleEndian.Uint64(data[privateTableStart+16:]))
-
-	// Field 4 (Code): variable-length
-	if len(data) >= privateTableStart+24+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+24:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Code = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
-	// Field 5 (Currency): variable-length
-	if len(data) >= privateTableStart+28+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+28:]))
+	// Field 1 (Username): variable-length
+	if len(data) >= privateTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
 		if payloadOffset > 0 {
 			payloadOffset += offsetToPrivate // convert relative offset to absolute
 		}
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.Currency = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Username = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 6 (RoomDescription): variable-length
-	if len(data) >= privateTableStart+32+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+32:]))
+	// Field 2 (Password): variable-length
+	if len(data) >= privateTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
 		if payloadOffset > 0 {
 			payloadOffset += offsetToPrivate // convert relative offset to absolute
 		}
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.RoomDescription = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Password = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
@@ -6753,113 +14519,21 @@ func (m *RoomType) UnmarshalSymphony(data []byte) error {
 	return nil
 }
 
-type RoomTypeRaw []byte
+type RegisterUserRequestRaw []byte
 
-func (m RoomTypeRaw) MarshalSymphony() ([]byte, error) {
+func (m RegisterUserRequestRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *RoomTypeRaw) UnmarshalSymphony(data []byte) error {
-	*m = RoomTypeRaw(data)
+func (m *RegisterUserRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = RegisterUserRequestRaw(data)
 	return nil
 }
 
-func (m RoomTypeRaw) GetBookableRate() float64 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter BookableRate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter BookableRate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (BookableRate): fixed-length (8 bytes)
-	if len(m) < offsetToPrivate+1+8 {
-		return 0
-	}
-	return math.Float64frombits(binary.LittleEndian.Uint64(m[offsetToPrivate+1:]))
-}
-
-func (m RoomTypeRaw) GetTotalRate() float64 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter TotalRate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter TotalRate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 2 (TotalRate): fixed-length (8 bytes)
-	if len(m) < offsetToPrivate+9+8 {
-		return 0
-	}
-	return math.Float64frombits(binary.LittleEndian.Uint64(m[offsetToPrivate+9:]))
-}
-
-func (m RoomTypeRaw) GetTotalRateInclusive() float64 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter TotalRateInclusive called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter TotalRateInclusive called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
-	if len(m) < offsetToPrivate+17+8 {
-		return 0
-	}
-	return math.Float64frombits(binary.LittleEndian.Uint64(m[offsetToPrivate+17:]))
-}
-
-func (m RoomTypeRaw) GetCode() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Code called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Code called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 4 (Code): variable-length
-	if len(m) < offsetToPrivate+25+4 {
-		return ""
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+25:]))
-	if payloadOffset == 0 {
-		return ""
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
-	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
-}
-
-func (m RoomTypeRaw) GetCurrency() string {
+func (m RegisterUserRequestRaw) GetUsername() string {
 	// ASSERT: Private field requires complete buffer
 	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Currency called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+		panic(fmt.Sprintf("private getter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
 	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
@@ -6867,13 +14541,13 @@ func (m RoomTypeRaw) GetCurrency() string {
 		if offsetToPrivate < len(m) {
 			marker = m[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private getter Currency called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+		panic(fmt.Sprintf("private getter Username called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
 	}
-	// Field 5 (Currency): variable-length
-	if len(m) < offsetToPrivate+29+4 {
+	// Field 1 (Username): variable-length
+	if len(m) < offsetToPrivate+1+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+29:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
 	if payloadOffset == 0 {
 		return ""
 	}
@@ -6888,10 +14562,10 @@ func (m RoomTypeRaw) GetCurrency() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m RoomTypeRaw) GetRoomDescription() string {
+func (m RegisterUserRequestRaw) GetPassword() string {
 	// ASSERT: Private field requires complete buffer
 	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter RoomDescription called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+		panic(fmt.Sprintf("private getter Password called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
 	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
@@ -6899,140 +14573,31 @@ func (m RoomTypeRaw) GetRoomDescription() string {
 		if offsetToPrivate < len(m) {
 			marker = m[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private getter RoomDescription called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+		panic(fmt.Sprintf("private getter Password called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
 	}
-	// Field 6 (RoomDescription): variable-length
-	if len(m) < offsetToPrivate+33+4 {
+	// Field 2 (Password): variable-length
+	if len(m) < offsetToPrivate+5+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+33:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
 	if payloadOffset == 0 {
 		return ""
 	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
-	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
-}
-
-func (m *RoomTypeRaw) SetBookableRate(v float64) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter BookableRate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter BookableRate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 1 (BookableRate): fixed-length (8 bytes)
-	if len(*m) < offsetToPrivate+1+8 {
-		return fmt.Errorf("buffer too short")
-	}
-	binary.LittleEndian.PutUint64((*m)[offsetToPrivate+1:], math.Float64bits(v))
-	return nil
-}
-
-func (m *RoomTypeRaw) SetTotalRate(v float64) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter TotalRate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter TotalRate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 2 (TotalRate): fixed-length (8 bytes)
-	if len(*m) < offsetToPrivate+9+8 {
-		return fmt.Errorf("buffer too short")
-	}
-	binary.LittleEndian.PutUint64((*m)[offsetToPrivate+9:], math.Float64bits(v))
-	return nil
-}
-
-func (m *RoomTypeRaw) SetTotalRateInclusive(v float64) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter TotalRateInclusive called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter TotalRateInclusive called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 3 (TotalRateInclusive): fixed-length (8 bytes)
-	if len(*m) < offsetToPrivate+17+8 {
-		return fmt.Errorf("buffer too short")
-	}
-	binary.LittleEndian.PutUint64((*m)[offsetToPrivate+17:], math.Float64bits(v))
-	return nil
-}
-
-func (m *RoomTypeRaw) SetCode(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Code called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter Code called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 4 (Code): variable-length
-	if len(*m) < offsetToPrivate+25+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+25:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
-	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp RoomType
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
+	payloadOffset += offsetToPrivate // convert relative offset to absolute
+	if len(m) < payloadOffset+4 {
+		return ""
 	}
-	temp.Code = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
+	if len(m) < payloadOffset+4+dataLen {
+		return ""
 	}
-	*m = RoomTypeRaw(newData)
-	return nil
+	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m *RoomTypeRaw) SetCurrency(v string) error {
+func (m *RegisterUserRequestRaw) SetUsername(v string) error {
 	// ASSERT: Private field setter requires complete buffer
 	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Currency called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+		panic(fmt.Sprintf("private setter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
 	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
@@ -7040,13 +14605,13 @@ func (m *RoomTypeRaw) SetCurrency(v string) error {
 		if offsetToPrivate < len(*m) {
 			marker = (*m)[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private setter Currency called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+		panic(fmt.Sprintf("private setter Username called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 5 (Currency): variable-length
-	if len(*m) < offsetToPrivate+29+4 {
+	// Field 1 (Username): variable-length
+	if len(*m) < offsetToPrivate+1+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+29:]))
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
 	if oldPayloadOffset > 0 {
 		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
 	}
@@ -7062,23 +14627,23 @@ func (m *RoomTypeRaw) SetCurrency(v string) error {
 		return nil
 	}
 	// Need to remarshal: unmarshal, update, marshal
-	var temp RoomType
+	var temp RegisterUserRequest
 	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.Currency = v
+	temp.Username = v
 	newData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = RoomTypeRaw(newData)
+	*m = RegisterUserRequestRaw(newData)
 	return nil
 }
 
-func (m *RoomTypeRaw) SetRoomDescription(v string) error {
+func (m *RegisterUserRequestRaw) SetPassword(v string) error {
 	// ASSERT: Private field setter requires complete buffer
 	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter RoomDescription called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+		panic(fmt.Sprintf("private setter Password called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
 	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
@@ -7086,13 +14651,13 @@ func (m *RoomTypeRaw) SetRoomDescription(v string) error {
 		if offsetToPrivate < len(*m) {
 			marker = (*m)[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private setter RoomDescription called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+		panic(fmt.Sprintf("private setter Password called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 6 (RoomDescription): variable-length
-	if len(*m) < offsetToPrivate+33+4 {
+	// Field 2 (Password): variable-length
+	if len(*m) < offsetToPrivate+5+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+33:]))
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+5:]))
 	if oldPayloadOffset > 0 {
 		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
 	}
@@ -7108,35 +14673,233 @@ func (m *RoomTypeRaw) SetRoomDescription(v string) error {
 		return nil
 	}
 	// Need to remarshal: unmarshal, update, marshal
-	var temp RoomType
+	var temp RegisterUserRequest
 	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.RoomDescription = v
+	temp.Password = v
 	newData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = RoomTypeRaw(newData)
+	*m = RegisterUserRequestRaw(newData)
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *ReservationRequest) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
+func (m *RegisterUserResult) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 1 // table
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 1
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (Registered): fixed-length (1 bytes)
+	if m.Registered {
+		buf[tableStart+0] = 1
+	} else {
+		buf[tableStart+0] = 0
+	}
+
+	return buf, nil
 }
 
 // MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *ReservationRequest) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *RegisterUserResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *RegisterUserResult) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (Registered): fixed-length (1 bytes)
+	if len(data) < tableStart+1 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Registered = data[tableStart+0] != 0
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *RegisterUserResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *RegisterUserResult) MarshalSymphony() ([]byte, error) {
 	size := 0
-	size += 20 // table
-	size += 4 + len(m.CustomerName)
-	size += 4 // count for HotelId
-	for _, item := range m.HotelId {
-		size += 4 + len(item)
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 1  // table entries
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 1 // field Registered
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 1
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (Registered): fixed-length (1 bytes)
+	if m.Registered {
+		buf[publicTableStart+0] = 1
+	} else {
+		buf[publicTableStart+0] = 0
 	}
-	size += 4 + len(m.InDate)
-	size += 4 + len(m.OutDate)
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *RegisterUserResult) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (Registered): fixed-length (1 bytes)
+	if len(data) < publicTableStart+1 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Registered = data[publicTableStart+0] != 0
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type RegisterUserResultRaw []byte
+
+func (m RegisterUserResultRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *RegisterUserResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = RegisterUserResultRaw(data)
+	return nil
+}
+
+func (m RegisterUserResultRaw) GetRegistered() bool {
+	// Field 1 (Registered): fixed-length (1 bytes)
+	if len(m) < 13+1 {
+		return false
+	}
+	return m[13] != 0
+}
+
+func (m *RegisterUserResultRaw) SetRegistered(v bool) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Registered called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 1 (Registered): fixed-length (1 bytes)
+	if len(*m) < 13+1 {
+		return fmt.Errorf("buffer too short")
+	}
+	if v {
+		(*m)[13] = 1
+	} else {
+		(*m)[13] = 0
+	}
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *ChangePasswordRequest) MarshalSymphonyPublic() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *ChangePasswordRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	size := 0
+	size += 12 // table
+	size += 4 + len(m.Username)
+	size += 4 + len(m.Password)
+	size += 4 + len(m.NewPassword)
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -7145,61 +14908,42 @@ func (m *ReservationRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 20
+	payloadStart := tableStart + 12
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (CustomerName): variable-length
+	// Field 1 (Username): variable-length
 	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.CustomerName)
+	dataLen = len(m.Username)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.CustomerName)
-	payloadOffset += 4 + len(m.CustomerName)
+	copy(buf[payloadStart+payloadOffset+4:], m.Username)
+	payloadOffset += 4 + len(m.Username)
 
-	// Field 2 (HotelId): repeated variable-length
+	// Field 2 (Password): variable-length
 	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
-	count = len(m.HotelId)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
-	currentOffset = payloadStart + payloadOffset + 4
-	for _, item := range m.HotelId {
-		itemLen := len(item)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
-		copy(buf[currentOffset+4:], item)
-		currentOffset += 4 + itemLen
-	}
-	payloadOffset += 4 // count
-	for _, item := range m.HotelId {
-		payloadOffset += 4 + len(item)
-	}
-
-	// Field 3 (InDate): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.InDate)
+	dataLen = len(m.Password)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.InDate)
-	payloadOffset += 4 + len(m.InDate)
+	copy(buf[payloadStart+payloadOffset+4:], m.Password)
+	payloadOffset += 4 + len(m.Password)
 
-	// Field 4 (OutDate): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+12:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.OutDate)
+	// Field 3 (NewPassword): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.NewPassword)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.OutDate)
-	payloadOffset += 4 + len(m.OutDate)
-
-	// Field 5 (RoomNumber): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[tableStart+16:], uint32(m.RoomNumber))
+	copy(buf[payloadStart+payloadOffset+4:], m.NewPassword)
+	payloadOffset += 4 + len(m.NewPassword)
 
 	return buf, nil
 }
 
 // UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *ReservationRequest) UnmarshalSymphonyPublic(data []byte) error {
+func (m *ChangePasswordRequest) UnmarshalSymphonyPublic(data []byte) error {
 	return nil
 }
 
 // UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *ReservationRequest) UnmarshalSymphonyPrivate(data []byte) error {
+func (m *ChangePasswordRequest) UnmarshalSymphonyPrivate(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -7211,86 +14955,56 @@ func (m *ReservationRequest) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (CustomerName): variable-length
+	// Field 1 (Username): variable-length
 	if len(data) >= tableStart+0+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.CustomerName = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Username = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 2 (HotelId): repeated variable-length
+	// Field 2 (Password): variable-length
 	if len(data) >= tableStart+4+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.HotelId = make([]string, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						m.HotelId = append(m.HotelId, string(data[currentOffset+4:currentOffset+4+itemLen]))
-						currentOffset += 4 + itemLen
-					}
-				}
-			}
-		}
-	}
-
-	// Field 3 (InDate): variable-length
-	if len(data) >= tableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Password = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 4 (OutDate): variable-length
-	if len(data) >= tableStart+12+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+12:]))
+	// Field 3 (NewPassword): variable-length
+	if len(data) >= tableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.NewPassword = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 5 (RoomNumber): fixed-length (4 bytes)
-	if len(data) < tableStart+20 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.RoomNumber = int32(binary.LittleEndian.Uint32(data[tableStart+16:]))
-
 	return nil
 }
 
-func (m *ReservationRequest) MarshalSymphony() ([]byte, error) {
+func (m *ChangePasswordRequest) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
 	// Private segment:
 	size += 1  // version byte
-	size += 20 // table entries
-	// Field 1 (CustomerName): variable-length payload
-	size += 4 + len(m.CustomerName) // 4 bytes length prefix + data
-	// Field 2 (HotelId): repeated variable-length payload
-	size += 4 // count
-	for _, item := range m.HotelId {
-		size += 4 + len(item) // 4 bytes length prefix + data
-	}
-	// Field 3 (InDate): variable-length payload
-	size += 4 + len(m.InDate) // 4 bytes length prefix + data
-	// Field 4 (OutDate): variable-length payload
-	size += 4 + len(m.OutDate) // 4 bytes length prefix + data
+	size += 12 // table entries
+	// Field 1 (Username): variable-length payload
+	size += 4 + len(m.Username) // 4 bytes length prefix + data
+	// Field 2 (Password): variable-length payload
+	size += 4 + len(m.Password) // 4 bytes length prefix + data
+	// Field 3 (NewPassword): variable-length payload
+	size += 4 + len(m.NewPassword) // 4 bytes length prefix + data
 
 	buf := make([]byte, size)
 
@@ -7324,57 +15038,38 @@ func (m *ReservationRequest) MarshalSymphony() ([]byte, error) {
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 20 bytes table
-	privatePayloadStart := privateTableStart + 20
+	privateTableStart := privateStart + 1 // 12 bytes table
+	privatePayloadStart := privateTableStart + 12
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (CustomerName): variable-length
+	// Field 1 (Username): variable-length
 	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.CustomerName)
+	dataLen = len(m.Username)
 	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.CustomerName)
-	privatePayloadOffset += 4 + len(m.CustomerName)
+	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Username)
+	privatePayloadOffset += 4 + len(m.Username)
 
-	// Field 2 (HotelId): repeated variable-length
+	// Field 2 (Password): variable-length
 	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	count = len(m.HotelId)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(count))
-	currentOffset = privatePayloadStart + privatePayloadOffset + 4
-	for _, item := range m.HotelId {
-		itemLen := len(item)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
-		copy(buf[currentOffset+4:], item)
-		currentOffset += 4 + itemLen
-	}
-	privatePayloadOffset += 4 // count
-	for _, item := range m.HotelId {
-		privatePayloadOffset += 4 + len(item)
-	}
-
-	// Field 3 (InDate): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+8:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.InDate)
+	dataLen = len(m.Password)
 	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.InDate)
-	privatePayloadOffset += 4 + len(m.InDate)
+	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Password)
+	privatePayloadOffset += 4 + len(m.Password)
 
-	// Field 4 (OutDate): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+12:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.OutDate)
+	// Field 3 (NewPassword): variable-length
+	binary.LittleEndian.PutUint32(buf[privateTableStart+8:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
+	dataLen = len(m.NewPassword)
 	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.OutDate)
-	privatePayloadOffset += 4 + len(m.OutDate)
-
-	// Field 5 (RoomNumber): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[privateTableStart+16:], uint32(m.RoomNumber))
+	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.NewPassword)
+	privatePayloadOffset += 4 + len(m.NewPassword)
 
 	return buf, nil
 }
 
-func (m *ReservationRequest) UnmarshalSymphony(data []byte) error {
+func (m *ChangePasswordRequest) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -7410,7 +15105,7 @@ func (m *ReservationRequest) UnmarshalSymphony(data []byte) error {
 	privateTableStart := offsetToPrivate + 1
 	_ = privateTableStart
 	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (CustomerName): variable-length
+	// Field 1 (Username): variable-length
 	if len(data) >= privateTableStart+0+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
 		if payloadOffset > 0 {
@@ -7419,85 +15114,57 @@ func (m *ReservationRequest) UnmarshalSymphony(data []byte) error {
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.CustomerName = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Username = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 2 (HotelId): repeated variable-length
+	// Field 2 (Password): variable-length
 	if len(data) >= privateTableStart+4+4 {
 		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
 		if payloadOffset > 0 {
 			payloadOffset += offsetToPrivate // convert relative offset to absolute
 		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.HotelId = make([]string, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						m.HotelId = append(m.HotelId, string(data[currentOffset+4:currentOffset+4+itemLen]))
-						currentOffset += 4 + itemLen
-					}
-				}
-			}
-		}
-	}
-
-	// Field 3 (InDate): variable-length
-	if len(data) >= privateTableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+8:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Password = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 4 (OutDate): variable-length
-	if len(data) >= privateTableStart+12+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+12:]))
+	// Field 3 (NewPassword): variable-length
+	if len(data) >= privateTableStart+8+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+8:]))
 		if payloadOffset > 0 {
 			payloadOffset += offsetToPrivate // convert relative offset to absolute
 		}
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.NewPassword = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 5 (RoomNumber): fixed-length (4 bytes)
-	if len(data) < privateTableStart+20 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.RoomNumber = int32(binary.LittleEndian.Uint32(data[privateTableStart+16:]))
-
 	return nil
 }
 
-type ReservationRequestRaw []byte
+type ChangePasswordRequestRaw []byte
 
-func (m ReservationRequestRaw) MarshalSymphony() ([]byte, error) {
+func (m ChangePasswordRequestRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *ReservationRequestRaw) UnmarshalSymphony(data []byte) error {
-	*m = ReservationRequestRaw(data)
+func (m *ChangePasswordRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = ChangePasswordRequestRaw(data)
 	return nil
 }
 
-func (m ReservationRequestRaw) GetCustomerName() string {
+func (m ChangePasswordRequestRaw) GetUsername() string {
 	// ASSERT: Private field requires complete buffer
 	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter CustomerName called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+		panic(fmt.Sprintf("private getter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
 	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
@@ -7505,9 +15172,9 @@ func (m ReservationRequestRaw) GetCustomerName() string {
 		if offsetToPrivate < len(m) {
 			marker = m[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private getter CustomerName called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+		panic(fmt.Sprintf("private getter Username called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
 	}
-	// Field 1 (CustomerName): variable-length
+	// Field 1 (Username): variable-length
 	if len(m) < offsetToPrivate+1+4 {
 		return ""
 	}
@@ -7526,10 +15193,10 @@ func (m ReservationRequestRaw) GetCustomerName() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m ReservationRequestRaw) GetHotelId() []string {
+func (m ChangePasswordRequestRaw) GetPassword() string {
 	// ASSERT: Private field requires complete buffer
 	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter HotelId called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+		panic(fmt.Sprintf("private getter Password called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
 	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
@@ -7537,55 +15204,13 @@ func (m ReservationRequestRaw) GetHotelId() []string {
 		if offsetToPrivate < len(m) {
 			marker = m[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private getter HotelId called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+		panic(fmt.Sprintf("private getter Password called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
 	}
-	// Field 2 (HotelId): repeated variable-length
+	// Field 2 (Password): variable-length
 	if len(m) < offsetToPrivate+5+4 {
-		return nil
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
-	if payloadOffset == 0 {
-		return nil
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return nil
-	}
-	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	result := make([]string, count)
-	currentOffset := payloadOffset + 4
-	for i := 0; i < count; i++ {
-		if len(m) < currentOffset+4 {
-			return nil
-		}
-		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
-		if len(m) < currentOffset+4+itemLen {
-			return nil
-		}
-		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
-		currentOffset += 4 + itemLen
-	}
-	return result
-}
-
-func (m ReservationRequestRaw) GetInDate() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter InDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter InDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 3 (InDate): variable-length
-	if len(m) < offsetToPrivate+9+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+9:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
 	if payloadOffset == 0 {
 		return ""
 	}
@@ -7600,10 +15225,10 @@ func (m ReservationRequestRaw) GetInDate() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m ReservationRequestRaw) GetOutDate() string {
+func (m ChangePasswordRequestRaw) GetNewPassword() string {
 	// ASSERT: Private field requires complete buffer
 	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter OutDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+		panic(fmt.Sprintf("private getter NewPassword called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
 	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
@@ -7611,13 +15236,13 @@ func (m ReservationRequestRaw) GetOutDate() string {
 		if offsetToPrivate < len(m) {
 			marker = m[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private getter OutDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+		panic(fmt.Sprintf("private getter NewPassword called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
 	}
-	// Field 4 (OutDate): variable-length
-	if len(m) < offsetToPrivate+13+4 {
+	// Field 3 (NewPassword): variable-length
+	if len(m) < offsetToPrivate+9+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+13:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+9:]))
 	if payloadOffset == 0 {
 		return ""
 	}
@@ -7632,76 +15257,10 @@ func (m ReservationRequestRaw) GetOutDate() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m ReservationRequestRaw) GetRoomNumber() int32 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter RoomNumber called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter RoomNumber called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 5 (RoomNumber): fixed-length (4 bytes)
-	if len(m) < offsetToPrivate+17+4 {
-		return 0
-	}
-	return int32(binary.LittleEndian.Uint32(m[offsetToPrivate+17:]))
-}
-
-func (m *ReservationRequestRaw) SetCustomerName(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter CustomerName called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter CustomerName called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 1 (CustomerName): variable-length
-	if len(*m) < offsetToPrivate+1+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
-	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp ReservationRequest
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
-	}
-	temp.CustomerName = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
-	}
-	*m = ReservationRequestRaw(newData)
-	return nil
-}
-
-func (m *ReservationRequestRaw) SetHotelId(v []string) error {
+func (m *ChangePasswordRequestRaw) SetUsername(v string) error {
 	// ASSERT: Private field setter requires complete buffer
 	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter HotelId called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+		panic(fmt.Sprintf("private setter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
 	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
@@ -7709,67 +15268,45 @@ func (m *ReservationRequestRaw) SetHotelId(v []string) error {
 		if offsetToPrivate < len(*m) {
 			marker = (*m)[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private setter HotelId called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+		panic(fmt.Sprintf("private setter Username called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 2 (HotelId): repeated variable-length
-	if len(*m) < offsetToPrivate+5+4 {
+	// Field 1 (Username): variable-length
+	if len(*m) < offsetToPrivate+1+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+5:]))
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
 	if oldPayloadOffset > 0 {
 		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
 	}
-	var oldCount int
-	var oldDataSize int
+	var oldDataLen int
 	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
-		oldDataSize = 4
-		currentOffset := oldPayloadOffset + 4
-		for i := 0; i < oldCount; i++ {
-			if len(*m) < currentOffset+4 {
-				break
-			}
-			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
-			oldDataSize += 4 + itemLen
-			currentOffset += 4 + itemLen
-		}
-	}
-	newCount := len(v)
-	newDataSize := 4 // count
-	for _, item := range v {
-		newDataSize += 4 + len(item) // 4 bytes length + data
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
 	}
-	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
 		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
-		currentOffset := oldPayloadOffset + 4
-		for _, item := range v {
-			itemLen := len(item)
-			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
-			copy((*m)[currentOffset+4:], item)
-			currentOffset += 4 + itemLen
-		}
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
 		return nil
 	}
 	// Need to remarshal: unmarshal, update, marshal
-	var temp ReservationRequest
+	var temp ChangePasswordRequest
 	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.HotelId = v
+	temp.Username = v
 	newData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = ReservationRequestRaw(newData)
+	*m = ChangePasswordRequestRaw(newData)
 	return nil
 }
 
-func (m *ReservationRequestRaw) SetInDate(v string) error {
+func (m *ChangePasswordRequestRaw) SetPassword(v string) error {
 	// ASSERT: Private field setter requires complete buffer
 	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter InDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+		panic(fmt.Sprintf("private setter Password called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
 	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
@@ -7777,13 +15314,13 @@ func (m *ReservationRequestRaw) SetInDate(v string) error {
 		if offsetToPrivate < len(*m) {
 			marker = (*m)[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private setter InDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+		panic(fmt.Sprintf("private setter Password called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 3 (InDate): variable-length
-	if len(*m) < offsetToPrivate+9+4 {
+	// Field 2 (Password): variable-length
+	if len(*m) < offsetToPrivate+5+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+9:]))
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+5:]))
 	if oldPayloadOffset > 0 {
 		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
 	}
@@ -7799,23 +15336,23 @@ func (m *ReservationRequestRaw) SetInDate(v string) error {
 		return nil
 	}
 	// Need to remarshal: unmarshal, update, marshal
-	var temp ReservationRequest
+	var temp ChangePasswordRequest
 	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.InDate = v
+	temp.Password = v
 	newData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = ReservationRequestRaw(newData)
+	*m = ChangePasswordRequestRaw(newData)
 	return nil
 }
 
-func (m *ReservationRequestRaw) SetOutDate(v string) error {
+func (m *ChangePasswordRequestRaw) SetNewPassword(v string) error {
 	// ASSERT: Private field setter requires complete buffer
 	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter OutDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+		panic(fmt.Sprintf("private setter NewPassword called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
 	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
@@ -7823,13 +15360,13 @@ func (m *ReservationRequestRaw) SetOutDate(v string) error {
 		if offsetToPrivate < len(*m) {
 			marker = (*m)[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private setter OutDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+		panic(fmt.Sprintf("private setter NewPassword called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 4 (OutDate): variable-length
-	if len(*m) < offsetToPrivate+13+4 {
+	// Field 3 (NewPassword): variable-length
+	if len(*m) < offsetToPrivate+9+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+13:]))
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+9:]))
 	if oldPayloadOffset > 0 {
 		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
 	}
@@ -7845,53 +15382,23 @@ func (m *ReservationRequestRaw) SetOutDate(v string) error {
 		return nil
 	}
 	// Need to remarshal: unmarshal, update, marshal
-	var temp ReservationRequest
+	var temp ChangePasswordRequest
 	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.OutDate = v
+	temp.NewPassword = v
 	newData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = ReservationRequestRaw(newData)
-	return nil
-}
-
-func (m *ReservationRequestRaw) SetRoomNumber(v int32) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter RoomNumber called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter RoomNumber called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 5 (RoomNumber): fixed-length (4 bytes)
-	if len(*m) < offsetToPrivate+17+4 {
-		return fmt.Errorf("buffer too short")
-	}
-	binary.LittleEndian.PutUint32((*m)[offsetToPrivate+17:], uint32(v))
+	*m = ChangePasswordRequestRaw(newData)
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *ReservationResult) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *ReservationResult) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *ChangePasswordResult) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
-	size += 4 // table
-	size += 4 // count for HotelId
-	for _, item := range m.HotelId {
-		size += 4 + len(item)
-	}
+	size += 5 // table
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -7900,37 +15407,31 @@ func (m *ReservationResult) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 4
+	payloadStart := tableStart + 5
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (HotelId): repeated variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	count = len(m.HotelId)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
-	currentOffset = payloadStart + payloadOffset + 4
-	for _, item := range m.HotelId {
-		itemLen := len(item)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
-		copy(buf[currentOffset+4:], item)
-		currentOffset += 4 + itemLen
-	}
-	payloadOffset += 4 // count
-	for _, item := range m.HotelId {
-		payloadOffset += 4 + len(item)
+	// Field 1 (Changed): fixed-length (1 bytes)
+	if m.Changed {
+		buf[tableStart+0] = 1
+	} else {
+		buf[tableStart+0] = 0
 	}
 
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+1:], uint32(m.RetryAfter))
+
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *ReservationResult) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *ChangePasswordResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *ReservationResult) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *ChangePasswordResult) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -7942,41 +15443,34 @@ func (m *ReservationResult) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (HotelId): repeated variable-length
-	if len(data) >= tableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.HotelId = make([]string, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						m.HotelId = append(m.HotelId, string(data[currentOffset+4:currentOffset+4+itemLen]))
-						currentOffset += 4 + itemLen
-					}
-				}
-			}
-		}
+	// Field 1 (Changed): fixed-length (1 bytes)
+	if len(data) < tableStart+1 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Changed = data[tableStart+0] != 0
+
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(data) < tableStart+5 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
+	m.RetryAfter = int32(binary.LittleEndian.Uint32(data[tableStart+1:]))
 
 	return nil
 }
 
-func (m *ReservationResult) MarshalSymphony() ([]byte, error) {
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *ChangePasswordResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *ChangePasswordResult) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 5  // table entries
 	// Private segment:
 	size += 1 // version byte
-	size += 4 // table entries
-	// Field 1 (HotelId): repeated variable-length payload
-	size += 4 // count
-	for _, item := range m.HotelId {
-		size += 4 + len(item) // 4 bytes length prefix + data
-	}
 
 	buf := make([]byte, size)
 
@@ -7992,6 +15486,8 @@ func (m *ReservationResult) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 1 // field Changed
+	publicSegmentSize += 4 // field RetryAfter
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -8000,43 +15496,37 @@ func (m *ReservationResult) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 5
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (Changed): fixed-length (1 bytes)
+	if m.Changed {
+		buf[publicTableStart+0] = 1
+	} else {
+		buf[publicTableStart+0] = 0
+	}
+
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+1:], uint32(m.RetryAfter))
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 4 bytes table
-	privatePayloadStart := privateTableStart + 4
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (HotelId): repeated variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	count = len(m.HotelId)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(count))
-	currentOffset = privatePayloadStart + privatePayloadOffset + 4
-	for _, item := range m.HotelId {
-		itemLen := len(item)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
-		copy(buf[currentOffset+4:], item)
-		currentOffset += 4 + itemLen
-	}
-	privatePayloadOffset += 4 // count
-	for _, item := range m.HotelId {
-		privatePayloadOffset += 4 + len(item)
-	}
-
 	return buf, nil
 }
 
-func (m *ReservationResult) UnmarshalSymphony(data []byte) error {
+func (m *ChangePasswordResult) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -8065,170 +15555,102 @@ func (m *ReservationResult) UnmarshalSymphony(data []byte) error {
 	currentOffset := 0
 	_ = currentOffset
 
-	// === PUBLIC FIELDS ===
-	publicTableStart := 13
-	_ = publicTableStart
-	// === PRIVATE FIELDS ===
-	privateTableStart := offsetToPrivate + 1
-	_ = privateTableStart
-	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (HotelId): repeated variable-length
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.HotelId = make([]string, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						m.HotelId = append(m.HotelId, string(data[currentOffset+4:currentOffset+4+itemLen]))
-						currentOffset += 4 + itemLen
-					}
-				}
-			}
-		}
-	}
-
-	return nil
-}
-
-type ReservationResultRaw []byte
-
-func (m ReservationResultRaw) MarshalSymphony() ([]byte, error) {
-	return []byte(m), nil
-}
-
-func (m *ReservationResultRaw) UnmarshalSymphony(data []byte) error {
-	*m = ReservationResultRaw(data)
-	return nil
-}
-
-func (m ReservationResultRaw) GetHotelId() []string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter HotelId called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter HotelId called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (HotelId): repeated variable-length
-	if len(m) < offsetToPrivate+1+4 {
-		return nil
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
-	if payloadOffset == 0 {
-		return nil
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return nil
-	}
-	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	result := make([]string, count)
-	currentOffset := payloadOffset + 4
-	for i := 0; i < count; i++ {
-		if len(m) < currentOffset+4 {
-			return nil
-		}
-		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
-		if len(m) < currentOffset+4+itemLen {
-			return nil
-		}
-		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
-		currentOffset += 4 + itemLen
-	}
-	return result
-}
-
-func (m *ReservationResultRaw) SetHotelId(v []string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter HotelId called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (Changed): fixed-length (1 bytes)
+	if len(data) < publicTableStart+1 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter HotelId called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+	m.Changed = data[publicTableStart+0] != 0
+
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(data) < publicTableStart+5 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
-	// Field 1 (HotelId): repeated variable-length
-	if len(*m) < offsetToPrivate+1+4 {
-		return fmt.Errorf("buffer too short for table entry")
+	m.RetryAfter = int32(binary.LittleEndian.Uint32(data[publicTableStart+1:]))
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type ChangePasswordResultRaw []byte
+
+func (m ChangePasswordResultRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *ChangePasswordResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = ChangePasswordResultRaw(data)
+	return nil
+}
+
+func (m ChangePasswordResultRaw) GetChanged() bool {
+	// Field 1 (Changed): fixed-length (1 bytes)
+	if len(m) < 13+1 {
+		return false
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
+	return m[13] != 0
+}
+
+func (m ChangePasswordResultRaw) GetRetryAfter() int32 {
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(m) < 14+4 {
+		return 0
 	}
-	var oldCount int
-	var oldDataSize int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
-		oldDataSize = 4
-		currentOffset := oldPayloadOffset + 4
-		for i := 0; i < oldCount; i++ {
-			if len(*m) < currentOffset+4 {
-				break
-			}
-			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
-			oldDataSize += 4 + itemLen
-			currentOffset += 4 + itemLen
+	return int32(binary.LittleEndian.Uint32(m[14:]))
+}
+
+func (m *ChangePasswordResultRaw) SetChanged(v bool) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Changed called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
 	}
-	newCount := len(v)
-	newDataSize := 4 // count
-	for _, item := range v {
-		newDataSize += 4 + len(item) // 4 bytes length + data
+	// Field 1 (Changed): fixed-length (1 bytes)
+	if len(*m) < 13+1 {
+		return fmt.Errorf("buffer too short")
 	}
-	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
-		currentOffset := oldPayloadOffset + 4
-		for _, item := range v {
-			itemLen := len(item)
-			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
-			copy((*m)[currentOffset+4:], item)
-			currentOffset += 4 + itemLen
-		}
-		return nil
+	if v {
+		(*m)[13] = 1
+	} else {
+		(*m)[13] = 0
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp ReservationResult
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
+	return nil
+}
+
+func (m *ChangePasswordResultRaw) SetRetryAfter(v int32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter RetryAfter called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
 	}
-	temp.HotelId = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(*m) < 14+4 {
+		return fmt.Errorf("buffer too short")
 	}
-	*m = ReservationResultRaw(newData)
+	binary.LittleEndian.PutUint32((*m)[14:], uint32(v))
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *SearchRequest) MarshalSymphonyPublic() ([]byte, error) {
+func (m *DeleteUserRequest) MarshalSymphonyPublic() ([]byte, error) {
 	return []byte{}, nil
 }
 
 // MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *SearchRequest) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *DeleteUserRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	size := 0
-	size += 16 // table
-	size += 4 + len(m.InDate)
-	size += 4 + len(m.OutDate)
+	size += 8 // table
+	size += 4 + len(m.Username)
+	size += 4 + len(m.Password)
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -8237,41 +15659,35 @@ func (m *SearchRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 16
+	payloadStart := tableStart + 8
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (Lat): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[tableStart+0:], math.Float32bits(m.Lat))
-
-	// Field 2 (Lon): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[tableStart+4:], math.Float32bits(m.Lon))
-
-	// Field 3 (InDate): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+8:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.InDate)
+	// Field 1 (Username): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Username)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.InDate)
-	payloadOffset += 4 + len(m.InDate)
+	copy(buf[payloadStart+payloadOffset+4:], m.Username)
+	payloadOffset += 4 + len(m.Username)
 
-	// Field 4 (OutDate): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+12:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.OutDate)
+	// Field 2 (Password): variable-length
+	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
+	dataLen = len(m.Password)
 	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.OutDate)
-	payloadOffset += 4 + len(m.OutDate)
+	copy(buf[payloadStart+payloadOffset+4:], m.Password)
+	payloadOffset += 4 + len(m.Password)
 
 	return buf, nil
 }
 
 // UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *SearchRequest) UnmarshalSymphonyPublic(data []byte) error {
+func (m *DeleteUserRequest) UnmarshalSymphonyPublic(data []byte) error {
 	return nil
 }
 
 // UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *SearchRequest) UnmarshalSymphonyPrivate(data []byte) error {
+func (m *DeleteUserRequest) UnmarshalSymphonyPrivate(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -8283,36 +15699,24 @@ func (m *SearchRequest) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (Lat): fixed-length (4 bytes)
-	if len(data) < tableStart+4 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[tableStart+0:]))
-
-	// Field 2 (Lon): fixed-length (4 bytes)
-	if len(data) < tableStart+8 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[tableStart+4:]))
-
-	// Field 3 (InDate): variable-length
-	if len(data) >= tableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+8:]))
+	// Field 1 (Username): variable-length
+	if len(data) >= tableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Username = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 4 (OutDate): variable-length
-	if len(data) >= tableStart+12+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+12:]))
+	// Field 2 (Password): variable-length
+	if len(data) >= tableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Password = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
@@ -8320,18 +15724,18 @@ func (m *SearchRequest) UnmarshalSymphonyPrivate(data []byte) error {
 	return nil
 }
 
-func (m *SearchRequest) MarshalSymphony() ([]byte, error) {
+func (m *DeleteUserRequest) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
 	// Private segment:
-	size += 1  // version byte
-	size += 16 // table entries
-	// Field 3 (InDate): variable-length payload
-	size += 4 + len(m.InDate) // 4 bytes length prefix + data
-	// Field 4 (OutDate): variable-length payload
-	size += 4 + len(m.OutDate) // 4 bytes length prefix + data
+	size += 1 // version byte
+	size += 8 // table entries
+	// Field 1 (Username): variable-length payload
+	size += 4 + len(m.Username) // 4 bytes length prefix + data
+	// Field 2 (Password): variable-length payload
+	size += 4 + len(m.Password) // 4 bytes length prefix + data
 
 	buf := make([]byte, size)
 
@@ -8365,37 +15769,31 @@ func (m *SearchRequest) MarshalSymphony() ([]byte, error) {
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 16 bytes table
-	privatePayloadStart := privateTableStart + 16
+	privateTableStart := privateStart + 1 // 8 bytes table
+	privatePayloadStart := privateTableStart + 8
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (Lat): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], math.Float32bits(m.Lat))
-
-	// Field 2 (Lon): fixed-length (4 bytes)
-	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], math.Float32bits(m.Lon))
-
-	// Field 3 (InDate): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+8:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.InDate)
+	// Field 1 (Username): variable-length
+	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
+	dataLen = len(m.Username)
 	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.InDate)
-	privatePayloadOffset += 4 + len(m.InDate)
+	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Username)
+	privatePayloadOffset += 4 + len(m.Username)
 
-	// Field 4 (OutDate): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+12:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.OutDate)
+	// Field 2 (Password): variable-length
+	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
+	dataLen = len(m.Password)
 	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.OutDate)
-	privatePayloadOffset += 4 + len(m.OutDate)
+	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Password)
+	privatePayloadOffset += 4 + len(m.Password)
 
 	return buf, nil
 }
 
-func (m *SearchRequest) UnmarshalSymphony(data []byte) error {
+func (m *DeleteUserRequest) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -8431,104 +15829,52 @@ func (m *SearchRequest) UnmarshalSymphony(data []byte) error {
 	privateTableStart := offsetToPrivate + 1
 	_ = privateTableStart
 	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (Lat): fixed-length (4 bytes)
-	if len(data) < privateTableStart+4 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-
-	// Field 2 (Lon): fixed-length (4 bytes)
-	if len(data) < privateTableStart+8 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Lon = math.Float32frombits(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
-
-	// Field 3 (InDate): variable-length
-	if len(data) >= privateTableStart+8+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+8:]))
+	// Field 1 (Username): variable-length
+	if len(data) >= privateTableStart+0+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
 		if payloadOffset > 0 {
 			payloadOffset += offsetToPrivate // convert relative offset to absolute
 		}
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.InDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Username = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	// Field 4 (OutDate): variable-length
-	if len(data) >= privateTableStart+12+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+12:]))
+	// Field 2 (Password): variable-length
+	if len(data) >= privateTableStart+4+4 {
+		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
 		if payloadOffset > 0 {
 			payloadOffset += offsetToPrivate // convert relative offset to absolute
 		}
 		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
 			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
 			if len(data) >= payloadOffset+4+dataLen {
-				m.OutDate = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
+				m.Password = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
 			}
 		}
 	}
 
-	return nil
-}
-
-type SearchRequestRaw []byte
-
-func (m SearchRequestRaw) MarshalSymphony() ([]byte, error) {
-	return []byte(m), nil
-}
-
-func (m *SearchRequestRaw) UnmarshalSymphony(data []byte) error {
-	*m = SearchRequestRaw(data)
-	return nil
-}
-
-func (m SearchRequestRaw) GetLat() float32 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Lat called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Lat called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (Lat): fixed-length (4 bytes)
-	if len(m) < offsetToPrivate+1+4 {
-		return 0
-	}
-	return math.Float32frombits(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
-}
-
-func (m SearchRequestRaw) GetLon() float32 {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Lon called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Lon called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 2 (Lon): fixed-length (4 bytes)
-	if len(m) < offsetToPrivate+5+4 {
-		return 0
-	}
-	return math.Float32frombits(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
+	return nil
 }
 
-func (m SearchRequestRaw) GetInDate() string {
+type DeleteUserRequestRaw []byte
+
+func (m DeleteUserRequestRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *DeleteUserRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = DeleteUserRequestRaw(data)
+	return nil
+}
+
+func (m DeleteUserRequestRaw) GetUsername() string {
 	// ASSERT: Private field requires complete buffer
 	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter InDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+		panic(fmt.Sprintf("private getter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
 	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
@@ -8536,13 +15882,13 @@ func (m SearchRequestRaw) GetInDate() string {
 		if offsetToPrivate < len(m) {
 			marker = m[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private getter InDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+		panic(fmt.Sprintf("private getter Username called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
 	}
-	// Field 3 (InDate): variable-length
-	if len(m) < offsetToPrivate+9+4 {
+	// Field 1 (Username): variable-length
+	if len(m) < offsetToPrivate+1+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+9:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
 	if payloadOffset == 0 {
 		return ""
 	}
@@ -8557,10 +15903,10 @@ func (m SearchRequestRaw) GetInDate() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m SearchRequestRaw) GetOutDate() string {
+func (m DeleteUserRequestRaw) GetPassword() string {
 	// ASSERT: Private field requires complete buffer
 	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter OutDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
+		panic(fmt.Sprintf("private getter Password called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
 	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
@@ -8568,13 +15914,13 @@ func (m SearchRequestRaw) GetOutDate() string {
 		if offsetToPrivate < len(m) {
 			marker = m[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private getter OutDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
+		panic(fmt.Sprintf("private getter Password called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
 	}
-	// Field 4 (OutDate): variable-length
-	if len(m) < offsetToPrivate+13+4 {
+	// Field 2 (Password): variable-length
+	if len(m) < offsetToPrivate+5+4 {
 		return ""
 	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+13:]))
+	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
 	if payloadOffset == 0 {
 		return ""
 	}
@@ -8589,10 +15935,10 @@ func (m SearchRequestRaw) GetOutDate() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m *SearchRequestRaw) SetLat(v float32) error {
+func (m *DeleteUserRequestRaw) SetUsername(v string) error {
 	// ASSERT: Private field setter requires complete buffer
 	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Lat called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+		panic(fmt.Sprintf("private setter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
 	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
@@ -8600,41 +15946,45 @@ func (m *SearchRequestRaw) SetLat(v float32) error {
 		if offsetToPrivate < len(*m) {
 			marker = (*m)[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private setter Lat called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+		panic(fmt.Sprintf("private setter Username called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 1 (Lat): fixed-length (4 bytes)
+	// Field 1 (Username): variable-length
 	if len(*m) < offsetToPrivate+1+4 {
-		return fmt.Errorf("buffer too short")
+		return fmt.Errorf("buffer too short for table entry")
 	}
-	binary.LittleEndian.PutUint32((*m)[offsetToPrivate+1:], math.Float32bits(v))
-	return nil
-}
-
-func (m *SearchRequestRaw) SetLon(v float32) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Lon called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
+	if oldPayloadOffset > 0 {
+		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter Lon called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+	var oldDataLen int
+	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
+		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
 	}
-	// Field 2 (Lon): fixed-length (4 bytes)
-	if len(*m) < offsetToPrivate+5+4 {
-		return fmt.Errorf("buffer too short")
+	newDataLen := len(v)
+	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
+		// Update in-place (waste space)
+		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
+		copy((*m)[oldPayloadOffset+4:], v)
+		return nil
+	}
+	// Need to remarshal: unmarshal, update, marshal
+	var temp DeleteUserRequest
+	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	temp.Username = v
+	newData, err := temp.MarshalSymphony()
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	binary.LittleEndian.PutUint32((*m)[offsetToPrivate+5:], math.Float32bits(v))
+	*m = DeleteUserRequestRaw(newData)
 	return nil
 }
 
-func (m *SearchRequestRaw) SetInDate(v string) error {
+func (m *DeleteUserRequestRaw) SetPassword(v string) error {
 	// ASSERT: Private field setter requires complete buffer
 	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter InDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+		panic(fmt.Sprintf("private setter Password called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
 	}
 	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
 	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
@@ -8642,13 +15992,13 @@ func (m *SearchRequestRaw) SetInDate(v string) error {
 		if offsetToPrivate < len(*m) {
 			marker = (*m)[offsetToPrivate]
 		}
-		panic(fmt.Sprintf("private setter InDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+		panic(fmt.Sprintf("private setter Password called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 3 (InDate): variable-length
-	if len(*m) < offsetToPrivate+9+4 {
+	// Field 2 (Password): variable-length
+	if len(*m) < offsetToPrivate+5+4 {
 		return fmt.Errorf("buffer too short for table entry")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+9:]))
+	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+5:]))
 	if oldPayloadOffset > 0 {
 		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
 	}
@@ -8664,78 +16014,324 @@ func (m *SearchRequestRaw) SetInDate(v string) error {
 		return nil
 	}
 	// Need to remarshal: unmarshal, update, marshal
-	var temp SearchRequest
+	var temp DeleteUserRequest
 	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
-	temp.InDate = v
+	temp.Password = v
 	newData, err := temp.MarshalSymphony()
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = SearchRequestRaw(newData)
+	*m = DeleteUserRequestRaw(newData)
 	return nil
 }
 
-func (m *SearchRequestRaw) SetOutDate(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter OutDate called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *DeleteUserResult) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 5 // table
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 5
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 1 (Deleted): fixed-length (1 bytes)
+	if m.Deleted {
+		buf[tableStart+0] = 1
+	} else {
+		buf[tableStart+0] = 0
 	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter OutDate called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
+
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+1:], uint32(m.RetryAfter))
+
+	return buf, nil
+}
+
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *DeleteUserResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *DeleteUserResult) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 1 (Deleted): fixed-length (1 bytes)
+	if len(data) < tableStart+1 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
-	// Field 4 (OutDate): variable-length
-	if len(*m) < offsetToPrivate+13+4 {
-		return fmt.Errorf("buffer too short for table entry")
+	m.Deleted = data[tableStart+0] != 0
+
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(data) < tableStart+5 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+13:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
+	m.RetryAfter = int32(binary.LittleEndian.Uint32(data[tableStart+1:]))
+
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *DeleteUserResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *DeleteUserResult) MarshalSymphony() ([]byte, error) {
+	size := 0
+	// Public segment:
+	size += 1  // version byte
+	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 5  // table entries
+	// Private segment:
+	size += 1 // version byte
+
+	buf := make([]byte, size)
+
+	dataLen := 0 // avoid no new variables warning
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC SEGMENT ===
+	buf[0] = 0x01 // version byte
+
+	// Calculate offset to private segment
+	publicSegmentSize := 13
+	publicSegmentSize += 1 // field Deleted
+	publicSegmentSize += 4 // field RetryAfter
+
+	// Write reserved header
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
+	binary.LittleEndian.PutUint32(buf[5:9], 0)                         // service_id
+	binary.LittleEndian.PutUint32(buf[9:13], 0)                        // method_id
+
+	// Write public fields
+	publicTableStart := 13
+	publicPayloadStart := publicTableStart + 5
+	publicPayloadOffset := 0
+	_ = publicPayloadStart
+	_ = publicPayloadOffset
+
+	// Field 1 (Deleted): fixed-length (1 bytes)
+	if m.Deleted {
+		buf[publicTableStart+0] = 1
+	} else {
+		buf[publicTableStart+0] = 0
 	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
+
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+1:], uint32(m.RetryAfter))
+
+	// === PRIVATE SEGMENT ===
+	privateStart := publicSegmentSize
+	buf[privateStart] = 0x01 // version byte
+
+	// Write private fields
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
+	privatePayloadOffset := 0
+	_ = privatePayloadStart
+	_ = privatePayloadOffset
+
+	// Private segment offsets are stored relative to privateStart
+	return buf, nil
+}
+
+func (m *DeleteUserResult) UnmarshalSymphony(data []byte) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+
+	// Validate public segment version
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+
+	// Read reserved header
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	// service_name := binary.LittleEndian.Uint32(data[5:9])  // not used yet
+	// method_name := binary.LittleEndian.Uint32(data[9:13])  // not used yet
+
+	// Assert private segment exists
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+
+	// === PUBLIC FIELDS ===
+	publicTableStart := 13
+	_ = publicTableStart
+	// Field 1 (Deleted): fixed-length (1 bytes)
+	if len(data) < publicTableStart+1 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Deleted = data[publicTableStart+0] != 0
+
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(data) < publicTableStart+5 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.RetryAfter = int32(binary.LittleEndian.Uint32(data[publicTableStart+1:]))
+
+	// === PRIVATE FIELDS ===
+	privateTableStart := offsetToPrivate + 1
+	_ = privateTableStart
+	// Private segment offsets are relative to offsetToPrivate
+	return nil
+}
+
+type DeleteUserResultRaw []byte
+
+func (m DeleteUserResultRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *DeleteUserResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = DeleteUserResultRaw(data)
+	return nil
+}
+
+func (m DeleteUserResultRaw) GetDeleted() bool {
+	// Field 1 (Deleted): fixed-length (1 bytes)
+	if len(m) < 13+1 {
+		return false
+	}
+	return m[13] != 0
+}
+
+func (m DeleteUserResultRaw) GetRetryAfter() int32 {
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(m) < 14+4 {
+		return 0
+	}
+	return int32(binary.LittleEndian.Uint32(m[14:]))
+}
+
+func (m *DeleteUserResultRaw) SetDeleted(v bool) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Deleted called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
 	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
+	// Field 1 (Deleted): fixed-length (1 bytes)
+	if len(*m) < 13+1 {
+		return fmt.Errorf("buffer too short")
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp SearchRequest
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
+	if v {
+		(*m)[13] = 1
+	} else {
+		(*m)[13] = 0
 	}
-	temp.OutDate = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+	return nil
+}
+
+func (m *DeleteUserResultRaw) SetRetryAfter(v int32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter RetryAfter called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
+	}
+	// Field 2 (RetryAfter): fixed-length (4 bytes)
+	if len(*m) < 14+4 {
+		return fmt.Errorf("buffer too short")
 	}
-	*m = SearchRequestRaw(newData)
+	binary.LittleEndian.PutUint32((*m)[14:], uint32(v))
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *SearchResult) MarshalSymphonyPublic() ([]byte, error) {
+func (m *ReloadUsersRequest) MarshalSymphonyPublic() ([]byte, error) {
 	return []byte{}, nil
 }
 
 // MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *SearchResult) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *ReloadUsersRequest) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *ReloadUsersRequest) UnmarshalSymphonyPublic(data []byte) error {
+	return nil
+}
+
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *ReloadUsersRequest) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *ReloadUsersRequest) MarshalSymphony() ([]byte, error) {
+	// Empty message - public segment with header only, empty private segment
+	buf := make([]byte, 14)                     // 1 version + 12 reserved + 1 version for private
+	buf[0] = 0x01                               // public version
+	binary.LittleEndian.PutUint32(buf[1:5], 13) // offset_to_private
+	// service_name and method_name stay 0
+	buf[13] = 0x01 // private version
+	return buf, nil
+}
+
+func (m *ReloadUsersRequest) UnmarshalSymphony(data []byte) error {
+	// Empty message - just validate version bytes
+	if len(data) < 14 {
+		return fmt.Errorf("invalid data: too short")
+	}
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+	return nil
+}
+
+type ReloadUsersRequestRaw []byte
+
+func (m ReloadUsersRequestRaw) MarshalSymphony() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func (m *ReloadUsersRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = ReloadUsersRequestRaw(data)
+	return nil
+}
+
+// MarshalSymphonyPublic marshals only the public fields (without header)
+func (m *ReloadUsersResult) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
 	size += 4 // table
-	size += 4 // count for HotelIds
-	for _, item := range m.HotelIds {
-		size += 4 + len(item)
-	}
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -8749,32 +16345,19 @@ func (m *SearchResult) MarshalSymphonyPrivate() ([]byte, error) {
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (HotelIds): repeated variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(payloadStart+payloadOffset))
-	count = len(m.HotelIds)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(count))
-	currentOffset = payloadStart + payloadOffset + 4
-	for _, item := range m.HotelIds {
-		itemLen := len(item)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
-		copy(buf[currentOffset+4:], item)
-		currentOffset += 4 + itemLen
-	}
-	payloadOffset += 4 // count
-	for _, item := range m.HotelIds {
-		payloadOffset += 4 + len(item)
-	}
+	// Field 1 (Count): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[tableStart+0:], uint32(m.Count))
 
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *SearchResult) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *ReloadUsersResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *SearchResult) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *ReloadUsersResult) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -8786,41 +16369,28 @@ func (m *SearchResult) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (HotelIds): repeated variable-length
-	if len(data) >= tableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+0:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.HotelIds = make([]string, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
-						currentOffset += 4 + itemLen
-					}
-				}
-			}
-		}
+	// Field 1 (Count): fixed-length (4 bytes)
+	if len(data) < tableStart+4 {
+		return fmt.Errorf("invalid data: too short for field")
 	}
+	m.Count = int32(binary.LittleEndian.Uint32(data[tableStart+0:]))
 
 	return nil
 }
 
-func (m *SearchResult) MarshalSymphony() ([]byte, error) {
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *ReloadUsersResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *ReloadUsersResult) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 4  // table entries
 	// Private segment:
 	size += 1 // version byte
-	size += 4 // table entries
-	// Field 1 (HotelIds): repeated variable-length payload
-	size += 4 // count
-	for _, item := range m.HotelIds {
-		size += 4 + len(item) // 4 bytes length prefix + data
-	}
 
 	buf := make([]byte, size)
 
@@ -8836,6 +16406,7 @@ func (m *SearchResult) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 4 // field Count
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -8844,43 +16415,30 @@ func (m *SearchResult) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 4
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (Count): fixed-length (4 bytes)
+	binary.LittleEndian.PutUint32(buf[publicTableStart+0:], uint32(m.Count))
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 4 bytes table
-	privatePayloadStart := privateTableStart + 4
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (HotelIds): repeated variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+0:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	count = len(m.HotelIds)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(count))
-	currentOffset = privatePayloadStart + privatePayloadOffset + 4
-	for _, item := range m.HotelIds {
-		itemLen := len(item)
-		binary.LittleEndian.PutUint32(buf[currentOffset:], uint32(itemLen))
-		copy(buf[currentOffset+4:], item)
-		currentOffset += 4 + itemLen
-	}
-	privatePayloadOffset += 4 // count
-	for _, item := range m.HotelIds {
-		privatePayloadOffset += 4 + len(item)
-	}
-
 	return buf, nil
 }
 
-func (m *SearchResult) UnmarshalSymphony(data []byte) error {
+func (m *ReloadUsersResult) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -8912,167 +16470,82 @@ func (m *SearchResult) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
+	// Field 1 (Count): fixed-length (4 bytes)
+	if len(data) < publicTableStart+4 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Count = int32(binary.LittleEndian.Uint32(data[publicTableStart+0:]))
+
 	// === PRIVATE FIELDS ===
 	privateTableStart := offsetToPrivate + 1
 	_ = privateTableStart
 	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (HotelIds): repeated variable-length
-	if len(data) >= privateTableStart+0+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+0:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			count = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			m.HotelIds = make([]string, 0, count)
-			currentOffset = payloadOffset + 4
-			for i := 0; i < count; i++ {
-				if len(data) >= currentOffset+4 {
-					itemLen := int(binary.LittleEndian.Uint32(data[currentOffset:]))
-					if len(data) >= currentOffset+4+itemLen {
-						m.HotelIds = append(m.HotelIds, string(data[currentOffset+4:currentOffset+4+itemLen]))
-						currentOffset += 4 + itemLen
-					}
-				}
-			}
-		}
-	}
-
 	return nil
 }
 
-type SearchResultRaw []byte
+type ReloadUsersResultRaw []byte
 
-func (m SearchResultRaw) MarshalSymphony() ([]byte, error) {
+func (m ReloadUsersResultRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *SearchResultRaw) UnmarshalSymphony(data []byte) error {
-	*m = SearchResultRaw(data)
+func (m *ReloadUsersResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = ReloadUsersResultRaw(data)
 	return nil
-}
-
-func (m SearchResultRaw) GetHotelIds() []string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter HotelIds called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter HotelIds called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (HotelIds): repeated variable-length
-	if len(m) < offsetToPrivate+1+4 {
-		return nil
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+1:]))
-	if payloadOffset == 0 {
-		return nil
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return nil
-	}
-	count := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	result := make([]string, count)
-	currentOffset := payloadOffset + 4
-	for i := 0; i < count; i++ {
-		if len(m) < currentOffset+4 {
-			return nil
-		}
-		itemLen := int(binary.LittleEndian.Uint32(m[currentOffset:]))
-		if len(m) < currentOffset+4+itemLen {
-			return nil
-		}
-		result[i] = string(m[currentOffset+4 : currentOffset+4+itemLen])
-		currentOffset += 4 + itemLen
-	}
-	return result
-}
-
-func (m *SearchResultRaw) SetHotelIds(v []string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter HotelIds called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private setter HotelIds called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
-	}
-	// Field 1 (HotelIds): repeated variable-length
-	if len(*m) < offsetToPrivate+1+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+1:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldCount int
-	var oldDataSize int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldCount = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-		// Calculate old data size: 4 bytes count + for each item: 4 bytes length + data
-		oldDataSize = 4
-		currentOffset := oldPayloadOffset + 4
-		for i := 0; i < oldCount; i++ {
-			if len(*m) < currentOffset+4 {
-				break
-			}
-			itemLen := int(binary.LittleEndian.Uint32((*m)[currentOffset:]))
-			oldDataSize += 4 + itemLen
-			currentOffset += 4 + itemLen
-		}
-	}
-	newCount := len(v)
-	newDataSize := 4 // count
-	for _, item := range v {
-		newDataSize += 4 + len(item) // 4 bytes length + data
-	}
-	if oldPayloadOffset > 0 && newDataSize <= oldDataSize {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newCount))
-		currentOffset := oldPayloadOffset + 4
-		for _, item := range v {
-			itemLen := len(item)
-			binary.LittleEndian.PutUint32((*m)[currentOffset:], uint32(itemLen))
-			copy((*m)[currentOffset+4:], item)
-			currentOffset += 4 + itemLen
-		}
-		return nil
+}
+
+func (m ReloadUsersResultRaw) GetCount() int32 {
+	// Field 1 (Count): fixed-length (4 bytes)
+	if len(m) < 13+4 {
+		return 0
 	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp SearchResult
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
+	return int32(binary.LittleEndian.Uint32(m[13:]))
+}
+
+func (m *ReloadUsersResultRaw) SetCount(v int32) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Count called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
+		}
 	}
-	temp.HotelIds = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+	// Field 1 (Count): fixed-length (4 bytes)
+	if len(*m) < 13+4 {
+		return fmt.Errorf("buffer too short")
 	}
-	*m = SearchResultRaw(newData)
+	binary.LittleEndian.PutUint32((*m)[13:], uint32(v))
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *CheckUserRequest) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
+func (m *CheckSessionRequest) MarshalSymphonyPublic() ([]byte, error) {
+	size := 0
+	size += 8 // table
+	buf := make([]byte, size)
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	payloadStart := tableStart + 8
+	payloadOffset := 0
+	_ = payloadStart
+	_ = payloadOffset
+
+	// Field 2 (PasswordChanged): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[tableStart+0:], uint64(m.PasswordChanged))
+
+	return buf, nil
 }
 
 // MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *CheckUserRequest) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *CheckSessionRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	size := 0
-	size += 8 // table
+	size += 4 // table
 	size += 4 + len(m.Username)
-	size += 4 + len(m.Password)
 	buf := make([]byte, size)
 	dataLen := 0
 	_ = dataLen
@@ -9081,7 +16554,7 @@ func (m *CheckUserRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	currentOffset := 0
 	_ = currentOffset
 	tableStart := 0
-	payloadStart := tableStart + 8
+	payloadStart := tableStart + 4
 	payloadOffset := 0
 	_ = payloadStart
 	_ = payloadOffset
@@ -9093,23 +16566,33 @@ func (m *CheckUserRequest) MarshalSymphonyPrivate() ([]byte, error) {
 	copy(buf[payloadStart+payloadOffset+4:], m.Username)
 	payloadOffset += 4 + len(m.Username)
 
-	// Field 2 (Password): variable-length
-	binary.LittleEndian.PutUint32(buf[tableStart+4:], uint32(payloadStart+payloadOffset))
-	dataLen = len(m.Password)
-	binary.LittleEndian.PutUint32(buf[payloadStart+payloadOffset:], uint32(dataLen))
-	copy(buf[payloadStart+payloadOffset+4:], m.Password)
-	payloadOffset += 4 + len(m.Password)
-
 	return buf, nil
 }
 
 // UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *CheckUserRequest) UnmarshalSymphonyPublic(data []byte) error {
+func (m *CheckSessionRequest) UnmarshalSymphonyPublic(data []byte) error {
+	payloadOffset := 0
+	_ = payloadOffset
+	dataLen := 0
+	_ = dataLen
+	count := 0
+	_ = count
+	currentOffset := 0
+	_ = currentOffset
+	tableStart := 0
+	_ = tableStart
+
+	// Field 2 (PasswordChanged): fixed-length (8 bytes)
+	if len(data) < tableStart+8 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.PasswordChanged = int64(binary.LittleEndian.Uint64(data[tableStart+0:]))
+
 	return nil
 }
 
 // UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *CheckUserRequest) UnmarshalSymphonyPrivate(data []byte) error {
+func (m *CheckSessionRequest) UnmarshalSymphonyPrivate(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -9132,32 +16615,20 @@ func (m *CheckUserRequest) UnmarshalSymphonyPrivate(data []byte) error {
 		}
 	}
 
-	// Field 2 (Password): variable-length
-	if len(data) >= tableStart+4+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[tableStart+4:]))
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Password = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
 	return nil
 }
 
-func (m *CheckUserRequest) MarshalSymphony() ([]byte, error) {
+func (m *CheckSessionRequest) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 8  // table entries
 	// Private segment:
 	size += 1 // version byte
-	size += 8 // table entries
+	size += 4 // table entries
 	// Field 1 (Username): variable-length payload
 	size += 4 + len(m.Username) // 4 bytes length prefix + data
-	// Field 2 (Password): variable-length payload
-	size += 4 + len(m.Password) // 4 bytes length prefix + data
 
 	buf := make([]byte, size)
 
@@ -9173,6 +16644,7 @@ func (m *CheckUserRequest) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 8 // field PasswordChanged
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -9181,18 +16653,21 @@ func (m *CheckUserRequest) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 8
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 2 (PasswordChanged): fixed-length (8 bytes)
+	binary.LittleEndian.PutUint64(buf[publicTableStart+0:], uint64(m.PasswordChanged))
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 8 bytes table
-	privatePayloadStart := privateTableStart + 8
+	privateTableStart := privateStart + 1 // 4 bytes table
+	privatePayloadStart := privateTableStart + 4
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
@@ -9205,17 +16680,10 @@ func (m *CheckUserRequest) MarshalSymphony() ([]byte, error) {
 	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Username)
 	privatePayloadOffset += 4 + len(m.Username)
 
-	// Field 2 (Password): variable-length
-	binary.LittleEndian.PutUint32(buf[privateTableStart+4:], uint32((privatePayloadStart+privatePayloadOffset)-privateStart))
-	dataLen = len(m.Password)
-	binary.LittleEndian.PutUint32(buf[privatePayloadStart+privatePayloadOffset:], uint32(dataLen))
-	copy(buf[privatePayloadStart+privatePayloadOffset+4:], m.Password)
-	privatePayloadOffset += 4 + len(m.Password)
-
 	return buf, nil
 }
 
-func (m *CheckUserRequest) UnmarshalSymphony(data []byte) error {
+func (m *CheckSessionRequest) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -9247,6 +16715,12 @@ func (m *CheckUserRequest) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
+	// Field 2 (PasswordChanged): fixed-length (8 bytes)
+	if len(data) < publicTableStart+8 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.PasswordChanged = int64(binary.LittleEndian.Uint64(data[publicTableStart+0:]))
+
 	// === PRIVATE FIELDS ===
 	privateTableStart := offsetToPrivate + 1
 	_ = privateTableStart
@@ -9265,35 +16739,21 @@ func (m *CheckUserRequest) UnmarshalSymphony(data []byte) error {
 		}
 	}
 
-	// Field 2 (Password): variable-length
-	if len(data) >= privateTableStart+4+4 {
-		payloadOffset = int(binary.LittleEndian.Uint32(data[privateTableStart+4:]))
-		if payloadOffset > 0 {
-			payloadOffset += offsetToPrivate // convert relative offset to absolute
-		}
-		if payloadOffset > 0 && len(data) >= payloadOffset+4 {
-			dataLen = int(binary.LittleEndian.Uint32(data[payloadOffset:]))
-			if len(data) >= payloadOffset+4+dataLen {
-				m.Password = string(data[payloadOffset+4 : payloadOffset+4+dataLen])
-			}
-		}
-	}
-
 	return nil
 }
 
-type CheckUserRequestRaw []byte
+type CheckSessionRequestRaw []byte
 
-func (m CheckUserRequestRaw) MarshalSymphony() ([]byte, error) {
+func (m CheckSessionRequestRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *CheckUserRequestRaw) UnmarshalSymphony(data []byte) error {
-	*m = CheckUserRequestRaw(data)
+func (m *CheckSessionRequestRaw) UnmarshalSymphony(data []byte) error {
+	*m = CheckSessionRequestRaw(data)
 	return nil
 }
 
-func (m CheckUserRequestRaw) GetUsername() string {
+func (m CheckSessionRequestRaw) GetUsername() string {
 	// ASSERT: Private field requires complete buffer
 	if len(m) < 5 {
 		panic(fmt.Sprintf("private getter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
@@ -9325,39 +16785,15 @@ func (m CheckUserRequestRaw) GetUsername() string {
 	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
 }
 
-func (m CheckUserRequestRaw) GetPassword() string {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Password called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Password called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 2 (Password): variable-length
-	if len(m) < offsetToPrivate+5+4 {
-		return ""
-	}
-	payloadOffset := int(binary.LittleEndian.Uint32(m[offsetToPrivate+5:]))
-	if payloadOffset == 0 {
-		return ""
-	}
-	payloadOffset += offsetToPrivate // convert relative offset to absolute
-	if len(m) < payloadOffset+4 {
-		return ""
-	}
-	dataLen := int(binary.LittleEndian.Uint32(m[payloadOffset:]))
-	if len(m) < payloadOffset+4+dataLen {
-		return ""
+func (m CheckSessionRequestRaw) GetPasswordChanged() int64 {
+	// Field 2 (PasswordChanged): fixed-length (8 bytes)
+	if len(m) < 13+8 {
+		return 0
 	}
-	return string(m[payloadOffset+4 : payloadOffset+4+dataLen])
+	return int64(binary.LittleEndian.Uint64(m[13:]))
 }
 
-func (m *CheckUserRequestRaw) SetUsername(v string) error {
+func (m *CheckSessionRequestRaw) SetUsername(v string) error {
 	// ASSERT: Private field setter requires complete buffer
 	if len(*m) < 5 {
 		panic(fmt.Sprintf("private setter Username called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
@@ -9390,7 +16826,7 @@ func (m *CheckUserRequestRaw) SetUsername(v string) error {
 		return nil
 	}
 	// Need to remarshal: unmarshal, update, marshal
-	var temp CheckUserRequest
+	var temp CheckSessionRequest
 	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
 		return fmt.Errorf("failed to unmarshal: %w", err)
 	}
@@ -9399,63 +16835,28 @@ func (m *CheckUserRequestRaw) SetUsername(v string) error {
 	if err != nil {
 		return fmt.Errorf("failed to marshal: %w", err)
 	}
-	*m = CheckUserRequestRaw(newData)
+	*m = CheckSessionRequestRaw(newData)
 	return nil
 }
 
-func (m *CheckUserRequestRaw) SetPassword(v string) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Password called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *CheckSessionRequestRaw) SetPasswordChanged(v int64) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter PasswordChanged called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Password called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 2 (Password): variable-length
-	if len(*m) < offsetToPrivate+5+4 {
-		return fmt.Errorf("buffer too short for table entry")
-	}
-	oldPayloadOffset := int(binary.LittleEndian.Uint32((*m)[offsetToPrivate+5:]))
-	if oldPayloadOffset > 0 {
-		oldPayloadOffset += offsetToPrivate // convert relative offset to absolute
-	}
-	var oldDataLen int
-	if oldPayloadOffset > 0 && len(*m) >= oldPayloadOffset+4 {
-		oldDataLen = int(binary.LittleEndian.Uint32((*m)[oldPayloadOffset:]))
-	}
-	newDataLen := len(v)
-	if oldPayloadOffset > 0 && newDataLen <= oldDataLen {
-		// Update in-place (waste space)
-		binary.LittleEndian.PutUint32((*m)[oldPayloadOffset:], uint32(newDataLen))
-		copy((*m)[oldPayloadOffset+4:], v)
-		return nil
-	}
-	// Need to remarshal: unmarshal, update, marshal
-	var temp CheckUserRequest
-	if err := temp.UnmarshalSymphony([]byte(*m)); err != nil {
-		return fmt.Errorf("failed to unmarshal: %w", err)
-	}
-	temp.Password = v
-	newData, err := temp.MarshalSymphony()
-	if err != nil {
-		return fmt.Errorf("failed to marshal: %w", err)
+	// Field 2 (PasswordChanged): fixed-length (8 bytes)
+	if len(*m) < 13+8 {
+		return fmt.Errorf("buffer too short")
 	}
-	*m = CheckUserRequestRaw(newData)
+	binary.LittleEndian.PutUint64((*m)[13:], uint64(v))
 	return nil
 }
 
 // MarshalSymphonyPublic marshals only the public fields (without header)
-func (m *CheckUserResult) MarshalSymphonyPublic() ([]byte, error) {
-	return []byte{}, nil
-}
-
-// MarshalSymphonyPrivate marshals only the private fields (without header)
-func (m *CheckUserResult) MarshalSymphonyPrivate() ([]byte, error) {
+func (m *CheckSessionResult) MarshalSymphonyPublic() ([]byte, error) {
 	size := 0
 	size += 1 // table
 	buf := make([]byte, size)
@@ -9471,8 +16872,8 @@ func (m *CheckUserResult) MarshalSymphonyPrivate() ([]byte, error) {
 	_ = payloadStart
 	_ = payloadOffset
 
-	// Field 1 (Correct): fixed-length (1 bytes)
-	if m.Correct {
+	// Field 1 (Valid): fixed-length (1 bytes)
+	if m.Valid {
 		buf[tableStart+0] = 1
 	} else {
 		buf[tableStart+0] = 0
@@ -9481,13 +16882,13 @@ func (m *CheckUserResult) MarshalSymphonyPrivate() ([]byte, error) {
 	return buf, nil
 }
 
-// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
-func (m *CheckUserResult) UnmarshalSymphonyPublic(data []byte) error {
-	return nil
+// MarshalSymphonyPrivate marshals only the private fields (without header)
+func (m *CheckSessionResult) MarshalSymphonyPrivate() ([]byte, error) {
+	return []byte{}, nil
 }
 
-// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
-func (m *CheckUserResult) UnmarshalSymphonyPrivate(data []byte) error {
+// UnmarshalSymphonyPublic unmarshals only the public fields (without header)
+func (m *CheckSessionResult) UnmarshalSymphonyPublic(data []byte) error {
 	payloadOffset := 0
 	_ = payloadOffset
 	dataLen := 0
@@ -9499,23 +16900,28 @@ func (m *CheckUserResult) UnmarshalSymphonyPrivate(data []byte) error {
 	tableStart := 0
 	_ = tableStart
 
-	// Field 1 (Correct): fixed-length (1 bytes)
+	// Field 1 (Valid): fixed-length (1 bytes)
 	if len(data) < tableStart+1 {
 		return fmt.Errorf("invalid data: too short for field")
 	}
-	m.Correct = data[tableStart+0] != 0
+	m.Valid = data[tableStart+0] != 0
 
 	return nil
 }
 
-func (m *CheckUserResult) MarshalSymphony() ([]byte, error) {
+// UnmarshalSymphonyPrivate unmarshals only the private fields (without header)
+func (m *CheckSessionResult) UnmarshalSymphonyPrivate(data []byte) error {
+	return nil
+}
+
+func (m *CheckSessionResult) MarshalSymphony() ([]byte, error) {
 	size := 0
 	// Public segment:
 	size += 1  // version byte
 	size += 12 // reserved: offset_to_private, service_name, method_name
+	size += 1  // table entries
 	// Private segment:
 	size += 1 // version byte
-	size += 1 // table entries
 
 	buf := make([]byte, size)
 
@@ -9531,6 +16937,7 @@ func (m *CheckUserResult) MarshalSymphony() ([]byte, error) {
 
 	// Calculate offset to private segment
 	publicSegmentSize := 13
+	publicSegmentSize += 1 // field Valid
 
 	// Write reserved header
 	binary.LittleEndian.PutUint32(buf[1:5], uint32(publicSegmentSize)) // offset_to_private
@@ -9539,34 +16946,34 @@ func (m *CheckUserResult) MarshalSymphony() ([]byte, error) {
 
 	// Write public fields
 	publicTableStart := 13
-	publicPayloadStart := publicTableStart + 0
+	publicPayloadStart := publicTableStart + 1
 	publicPayloadOffset := 0
 	_ = publicPayloadStart
 	_ = publicPayloadOffset
 
+	// Field 1 (Valid): fixed-length (1 bytes)
+	if m.Valid {
+		buf[publicTableStart+0] = 1
+	} else {
+		buf[publicTableStart+0] = 0
+	}
+
 	// === PRIVATE SEGMENT ===
 	privateStart := publicSegmentSize
 	buf[privateStart] = 0x01 // version byte
 
 	// Write private fields
-	privateTableStart := privateStart + 1 // 1 bytes table
-	privatePayloadStart := privateTableStart + 1
+	privateTableStart := privateStart + 1 // 0 bytes table
+	privatePayloadStart := privateTableStart + 0
 	privatePayloadOffset := 0
 	_ = privatePayloadStart
 	_ = privatePayloadOffset
 
 	// Private segment offsets are stored relative to privateStart
-	// Field 1 (Correct): fixed-length (1 bytes)
-	if m.Correct {
-		buf[privateTableStart+0] = 1
-	} else {
-		buf[privateTableStart+0] = 0
-	}
-
 	return buf, nil
 }
 
-func (m *CheckUserResult) UnmarshalSymphony(data []byte) error {
+func (m *CheckSessionResult) UnmarshalSymphony(data []byte) error {
 	if len(data) < 13 {
 		return fmt.Errorf("invalid data: too short")
 	}
@@ -9598,71 +17005,54 @@ func (m *CheckUserResult) UnmarshalSymphony(data []byte) error {
 	// === PUBLIC FIELDS ===
 	publicTableStart := 13
 	_ = publicTableStart
+	// Field 1 (Valid): fixed-length (1 bytes)
+	if len(data) < publicTableStart+1 {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	m.Valid = data[publicTableStart+0] != 0
+
 	// === PRIVATE FIELDS ===
 	privateTableStart := offsetToPrivate + 1
 	_ = privateTableStart
 	// Private segment offsets are relative to offsetToPrivate
-	// Field 1 (Correct): fixed-length (1 bytes)
-	if len(data) < privateTableStart+1 {
-		return fmt.Errorf("invalid data: too short for field")
-	}
-	m.Correct = data[privateTableStart+0] != 0
-
 	return nil
 }
 
-type CheckUserResultRaw []byte
+type CheckSessionResultRaw []byte
 
-func (m CheckUserResultRaw) MarshalSymphony() ([]byte, error) {
+func (m CheckSessionResultRaw) MarshalSymphony() ([]byte, error) {
 	return []byte(m), nil
 }
 
-func (m *CheckUserResultRaw) UnmarshalSymphony(data []byte) error {
-	*m = CheckUserResultRaw(data)
+func (m *CheckSessionResultRaw) UnmarshalSymphony(data []byte) error {
+	*m = CheckSessionResultRaw(data)
 	return nil
 }
 
-func (m CheckUserResultRaw) GetCorrect() bool {
-	// ASSERT: Private field requires complete buffer
-	if len(m) < 5 {
-		panic(fmt.Sprintf("private getter Correct called on invalid buffer: len(m)=%d, need at least 5 bytes", len(m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32(m[1:5]))
-	if offsetToPrivate >= len(m) || m[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(m) {
-			marker = m[offsetToPrivate]
-		}
-		panic(fmt.Sprintf("private getter Correct called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(m), marker))
-	}
-	// Field 1 (Correct): fixed-length (1 bytes)
-	if len(m) < offsetToPrivate+1+1 {
+func (m CheckSessionResultRaw) GetValid() bool {
+	// Field 1 (Valid): fixed-length (1 bytes)
+	if len(m) < 13+1 {
 		return false
 	}
-	return m[offsetToPrivate+1] != 0
+	return m[13] != 0
 }
 
-func (m *CheckUserResultRaw) SetCorrect(v bool) error {
-	// ASSERT: Private field setter requires complete buffer
-	if len(*m) < 5 {
-		panic(fmt.Sprintf("private setter Correct called on invalid buffer: len(m)=%d, need at least 5 bytes", len(*m)))
-	}
-	offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
-	if offsetToPrivate >= len(*m) || (*m)[offsetToPrivate] != 0x01 {
-		marker := byte(0)
-		if offsetToPrivate < len(*m) {
-			marker = (*m)[offsetToPrivate]
+func (m *CheckSessionResultRaw) SetValid(v bool) error {
+	// ASSERT: Public field setter requires public-only buffer
+	if len(*m) >= 5 {
+		offsetToPrivate := int(binary.LittleEndian.Uint32((*m)[1:5]))
+		if offsetToPrivate < len(*m) && (*m)[offsetToPrivate] == 0x01 {
+			panic(fmt.Sprintf("public setter Valid called on complete buffer: offsetToPrivate=%d, len(m)=%d, marker=0x01 (should not modify complete buffer)", offsetToPrivate, len(*m)))
 		}
-		panic(fmt.Sprintf("private setter Correct called on public-only buffer: offsetToPrivate=%d, len(m)=%d, marker=0x%02x (expected 0x01)", offsetToPrivate, len(*m), marker))
 	}
-	// Field 1 (Correct): fixed-length (1 bytes)
-	if len(*m) < offsetToPrivate+1+1 {
+	// Field 1 (Valid): fixed-length (1 bytes)
+	if len(*m) < 13+1 {
 		return fmt.Errorf("buffer too short")
 	}
 	if v {
-		(*m)[offsetToPrivate+1] = 1
+		(*m)[13] = 1
 	} else {
-		(*m)[offsetToPrivate+1] = 0
+		(*m)[13] = 0
 	}
 	return nil
 }
@@ -41,21 +41,25 @@ var serviceIDToName = map[uint32]string{
 
 // Method IDs for Geo
 const (
-	Geo_MethodID_NearbyGeo = 1
+	Geo_MethodID_NearbyGeo   = 1
+	Geo_MethodID_SetLocation = 2
 )
 
 // Method name <-> ID mappings for Geo
 var Geo_methodNameToID = map[string]uint32{
-	"NearbyGeo": Geo_MethodID_NearbyGeo,
+	"NearbyGeo":   Geo_MethodID_NearbyGeo,
+	"SetLocation": Geo_MethodID_SetLocation,
 }
 
 var Geo_methodIDToName = map[uint32]string{
-	Geo_MethodID_NearbyGeo: "NearbyGeo",
+	Geo_MethodID_NearbyGeo:   "NearbyGeo",
+	Geo_MethodID_SetLocation: "SetLocation",
 }
 
 // GeoClient is the client API for Geo service.
 type GeoClient interface {
 	NearbyGeo(ctx context.Context, req *NearbyRequest) (*NearbyResult, error)
+	SetLocation(ctx context.Context, req *SetLocationRequest) (*SetLocationResult, error)
 }
 
 type arpcGeoClient struct {
@@ -78,8 +82,17 @@ func (c *arpcGeoClient) NearbyGeo(ctx context.Context, req *NearbyRequest) (*Nea
 	return resp, nil
 }
 
+func (c *arpcGeoClient) SetLocation(ctx context.Context, req *SetLocationRequest) (*SetLocationResult, error) {
+	resp := new(SetLocationResult)
+	if err := c.client.Call(ctx, "Geo", "SetLocation", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 type GeoServer interface {
 	NearbyGeo(ctx context.Context, req *NearbyRequest) (*NearbyResult, context.Context, error)
+	SetLocation(ctx context.Context, req *SetLocationRequest) (*SetLocationResult, context.Context, error)
 }
 
 func RegisterGeoServer(s *rpc.Server, srv GeoServer) {
@@ -93,6 +106,11 @@ func RegisterGeoServer(s *rpc.Server, srv GeoServer) {
 				MethodID:   Geo_MethodID_NearbyGeo,
 				Handler:    _Geo_NearbyGeo_Handler,
 			},
+			Geo_MethodID_SetLocation: {
+				MethodName: "SetLocation",
+				MethodID:   Geo_MethodID_SetLocation,
+				Handler:    _Geo_SetLocation_Handler,
+			},
 		},
 	}, srv)
 }
@@ -121,23 +139,55 @@ func _Geo_NearbyGeo_Handler(srv any, ctx context.Context, dec func(any) error, r
 	return resp, ctx, err
 }
 
+func _Geo_SetLocation_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(SetLocationRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(GeoServer).SetLocation(ctx, req.Payload.(*SetLocationRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
+
 // Method IDs for Profile
 const (
 	Profile_MethodID_GetProfiles = 1
+	Profile_MethodID_CreateHotel = 2
+	Profile_MethodID_UpdateHotel = 3
 )
 
 // Method name <-> ID mappings for Profile
 var Profile_methodNameToID = map[string]uint32{
 	"GetProfiles": Profile_MethodID_GetProfiles,
+	"CreateHotel": Profile_MethodID_CreateHotel,
+	"UpdateHotel": Profile_MethodID_UpdateHotel,
 }
 
 var Profile_methodIDToName = map[uint32]string{
 	Profile_MethodID_GetProfiles: "GetProfiles",
+	Profile_MethodID_CreateHotel: "CreateHotel",
+	Profile_MethodID_UpdateHotel: "UpdateHotel",
 }
 
 // ProfileClient is the client API for Profile service.
 type ProfileClient interface {
 	GetProfiles(ctx context.Context, req *GetProfilesRequest) (*GetProfilesResult, error)
+	CreateHotel(ctx context.Context, req *HotelRequest) (*HotelResult, error)
+	UpdateHotel(ctx context.Context, req *HotelRequest) (*HotelResult, error)
 }
 
 type arpcProfileClient struct {
@@ -160,8 +210,26 @@ func (c *arpcProfileClient) GetProfiles(ctx context.Context, req *GetProfilesReq
 	return resp, nil
 }
 
+func (c *arpcProfileClient) CreateHotel(ctx context.Context, req *HotelRequest) (*HotelResult, error) {
+	resp := new(HotelResult)
+	if err := c.client.Call(ctx, "Profile", "CreateHotel", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *arpcProfileClient) UpdateHotel(ctx context.Context, req *HotelRequest) (*HotelResult, error) {
+	resp := new(HotelResult)
+	if err := c.client.Call(ctx, "Profile", "UpdateHotel", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 type ProfileServer interface {
 	GetProfiles(ctx context.Context, req *GetProfilesRequest) (*GetProfilesResult, context.Context, error)
+	CreateHotel(ctx context.Context, req *HotelRequest) (*HotelResult, context.Context, error)
+	UpdateHotel(ctx context.Context, req *HotelRequest) (*HotelResult, context.Context, error)
 }
 
 func RegisterProfileServer(s *rpc.Server, srv ProfileServer) {
@@ -175,6 +243,16 @@ func RegisterProfileServer(s *rpc.Server, srv ProfileServer) {
 				MethodID:   Profile_MethodID_GetProfiles,
 				Handler:    _Profile_GetProfiles_Handler,
 			},
+			Profile_MethodID_CreateHotel: {
+				MethodName: "CreateHotel",
+				MethodID:   Profile_MethodID_CreateHotel,
+				Handler:    _Profile_CreateHotel_Handler,
+			},
+			Profile_MethodID_UpdateHotel: {
+				MethodName: "UpdateHotel",
+				MethodID:   Profile_MethodID_UpdateHotel,
+				Handler:    _Profile_UpdateHotel_Handler,
+			},
 		},
 	}, srv)
 }
@@ -203,23 +281,79 @@ func _Profile_GetProfiles_Handler(srv any, ctx context.Context, dec func(any) er
 	return resp, ctx, err
 }
 
+func _Profile_CreateHotel_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(HotelRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(ProfileServer).CreateHotel(ctx, req.Payload.(*HotelRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
+
+func _Profile_UpdateHotel_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(HotelRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(ProfileServer).UpdateHotel(ctx, req.Payload.(*HotelRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
+
 // Method IDs for Recommendation
 const (
 	Recommendation_MethodID_GetRecommendations = 1
+	Recommendation_MethodID_SetHotel           = 2
+	Recommendation_MethodID_ReloadHotels       = 3
 )
 
 // Method name <-> ID mappings for Recommendation
 var Recommendation_methodNameToID = map[string]uint32{
 	"GetRecommendations": Recommendation_MethodID_GetRecommendations,
+	"SetHotel":           Recommendation_MethodID_SetHotel,
+	"ReloadHotels":       Recommendation_MethodID_ReloadHotels,
 }
 
 var Recommendation_methodIDToName = map[uint32]string{
 	Recommendation_MethodID_GetRecommendations: "GetRecommendations",
+	Recommendation_MethodID_SetHotel:           "SetHotel",
+	Recommendation_MethodID_ReloadHotels:       "ReloadHotels",
 }
 
 // RecommendationClient is the client API for Recommendation service.
 type RecommendationClient interface {
 	GetRecommendations(ctx context.Context, req *GetRecommendationsRequest) (*GetRecommendationsResult, error)
+	SetHotel(ctx context.Context, req *SetHotelRequest) (*SetHotelResult, error)
+	ReloadHotels(ctx context.Context, req *ReloadHotelsRequest) (*ReloadHotelsResult, error)
 }
 
 type arpcRecommendationClient struct {
@@ -242,8 +376,26 @@ func (c *arpcRecommendationClient) GetRecommendations(ctx context.Context, req *
 	return resp, nil
 }
 
+func (c *arpcRecommendationClient) SetHotel(ctx context.Context, req *SetHotelRequest) (*SetHotelResult, error) {
+	resp := new(SetHotelResult)
+	if err := c.client.Call(ctx, "Recommendation", "SetHotel", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *arpcRecommendationClient) ReloadHotels(ctx context.Context, req *ReloadHotelsRequest) (*ReloadHotelsResult, error) {
+	resp := new(ReloadHotelsResult)
+	if err := c.client.Call(ctx, "Recommendation", "ReloadHotels", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 type RecommendationServer interface {
 	GetRecommendations(ctx context.Context, req *GetRecommendationsRequest) (*GetRecommendationsResult, context.Context, error)
+	SetHotel(ctx context.Context, req *SetHotelRequest) (*SetHotelResult, context.Context, error)
+	ReloadHotels(ctx context.Context, req *ReloadHotelsRequest) (*ReloadHotelsResult, context.Context, error)
 }
 
 func RegisterRecommendationServer(s *rpc.Server, srv RecommendationServer) {
@@ -257,6 +409,16 @@ func RegisterRecommendationServer(s *rpc.Server, srv RecommendationServer) {
 				MethodID:   Recommendation_MethodID_GetRecommendations,
 				Handler:    _Recommendation_GetRecommendations_Handler,
 			},
+			Recommendation_MethodID_SetHotel: {
+				MethodName: "SetHotel",
+				MethodID:   Recommendation_MethodID_SetHotel,
+				Handler:    _Recommendation_SetHotel_Handler,
+			},
+			Recommendation_MethodID_ReloadHotels: {
+				MethodName: "ReloadHotels",
+				MethodID:   Recommendation_MethodID_ReloadHotels,
+				Handler:    _Recommendation_ReloadHotels_Handler,
+			},
 		},
 	}, srv)
 }
@@ -285,23 +447,75 @@ func _Recommendation_GetRecommendations_Handler(srv any, ctx context.Context, de
 	return resp, ctx, err
 }
 
+func _Recommendation_SetHotel_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(SetHotelRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(RecommendationServer).SetHotel(ctx, req.Payload.(*SetHotelRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
+
+func _Recommendation_ReloadHotels_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(ReloadHotelsRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(RecommendationServer).ReloadHotels(ctx, req.Payload.(*ReloadHotelsRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
+
 // Method IDs for Rate
 const (
-	Rate_MethodID_GetRates = 1
+	Rate_MethodID_GetRates     = 1
+	Rate_MethodID_SetRatePlans = 2
 )
 
 // Method name <-> ID mappings for Rate
 var Rate_methodNameToID = map[string]uint32{
-	"GetRates": Rate_MethodID_GetRates,
+	"GetRates":     Rate_MethodID_GetRates,
+	"SetRatePlans": Rate_MethodID_SetRatePlans,
 }
 
 var Rate_methodIDToName = map[uint32]string{
-	Rate_MethodID_GetRates: "GetRates",
+	Rate_MethodID_GetRates:     "GetRates",
+	Rate_MethodID_SetRatePlans: "SetRatePlans",
 }
 
 // RateClient is the client API for Rate service.
 type RateClient interface {
 	GetRates(ctx context.Context, req *GetRatesRequest) (*GetRatesResult, error)
+	SetRatePlans(ctx context.Context, req *SetRatePlansRequest) (*SetRatePlansResult, error)
 }
 
 type arpcRateClient struct {
@@ -324,8 +538,17 @@ func (c *arpcRateClient) GetRates(ctx context.Context, req *GetRatesRequest) (*G
 	return resp, nil
 }
 
+func (c *arpcRateClient) SetRatePlans(ctx context.Context, req *SetRatePlansRequest) (*SetRatePlansResult, error) {
+	resp := new(SetRatePlansResult)
+	if err := c.client.Call(ctx, "Rate", "SetRatePlans", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 type RateServer interface {
 	GetRates(ctx context.Context, req *GetRatesRequest) (*GetRatesResult, context.Context, error)
+	SetRatePlans(ctx context.Context, req *SetRatePlansRequest) (*SetRatePlansResult, context.Context, error)
 }
 
 func RegisterRateServer(s *rpc.Server, srv RateServer) {
@@ -339,6 +562,11 @@ func RegisterRateServer(s *rpc.Server, srv RateServer) {
 				MethodID:   Rate_MethodID_GetRates,
 				Handler:    _Rate_GetRates_Handler,
 			},
+			Rate_MethodID_SetRatePlans: {
+				MethodName: "SetRatePlans",
+				MethodID:   Rate_MethodID_SetRatePlans,
+				Handler:    _Rate_SetRatePlans_Handler,
+			},
 		},
 	}, srv)
 }
@@ -367,27 +595,55 @@ func _Rate_GetRates_Handler(srv any, ctx context.Context, dec func(any) error, r
 	return resp, ctx, err
 }
 
+func _Rate_SetRatePlans_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(SetRatePlansRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(RateServer).SetRatePlans(ctx, req.Payload.(*SetRatePlansRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
+
 // Method IDs for Reservation
 const (
 	Reservation_MethodID_MakeReservation   = 1
 	Reservation_MethodID_CheckAvailability = 2
+	Reservation_MethodID_SetCapacity       = 3
 )
 
 // Method name <-> ID mappings for Reservation
 var Reservation_methodNameToID = map[string]uint32{
 	"MakeReservation":   Reservation_MethodID_MakeReservation,
 	"CheckAvailability": Reservation_MethodID_CheckAvailability,
+	"SetCapacity":       Reservation_MethodID_SetCapacity,
 }
 
 var Reservation_methodIDToName = map[uint32]string{
 	Reservation_MethodID_MakeReservation:   "MakeReservation",
 	Reservation_MethodID_CheckAvailability: "CheckAvailability",
+	Reservation_MethodID_SetCapacity:       "SetCapacity",
 }
 
 // ReservationClient is the client API for Reservation service.
 type ReservationClient interface {
 	MakeReservation(ctx context.Context, req *ReservationRequest) (*ReservationResult, error)
 	CheckAvailability(ctx context.Context, req *ReservationRequest) (*ReservationResult, error)
+	SetCapacity(ctx context.Context, req *SetCapacityRequest) (*SetCapacityResult, error)
 }
 
 type arpcReservationClient struct {
@@ -418,9 +674,18 @@ func (c *arpcReservationClient) CheckAvailability(ctx context.Context, req *Rese
 	return resp, nil
 }
 
+func (c *arpcReservationClient) SetCapacity(ctx context.Context, req *SetCapacityRequest) (*SetCapacityResult, error) {
+	resp := new(SetCapacityResult)
+	if err := c.client.Call(ctx, "Reservation", "SetCapacity", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 type ReservationServer interface {
 	MakeReservation(ctx context.Context, req *ReservationRequest) (*ReservationResult, context.Context, error)
 	CheckAvailability(ctx context.Context, req *ReservationRequest) (*ReservationResult, context.Context, error)
+	SetCapacity(ctx context.Context, req *SetCapacityRequest) (*SetCapacityResult, context.Context, error)
 }
 
 func RegisterReservationServer(s *rpc.Server, srv ReservationServer) {
@@ -439,6 +704,11 @@ func RegisterReservationServer(s *rpc.Server, srv ReservationServer) {
 				MethodID:   Reservation_MethodID_CheckAvailability,
 				Handler:    _Reservation_CheckAvailability_Handler,
 			},
+			Reservation_MethodID_SetCapacity: {
+				MethodName: "SetCapacity",
+				MethodID:   Reservation_MethodID_SetCapacity,
+				Handler:    _Reservation_SetCapacity_Handler,
+			},
 		},
 	}, srv)
 }
@@ -491,6 +761,30 @@ func _Reservation_CheckAvailability_Handler(srv any, ctx context.Context, dec fu
 	return resp, ctx, err
 }
 
+func _Reservation_SetCapacity_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(SetCapacityRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(ReservationServer).SetCapacity(ctx, req.Payload.(*SetCapacityRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
+
 // Method IDs for Search
 const (
 	Search_MethodID_Nearby = 1
@@ -575,21 +869,41 @@ func _Search_Nearby_Handler(srv any, ctx context.Context, dec func(any) error, r
 
 // Method IDs for User
 const (
-	User_MethodID_CheckUser = 1
+	User_MethodID_CheckUser      = 1
+	User_MethodID_RegisterUser   = 2
+	User_MethodID_ChangePassword = 3
+	User_MethodID_DeleteUser     = 4
+	User_MethodID_ReloadUsers    = 5
+	User_MethodID_CheckSession   = 6
 )
 
 // Method name <-> ID mappings for User
 var User_methodNameToID = map[string]uint32{
-	"CheckUser": User_MethodID_CheckUser,
+	"CheckUser":      User_MethodID_CheckUser,
+	"RegisterUser":   User_MethodID_RegisterUser,
+	"ChangePassword": User_MethodID_ChangePassword,
+	"DeleteUser":     User_MethodID_DeleteUser,
+	"ReloadUsers":    User_MethodID_ReloadUsers,
+	"CheckSession":   User_MethodID_CheckSession,
 }
 
 var User_methodIDToName = map[uint32]string{
-	User_MethodID_CheckUser: "CheckUser",
+	User_MethodID_CheckUser:      "CheckUser",
+	User_MethodID_RegisterUser:   "RegisterUser",
+	User_MethodID_ChangePassword: "ChangePassword",
+	User_MethodID_DeleteUser:     "DeleteUser",
+	User_MethodID_ReloadUsers:    "ReloadUsers",
+	User_MethodID_CheckSession:   "CheckSession",
 }
 
 // UserClient is the client API for User service.
 type UserClient interface {
 	CheckUser(ctx context.Context, req *CheckUserRequest) (*CheckUserResult, error)
+	RegisterUser(ctx context.Context, req *RegisterUserRequest) (*RegisterUserResult, error)
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResult, error)
+	DeleteUser(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResult, error)
+	ReloadUsers(ctx context.Context, req *ReloadUsersRequest) (*ReloadUsersResult, error)
+	CheckSession(ctx context.Context, req *CheckSessionRequest) (*CheckSessionResult, error)
 }
 
 type arpcUserClient struct {
@@ -612,8 +926,53 @@ func (c *arpcUserClient) CheckUser(ctx context.Context, req *CheckUserRequest) (
 	return resp, nil
 }
 
+func (c *arpcUserClient) RegisterUser(ctx context.Context, req *RegisterUserRequest) (*RegisterUserResult, error) {
+	resp := new(RegisterUserResult)
+	if err := c.client.Call(ctx, "User", "RegisterUser", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *arpcUserClient) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResult, error) {
+	resp := new(ChangePasswordResult)
+	if err := c.client.Call(ctx, "User", "ChangePassword", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *arpcUserClient) DeleteUser(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResult, error) {
+	resp := new(DeleteUserResult)
+	if err := c.client.Call(ctx, "User", "DeleteUser", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *arpcUserClient) ReloadUsers(ctx context.Context, req *ReloadUsersRequest) (*ReloadUsersResult, error) {
+	resp := new(ReloadUsersResult)
+	if err := c.client.Call(ctx, "User", "ReloadUsers", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *arpcUserClient) CheckSession(ctx context.Context, req *CheckSessionRequest) (*CheckSessionResult, error) {
+	resp := new(CheckSessionResult)
+	if err := c.client.Call(ctx, "User", "CheckSession", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 type UserServer interface {
 	CheckUser(ctx context.Context, req *CheckUserRequest) (*CheckUserResult, context.Context, error)
+	RegisterUser(ctx context.Context, req *RegisterUserRequest) (*RegisterUserResult, context.Context, error)
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResult, context.Context, error)
+	DeleteUser(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResult, context.Context, error)
+	ReloadUsers(ctx context.Context, req *ReloadUsersRequest) (*ReloadUsersResult, context.Context, error)
+	CheckSession(ctx context.Context, req *CheckSessionRequest) (*CheckSessionResult, context.Context, error)
 }
 
 func RegisterUserServer(s *rpc.Server, srv UserServer) {
@@ -627,6 +986,31 @@ func RegisterUserServer(s *rpc.Server, srv UserServer) {
 				MethodID:   User_MethodID_CheckUser,
 				Handler:    _User_CheckUser_Handler,
 			},
+			User_MethodID_RegisterUser: {
+				MethodName: "RegisterUser",
+				MethodID:   User_MethodID_RegisterUser,
+				Handler:    _User_RegisterUser_Handler,
+			},
+			User_MethodID_ChangePassword: {
+				MethodName: "ChangePassword",
+				MethodID:   User_MethodID_ChangePassword,
+				Handler:    _User_ChangePassword_Handler,
+			},
+			User_MethodID_DeleteUser: {
+				MethodName: "DeleteUser",
+				MethodID:   User_MethodID_DeleteUser,
+				Handler:    _User_DeleteUser_Handler,
+			},
+			User_MethodID_ReloadUsers: {
+				MethodName: "ReloadUsers",
+				MethodID:   User_MethodID_ReloadUsers,
+				Handler:    _User_ReloadUsers_Handler,
+			},
+			User_MethodID_CheckSession: {
+				MethodName: "CheckSession",
+				MethodID:   User_MethodID_CheckSession,
+				Handler:    _User_CheckSession_Handler,
+			},
 		},
 	}, srv)
 }
@@ -654,3 +1038,123 @@ func _User_CheckUser_Handler(srv any, ctx context.Context, dec func(any) error,
 	}
 	return resp, ctx, err
 }
+
+func _User_RegisterUser_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(RegisterUserRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(UserServer).RegisterUser(ctx, req.Payload.(*RegisterUserRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
+
+func _User_ChangePassword_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(ChangePasswordRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(UserServer).ChangePassword(ctx, req.Payload.(*ChangePasswordRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
+
+func _User_DeleteUser_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(DeleteUserRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(UserServer).DeleteUser(ctx, req.Payload.(*DeleteUserRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
+
+func _User_ReloadUsers_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(ReloadUsersRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(UserServer).ReloadUsers(ctx, req.Payload.(*ReloadUsersRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
+
+func _User_CheckSession_Handler(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+	req.Payload = new(CheckSessionRequest)
+	if err := dec(req.Payload); err != nil {
+		return nil, ctx, err
+	}
+	req, ctx, err := chain.ProcessRequest(ctx, req)
+	if err != nil {
+		return nil, ctx, err
+	}
+	result, ctx, err := srv.(UserServer).CheckSession(ctx, req.Payload.(*CheckSessionRequest))
+	if err != nil {
+		return nil, ctx, err
+	}
+	resp := &element.RPCResponse{
+		ID:     req.ID,
+		Result: result,
+	}
+	resp, ctx, err = chain.ProcessResponse(ctx, resp)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return resp, ctx, err
+}
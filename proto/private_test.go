@@ -0,0 +1,105 @@
+package hotel_reservation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// sensitive are the fields that must stay in the private Symphony segment.
+var sensitive = map[protoreflect.Name]bool{
+	"username":     true,
+	"password":     true,
+	"newPassword":  true,
+	"customerName": true,
+}
+
+func TestSensitiveFieldsPrivate(t *testing.T) {
+	msgs := File_hotel_reservation_proto.Messages()
+	for i := 0; i < msgs.Len(); i++ {
+		fields := msgs.Get(i).Fields()
+		for j := 0; j < fields.Len(); j++ {
+			fd := fields.Get(j)
+			public := proto.GetExtension(fd.Options(), E_IsPublic).(bool)
+			if sensitive[fd.Name()] && public {
+				t.Errorf("%v is public", fd.FullName())
+			}
+			if !sensitive[fd.Name()] && !public {
+				t.Errorf("%v is private but not a sensitive field", fd.FullName())
+			}
+		}
+	}
+}
+
+type symphonyMessage interface {
+	MarshalSymphony() ([]byte, error)
+	MarshalSymphonyPublic() ([]byte, error)
+}
+
+func TestPrivateBytesNotPublic(t *testing.T) {
+	tests := []struct {
+		msg     symphonyMessage
+		secrets []string
+		public  []string
+	}{
+		{&CheckUserRequest{Username: "Cornell_1", Password: "s3cret-pa55"}, []string{"Cornell_1", "s3cret-pa55"}, nil},
+		{&RegisterUserRequest{Username: "Cornell_1", Password: "s3cret-pa55"}, []string{"Cornell_1", "s3cret-pa55"}, nil},
+		{&ChangePasswordRequest{Username: "Cornell_1", Password: "s3cret-pa55", NewPassword: "n3w-pa55"}, []string{"Cornell_1", "s3cret-pa55", "n3w-pa55"}, nil},
+		{&DeleteUserRequest{Username: "Cornell_1", Password: "s3cret-pa55"}, []string{"Cornell_1", "s3cret-pa55"}, nil},
+		{&ReservationRequest{CustomerName: "Cornell_1", HotelId: []string{"hotel-42"}, InDate: "2015-04-09", OutDate: "2015-04-10", RoomNumber: 1},
+			[]string{"Cornell_1"}, []string{"hotel-42", "2015-04-09", "2015-04-10"}},
+		{&GetRecommendationsRequest{Require: "mixed", Username: "Cornell_1"}, []string{"Cornell_1"}, []string{"mixed"}},
+	}
+	for _, tt := range tests {
+		name := string(tt.msg.(proto.Message).ProtoReflect().Descriptor().Name())
+
+		pub, err := tt.msg.MarshalSymphonyPublic()
+		if err != nil {
+			t.Fatalf("%v: %v", name, err)
+		}
+		data, err := tt.msg.MarshalSymphony()
+		if err != nil {
+			t.Fatalf("%v: %v", name, err)
+		}
+		offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+		segment, private := data[:offsetToPrivate], data[offsetToPrivate:]
+
+		for _, s := range tt.secrets {
+			if bytes.Contains(pub, []byte(s)) || bytes.Contains(segment, []byte(s)) {
+				t.Errorf("%v: %q in the public segment", name, s)
+			}
+			if !bytes.Contains(private, []byte(s)) {
+				t.Errorf("%v: %q missing from the private segment", name, s)
+			}
+		}
+		for _, s := range tt.public {
+			if !bytes.Contains(segment, []byte(s)) {
+				t.Errorf("%v: %q missing from the public segment", name, s)
+			}
+		}
+	}
+}
+
+// TestRoutePublicSegment reads the fields a proxy routes on from the public
+// segment alone.
+func TestRoutePublicSegment(t *testing.T) {
+	req := &ReservationRequest{CustomerName: "Cornell_1", HotelId: []string{"hotel-42"}, InDate: "2015-04-09", OutDate: "2015-04-10", RoomNumber: 1}
+	data, err := req.MarshalSymphony()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw := ReservationRequestRaw(data[:binary.LittleEndian.Uint32(data[1:5])])
+
+	if got := raw.GetHotelId(); len(got) != 1 || got[0] != "hotel-42" {
+		t.Errorf("hotel ids = %v, want [hotel-42]", got)
+	}
+	if got := raw.GetInDate(); got != req.InDate {
+		t.Errorf("in date = %v, want %v", got, req.InDate)
+	}
+	if got := raw.GetRoomNumber(); got != req.RoomNumber {
+		t.Errorf("room number = %v, want %v", got, req.RoomNumber)
+	}
+}
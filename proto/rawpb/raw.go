@@ -0,0 +1,186 @@
+// Package rawpb serves the hot aRPC methods on the Symphony Raw view of their
+// requests. It is written by hand, unlike the generated stubs of package
+// proto.
+//
+// The Register functions register a service with the generated
+// Register*Server of package proto and then swap the handler of its hot
+// method for one handing it the Raw view of the payload instead of
+// unmarshaling it. Raw getters read straight from the payload, so a handler
+// only decodes the fields it uses and no message is allocated. They need the
+// Symphony serializer, the other serializers have no Raw view.
+//
+// Decoding a Raw view only points it at the payload, and the getters of
+// private fields panic on payloads without a private segment, so the
+// handlers check each payload first, like UnmarshalSymphony does.
+package rawpb
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/appnet-org/arpc/pkg/rpc"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
+)
+
+// rawMessage is the pointer to a Raw view, which the Symphony serializer
+// points at the payload without copying it.
+type rawMessage[Raw any] interface {
+	*Raw
+	UnmarshalSymphony(data []byte) error
+}
+
+// checkSymphony checks the header of the Symphony message data, that it has a
+// private segment, and that its public table holds fixed bytes, returning
+// the errors UnmarshalSymphony does.
+func checkSymphony(data []byte, fixed int) error {
+	if len(data) < 13 {
+		return fmt.Errorf("invalid data: too short")
+	}
+	if data[0] != 0x01 {
+		return fmt.Errorf("invalid data: wrong public version")
+	}
+	offsetToPrivate := int(binary.LittleEndian.Uint32(data[1:5]))
+	if offsetToPrivate >= len(data) || data[offsetToPrivate] != 0x01 {
+		return fmt.Errorf("missing private segment")
+	}
+	if len(data) < 13+fixed {
+		return fmt.Errorf("invalid data: too short for field")
+	}
+	return nil
+}
+
+// checkRepeated checks that the repeated field whose offset is at data[at:]
+// has no more items than fit in data, as the getters allocate them all
+// before reading any.
+func checkRepeated(data []byte, at int) error {
+	if len(data) < at+4 {
+		return nil
+	}
+	offset := int(binary.LittleEndian.Uint32(data[at:]))
+	if offset == 0 || len(data) < offset+4 {
+		return nil
+	}
+	count := int(binary.LittleEndian.Uint32(data[offset:]))
+	if size := len(data) - offset - 4; count > size/4 {
+		return fmt.Errorf("invalid data: %d items in %d bytes", count, size)
+	}
+	return nil
+}
+
+func checkNearby(m pb.NearbyRequestRaw) error {
+	return checkSymphony(m, 8)
+}
+
+func checkGetProfiles(m pb.GetProfilesRequestRaw) error {
+	if err := checkSymphony(m, 0); err != nil {
+		return err
+	}
+	if err := checkRepeated(m, 13); err != nil {
+		return err
+	}
+	return checkRepeated(m, 21)
+}
+
+func checkCheckUser(m pb.CheckUserRequestRaw) error {
+	return checkSymphony(m, 0)
+}
+
+// rawHandler returns a method handler calling handle with the Raw view of the
+// request, once check accepts it. The view is only valid until the call
+// returns, the server reuses the buffer after, so handle must copy what it
+// keeps. Views are pooled, so decoding the request allocates nothing.
+func rawHandler[Raw ~[]byte, P rawMessage[Raw], Res any](check func(Raw) error, handle func(ctx context.Context, req Raw) (*Res, context.Context, error)) rpc.MethodHandler {
+	views := sync.Pool{New: func() any { return P(new(Raw)) }}
+	return func(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+		raw := views.Get().(P)
+		err := dec(raw)
+		if err == nil {
+			err = check(*raw)
+		}
+		if err != nil {
+			*raw = nil
+			views.Put(raw)
+			return nil, ctx, err
+		}
+		req.Payload = raw
+		req, ctx, err = chain.ProcessRequest(ctx, req)
+		if err != nil {
+			return nil, ctx, err
+		}
+		result, ctx, err := handle(ctx, *raw)
+		// the payload is not read after the handler, elements only see the
+		// result
+		req.Payload = nil
+		*raw = nil
+		views.Put(raw)
+		if err != nil {
+			return nil, ctx, err
+		}
+		resp := &element.RPCResponse{
+			ID:     req.ID,
+			Result: result,
+		}
+		return chain.ProcessResponse(ctx, resp)
+	}
+}
+
+// replaceHandler replaces the handler of a method of a registered service.
+func replaceHandler(s *rpc.Server, serviceID, methodID uint32, handler rpc.MethodHandler) error {
+	services, err := transport.Services(s)
+	if err != nil {
+		return err
+	}
+	desc, ok := services[serviceID]
+	if !ok {
+		return fmt.Errorf("service %d is not registered", serviceID)
+	}
+	m, ok := desc.MethodsByID[methodID]
+	if !ok {
+		return fmt.Errorf("service %v has no method %d", desc.ServiceName, methodID)
+	}
+	desc.MethodsByID[methodID] = &rpc.MethodDesc{MethodName: m.MethodName, MethodID: m.MethodID, Handler: handler}
+	return nil
+}
+
+// GeoServer handles NearbyGeo on the Raw view of the request.
+type GeoServer interface {
+	pb.GeoServer
+	NearbyGeoRaw(ctx context.Context, req pb.NearbyRequestRaw) (*pb.NearbyResult, context.Context, error)
+}
+
+// RegisterGeo registers srv like pb.RegisterGeoServer, with NearbyGeo
+// served by NearbyGeoRaw.
+func RegisterGeo(s *rpc.Server, srv GeoServer) error {
+	pb.RegisterGeoServer(s, srv)
+	return replaceHandler(s, pb.ServiceID_Geo, pb.Geo_MethodID_NearbyGeo, rawHandler(checkNearby, srv.NearbyGeoRaw))
+}
+
+// ProfileServer handles GetProfiles on the Raw view of the request.
+type ProfileServer interface {
+	pb.ProfileServer
+	GetProfilesRaw(ctx context.Context, req pb.GetProfilesRequestRaw) (*pb.GetProfilesResult, context.Context, error)
+}
+
+// RegisterProfile registers srv like pb.RegisterProfileServer, with
+// GetProfiles served by GetProfilesRaw.
+func RegisterProfile(s *rpc.Server, srv ProfileServer) error {
+	pb.RegisterProfileServer(s, srv)
+	return replaceHandler(s, pb.ServiceID_Profile, pb.Profile_MethodID_GetProfiles, rawHandler(checkGetProfiles, srv.GetProfilesRaw))
+}
+
+// UserServer handles CheckUser on the Raw view of the request.
+type UserServer interface {
+	pb.UserServer
+	CheckUserRaw(ctx context.Context, req pb.CheckUserRequestRaw) (*pb.CheckUserResult, context.Context, error)
+}
+
+// RegisterUser registers srv like pb.RegisterUserServer, with CheckUser
+// served by CheckUserRaw.
+func RegisterUser(s *rpc.Server, srv UserServer) error {
+	pb.RegisterUserServer(s, srv)
+	return replaceHandler(s, pb.ServiceID_User, pb.User_MethodID_CheckUser, rawHandler(checkCheckUser, srv.CheckUserRaw))
+}
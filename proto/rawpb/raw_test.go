@@ -0,0 +1,304 @@
+package rawpb
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/appnet-org/arpc/pkg/rpc"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	"github.com/appnet-org/arpc/pkg/serializer"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
+)
+
+var (
+	nearbyReq   = &pb.NearbyRequest{Lat: 37.7867, Lon: -122.4112}
+	profilesReq = &pb.GetProfilesRequest{HotelIds: []string{"1", "2", "3", "4", "5"}, Locale: "en", Fields: []string{"name", "address.lat", "address.lon"}}
+	checkReq    = &pb.CheckUserRequest{Username: "Cornell_1", Password: "1111111111"}
+)
+
+// checkServer fails calls whose request differs from the ones above, read
+// through the message in the generated handlers and through the Raw view in
+// the others.
+type checkServer struct {
+	pb.GeoServer
+	pb.ProfileServer
+	pb.UserServer
+}
+
+var errRequest = errors.New("unexpected request")
+
+func (checkServer) NearbyGeo(ctx context.Context, req *pb.NearbyRequest) (*pb.NearbyResult, context.Context, error) {
+	return nearby(ctx, req.Lat, req.Lon)
+}
+
+func (checkServer) NearbyGeoRaw(ctx context.Context, req pb.NearbyRequestRaw) (*pb.NearbyResult, context.Context, error) {
+	return nearby(ctx, req.GetLat(), req.GetLon())
+}
+
+func nearby(ctx context.Context, lat, lon float32) (*pb.NearbyResult, context.Context, error) {
+	if lat != nearbyReq.Lat || lon != nearbyReq.Lon {
+		return nil, ctx, errRequest
+	}
+	return &pb.NearbyResult{}, ctx, nil
+}
+
+func (checkServer) GetProfiles(ctx context.Context, req *pb.GetProfilesRequest) (*pb.GetProfilesResult, context.Context, error) {
+	return getProfiles(ctx, req.HotelIds, req.Fields)
+}
+
+func (checkServer) GetProfilesRaw(ctx context.Context, req pb.GetProfilesRequestRaw) (*pb.GetProfilesResult, context.Context, error) {
+	return getProfiles(ctx, req.GetHotelIds(), req.GetFields())
+}
+
+func getProfiles(ctx context.Context, ids, fields []string) (*pb.GetProfilesResult, context.Context, error) {
+	if !slices.Equal(ids, profilesReq.HotelIds) || !slices.Equal(fields, profilesReq.Fields) {
+		return nil, ctx, errRequest
+	}
+	return &pb.GetProfilesResult{}, ctx, nil
+}
+
+func (checkServer) CheckUser(ctx context.Context, req *pb.CheckUserRequest) (*pb.CheckUserResult, context.Context, error) {
+	return checkUser(ctx, req.Username, req.Password)
+}
+
+func (checkServer) CheckUserRaw(ctx context.Context, req pb.CheckUserRequestRaw) (*pb.CheckUserResult, context.Context, error) {
+	return checkUser(ctx, req.GetUsername(), req.GetPassword())
+}
+
+func checkUser(ctx context.Context, username, password string) (*pb.CheckUserResult, context.Context, error) {
+	if username != checkReq.Username || password != checkReq.Password {
+		return nil, ctx, errRequest
+	}
+	return &pb.CheckUserResult{Correct: true}, ctx, nil
+}
+
+// hotMethod is a hot method as registered by the generated Register*Server
+// and by the Register function of this package.
+type hotMethod struct {
+	name         string
+	req          serializer.SymphonyMessage
+	handler, raw rpc.MethodHandler
+}
+
+// newServer returns an aRPC server that is closed when the test ends.
+func newServer(tb testing.TB) *rpc.Server {
+	tb.Helper()
+	s, err := rpc.NewServer("127.0.0.1:0", &serializer.SymphonySerializer{}, nil)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { s.GetTransport().Close() })
+	return s
+}
+
+// registered returns the description s keeps for a service.
+func registered(tb testing.TB, s *rpc.Server, serviceID uint32) *rpc.ServiceDesc {
+	tb.Helper()
+	services, err := transport.Services(s)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if services[serviceID] == nil {
+		tb.Fatalf("service %d is not registered", serviceID)
+	}
+	return services[serviceID]
+}
+
+// registerAll registers checkServer on a server with the generated functions
+// and on another with the ones of this package.
+func registerAll(tb testing.TB) (generated, raw *rpc.Server) {
+	tb.Helper()
+	generated, raw = newServer(tb), newServer(tb)
+	pb.RegisterGeoServer(generated, checkServer{})
+	pb.RegisterProfileServer(generated, checkServer{})
+	pb.RegisterUserServer(generated, checkServer{})
+	for _, register := range []func(*rpc.Server, checkServer) error{
+		func(s *rpc.Server, srv checkServer) error { return RegisterGeo(s, srv) },
+		func(s *rpc.Server, srv checkServer) error { return RegisterProfile(s, srv) },
+		func(s *rpc.Server, srv checkServer) error { return RegisterUser(s, srv) },
+	} {
+		if err := register(raw, checkServer{}); err != nil {
+			tb.Fatal(err)
+		}
+	}
+	return generated, raw
+}
+
+// hotID identifies a hot method and the request the tests call it with.
+type hotID struct {
+	name                string
+	req                 serializer.SymphonyMessage
+	serviceID, methodID uint32
+}
+
+var hotIDs = []hotID{
+	{"NearbyGeo", nearbyReq, pb.ServiceID_Geo, pb.Geo_MethodID_NearbyGeo},
+	{"GetProfiles", profilesReq, pb.ServiceID_Profile, pb.Profile_MethodID_GetProfiles},
+	{"CheckUser", checkReq, pb.ServiceID_User, pb.User_MethodID_CheckUser},
+}
+
+// hotMethods returns the handlers of the hot methods with and without the
+// Raw view.
+func hotMethods(tb testing.TB) []hotMethod {
+	tb.Helper()
+	generated, raw := registerAll(tb)
+	var methods []hotMethod
+	for _, id := range hotIDs {
+		methods = append(methods, hotMethod{
+			name:    id.name,
+			req:     id.req,
+			handler: registered(tb, generated, id.serviceID).MethodsByID[id.methodID].Handler,
+			raw:     registered(tb, raw, id.serviceID).MethodsByID[id.methodID].Handler,
+		})
+	}
+	return methods
+}
+
+// TestRegister checks that only the handlers of the hot methods differ from
+// the generated ones.
+func TestRegister(t *testing.T) {
+	generated, raw := registerAll(t)
+	for _, serviceID := range []uint32{pb.ServiceID_Geo, pb.ServiceID_Profile, pb.ServiceID_User} {
+		want, got := registered(t, generated, serviceID), registered(t, raw, serviceID)
+		if got.ServiceName != want.ServiceName || len(got.MethodsByID) != len(want.MethodsByID) {
+			t.Fatalf("registered %v with %d methods, want %v with %d", got.ServiceName, len(got.MethodsByID), want.ServiceName, len(want.MethodsByID))
+		}
+		for id, m := range want.MethodsByID {
+			hot := slices.ContainsFunc(hotIDs, func(h hotID) bool {
+				return h.serviceID == serviceID && h.methodID == id
+			})
+			g := got.MethodsByID[id]
+			if g == nil || g.MethodName != m.MethodName {
+				t.Errorf("%v: method %d is %v, want %v", want.ServiceName, id, g, m.MethodName)
+				continue
+			}
+			same := reflect.ValueOf(g.Handler).Pointer() == reflect.ValueOf(m.Handler).Pointer()
+			if same == hot {
+				t.Errorf("%v.%v: generated handler kept = %v, want %v", want.ServiceName, m.MethodName, same, !hot)
+			}
+		}
+	}
+
+	if err := RegisterGeo(newServer(t), checkServer{}); err != nil {
+		t.Errorf("registering on a new server: %v", err)
+	}
+	if err := replaceHandler(newServer(t), pb.ServiceID_Geo, pb.Geo_MethodID_NearbyGeo, nil); err == nil {
+		t.Error("replaced the handler of an unregistered service")
+	}
+}
+
+// decoder decodes data the way the aRPC server does with Symphony.
+func decoder(data []byte) func(any) error {
+	s := &serializer.SymphonySerializer{}
+	return func(v any) error {
+		return s.Unmarshal(data, v)
+	}
+}
+
+func call(handler rpc.MethodHandler, dec func(any) error, chain *element.RPCElementChain) (*element.RPCResponse, error) {
+	resp, _, err := handler(checkServer{}, context.Background(), dec, &element.RPCRequest{ID: 1}, chain)
+	return resp, err
+}
+
+func TestRawHandlers(t *testing.T) {
+	chain := element.NewRPCElementChain()
+	for _, m := range hotMethods(t) {
+		data, err := m.req.MarshalSymphony()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := call(m.handler, decoder(data), chain); err != nil {
+			t.Errorf("%v: %v", m.name, err)
+		}
+		if _, err := call(m.raw, decoder(data), chain); err != nil {
+			t.Errorf("%v raw: %v", m.name, err)
+		}
+	}
+}
+
+// TestRawHandlersInvalid checks that the Raw handlers fail on payloads the
+// generated ones fail to unmarshal, instead of panicking in the getters.
+func TestRawHandlersInvalid(t *testing.T) {
+	chain := element.NewRPCElementChain()
+	for _, m := range hotMethods(t) {
+		data, err := m.req.MarshalSymphony()
+		if err != nil {
+			t.Fatal(err)
+		}
+		offsetToPrivate := binary.LittleEndian.Uint32(data[1:5])
+		past := slices.Clone(data)
+		binary.LittleEndian.PutUint32(past[1:5], uint32(len(data)))
+		version := slices.Clone(data)
+		version[0] = 0x02
+
+		for _, tt := range []struct {
+			name string
+			data []byte
+		}{
+			{"empty", nil},
+			{"short header", data[:5]},
+			{"wrong version", version},
+			{"public only", data[:offsetToPrivate]},
+			{"private segment past the end", past},
+		} {
+			t.Run(m.name+"/"+tt.name, func(t *testing.T) {
+				if _, err := call(m.handler, decoder(tt.data), chain); err == nil {
+					t.Fatal("the generated handler accepts the payload")
+				}
+				if _, err := call(m.raw, decoder(tt.data), chain); err == nil {
+					t.Error("raw handler accepted the payload")
+				}
+			})
+		}
+	}
+
+	// a Lat without a Lon
+	lat := append([]byte{0x01}, binary.LittleEndian.AppendUint32(nil, 17)...)
+	lat = append(append(lat, make([]byte, 12)...), 0x01)
+	if _, err := call(rawHandler(checkNearby, checkServer{}.NearbyGeoRaw), decoder(lat), chain); err == nil {
+		t.Error("NearbyGeo raw handler accepted a request without a Lon")
+	}
+
+	// more hotel ids than the payload could hold
+	data, err := profilesReq.MarshalSymphony()
+	if err != nil {
+		t.Fatal(err)
+	}
+	binary.LittleEndian.PutUint32(data[binary.LittleEndian.Uint32(data[13:]):], 1<<31)
+	if _, err := call(rawHandler(checkGetProfiles, checkServer{}.GetProfilesRaw), decoder(data), chain); err == nil {
+		t.Error("GetProfiles raw handler accepted 2^31 hotel ids")
+	}
+}
+
+// BenchmarkHandlers compares the generated handlers of the hot methods, which
+// unmarshal the request, with the ones reading its Raw view, e.g.
+//
+//	go test ./proto/rawpb -run '^$' -bench Handlers -benchmem
+func BenchmarkHandlers(b *testing.B) {
+	chain := element.NewRPCElementChain()
+	for _, m := range hotMethods(b) {
+		data, err := m.req.MarshalSymphony()
+		if err != nil {
+			b.Fatal(err)
+		}
+		dec := decoder(data)
+		for _, h := range []struct {
+			name    string
+			handler rpc.MethodHandler
+		}{{"message", m.handler}, {"raw", m.raw}} {
+			b.Run(m.name+"/"+h.name, func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if _, err := call(h.handler, dec, chain); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Package reload refreshes the data services keep in memory, periodically and
+// on SIGHUP.
+package reload
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	defaultInterval = time.Minute
+)
+
+// Interval reads how often data is reloaded from RELOAD_INTERVAL.
+func Interval() time.Duration {
+	return IntervalOf("RELOAD_INTERVAL", defaultInterval)
+}
+
+// IntervalOf reads a reload interval from the env var env, or returns def
+// when it is unset or invalid.
+func IntervalOf(env string, def time.Duration) time.Duration {
+	interval := def
+	if val, ok := os.LookupEnv(env); ok {
+		if d, err := time.ParseDuration(val); err == nil && d > 0 {
+			interval = d
+		} else {
+			log.Warn().Msgf("Invalid %v %q, using %v", env, val, interval)
+		}
+	}
+	return interval
+}
+
+// Watch calls reload every interval and on SIGHUP until the process exits.
+// Failures are logged, what names the data in the log lines. SIGHUP is
+// handled from when Watch returns.
+func Watch(what string, interval time.Duration, reload func() error) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	go func() {
+		tick := time.NewTicker(interval)
+		defer tick.Stop()
+		for {
+			select {
+			case <-tick.C:
+			case <-hup:
+				log.Info().Msgf("Received SIGHUP, reloading %v", what)
+			}
+			if err := reload(); err != nil {
+				log.Error().Msgf("Failed to reload %v, keeping the previous ones: %v", what, err)
+			}
+		}
+	}()
+}
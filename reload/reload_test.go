@@ -0,0 +1,57 @@
+package reload
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestInterval(t *testing.T) {
+	for val, want := range map[string]time.Duration{"30s": 30 * time.Second, "0s": defaultInterval, "soon": defaultInterval} {
+		t.Setenv("RELOAD_INTERVAL", val)
+		if got := Interval(); got != want {
+			t.Errorf("Interval() with %q = %v, want %v", val, got, want)
+		}
+	}
+}
+
+func TestIntervalOf(t *testing.T) {
+	t.Setenv("MODEL_RELOAD_INTERVAL", "2h")
+	if got := IntervalOf("MODEL_RELOAD_INTERVAL", time.Hour); got != 2*time.Hour {
+		t.Errorf("IntervalOf set to 2h = %v", got)
+	}
+	if got := IntervalOf("UNSET_RELOAD_INTERVAL", time.Hour); got != time.Hour {
+		t.Errorf("IntervalOf unset = %v, want the default", got)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	calls := make(chan struct{}, 1)
+	Watch("hotels", time.Hour, func() error {
+		calls <- struct{}{}
+		return errors.New("mongo down")
+	})
+	if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-calls:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no reload on SIGHUP")
+	}
+
+	ticks := make(chan struct{}, 2)
+	Watch("users", time.Millisecond, func() error {
+		ticks <- struct{}{}
+		return nil
+	})
+	for i := 0; i < 2; i++ {
+		select {
+		case <-ticks:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%d reloads in 5s, want 2", i)
+		}
+	}
+}
@@ -0,0 +1,573 @@
+package frontend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	hotel "github.com/appnetorg/hotel-reservation-arpc/proto"
+)
+
+// maxBodySize bounds the JSON bodies accepted by the v2 API.
+const maxBodySize = 1 << 20
+
+// Error codes of the v2 API.
+const (
+	codeInvalidArgument  = "invalid_argument"
+	codeUnauthenticated  = "unauthenticated"
+	codePermission       = "permission_denied"
+	codeNotFound         = "not_found"
+	codeMethodNotAllowed = "method_not_allowed"
+	codeConflict         = "conflict"
+	codeUnavailable      = "unavailable"
+	codeLockedOut        = "locked_out"
+	codeIdempotency      = "idempotency_key_reused"
+	codeInternal         = "internal"
+)
+
+// apiError is the body of every failed v2 response.
+type apiError struct {
+	Error apiErrorDetail `json:"error"`
+}
+
+type apiErrorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type hotelV2 struct {
+	Id          string     `json:"id"`
+	Name        string     `json:"name"`
+	PhoneNumber string     `json:"phoneNumber"`
+	Address     *addressV2 `json:"address,omitempty"`
+	Score       *float64   `json:"score,omitempty"`
+}
+
+type addressV2 struct {
+	StreetNumber string  `json:"streetNumber"`
+	StreetName   string  `json:"streetName"`
+	City         string  `json:"city"`
+	State        string  `json:"state"`
+	Country      string  `json:"country"`
+	PostalCode   string  `json:"postalCode"`
+	Lat          float32 `json:"lat"`
+	Lon          float32 `json:"lon"`
+}
+
+type hotelsV2 struct {
+	Hotels []hotelV2 `json:"hotels"`
+}
+
+type credentialsV2 struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type sessionV2 struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type userV2 struct {
+	Username string `json:"username"`
+}
+
+type passwordChangeV2 struct {
+	Password    string `json:"password"`
+	NewPassword string `json:"newPassword"`
+}
+
+type passwordV2 struct {
+	Password string `json:"password"`
+}
+
+type reservationV2 struct {
+	HotelId      string `json:"hotelId"`
+	CustomerName string `json:"customerName,omitempty"`
+	InDate       string `json:"inDate"`
+	OutDate      string `json:"outDate"`
+	Rooms        int    `json:"rooms"`
+}
+
+// hotelV2Fields are the profile fields of hotelV2
+var hotelV2Fields = []string{"name", "phoneNumber", "address"}
+
+// registerV2 adds the routes of the v2 API. Known paths called with another
+// method get 405, unknown paths get a JSON 404.
+func (s *Server) registerV2(mux interface {
+	Handle(pattern string, handler http.Handler)
+}) {
+	routes := []struct {
+		method, path string
+		handler      http.HandlerFunc
+	}{
+		{http.MethodGet, "/api/v2/hotels", s.searchV2Handler},
+		{http.MethodGet, "/api/v2/recommendations", s.recommendV2Handler},
+		{http.MethodPost, "/api/v2/sessions", s.loginV2Handler},
+		{http.MethodPost, "/api/v2/users", s.registerV2Handler},
+		{http.MethodPut, "/api/v2/users/{username}/password", s.changePasswordV2Handler},
+		{http.MethodDelete, "/api/v2/users/{username}", s.deleteUserV2Handler},
+		{http.MethodPost, "/api/v2/reservations", s.reservationV2Handler},
+	}
+
+	allowed := make(map[string][]string)
+	var paths []string
+	for _, rt := range routes {
+		mux.Handle(rt.method+" "+rt.path, rt.handler)
+		if _, ok := allowed[rt.path]; !ok {
+			paths = append(paths, rt.path)
+		}
+		allowed[rt.path] = append(allowed[rt.path], rt.method)
+	}
+	for _, path := range paths {
+		allow := strings.Join(allowed[path], ", ")
+		mux.Handle(path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Allow", allow)
+			writeError(w, http.StatusMethodNotAllowed, codeMethodNotAllowed, "%v is not allowed, use %v", r.Method, allow)
+		}))
+	}
+	mux.Handle("/api/v2/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, codeNotFound, "no such resource %v", r.URL.Path)
+	}))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code, format string, args ...any) {
+	writeJSON(w, status, &apiError{apiErrorDetail{Code: code, Message: fmt.Sprintf(format, args...)}})
+}
+
+// writeLockedOut replies with 429 when the user service locked the user out.
+func writeLockedOut(w http.ResponseWriter, retryAfter int32) bool {
+	if retryAfter <= 0 {
+		return false
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter)))
+	writeError(w, http.StatusTooManyRequests, codeLockedOut, "too many failed attempts, retry in %d seconds", retryAfter)
+	return true
+}
+
+// decodeBody reads a JSON body into v, rejecting unknown fields.
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "invalid request body: %v", err)
+		return false
+	}
+	if dec.Decode(&struct{}{}) != io.EOF {
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "request body must hold a single JSON object")
+		return false
+	}
+	return true
+}
+
+// outgoing returns the context of the calls made for a request, telling the
+// user service the address of the client.
+func outgoing(r *http.Request) context.Context {
+	return metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{auth.ClientKey: clientIP(r)}))
+}
+
+// clientIP returns the address the request came from. X-Forwarded-For is not
+// trusted, a client could send any address in it.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func toHotelsV2(profiles []*hotel.Hotel, ids []string, scores []float64) hotelsV2 {
+	byId := make(map[string]*hotel.Hotel, len(profiles))
+	for _, h := range profiles {
+		byId[h.Id] = h
+	}
+
+	res := hotelsV2{Hotels: []hotelV2{}}
+	for i, id := range ids {
+		h, ok := byId[id]
+		if !ok {
+			continue
+		}
+		v := hotelV2{Id: h.Id, Name: h.Name, PhoneNumber: h.PhoneNumber}
+		if a := h.Address; a != nil {
+			v.Address = &addressV2{a.StreetNumber, a.StreetName, a.City, a.State, a.Country, a.PostalCode, a.Lat, a.Lon}
+		}
+		if i < len(scores) {
+			score := scores[i]
+			v.Score = &score
+		}
+		res.Hotels = append(res.Hotels, v)
+	}
+	return res
+}
+
+// parseLocation reads the lat and lon query parameters.
+func parseLocation(w http.ResponseWriter, r *http.Request) (float64, float64, bool) {
+	lat, errLat := strconv.ParseFloat(r.URL.Query().Get("lat"), 64)
+	lon, errLon := strconv.ParseFloat(r.URL.Query().Get("lon"), 64)
+	if errLat != nil || errLon != nil || lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "lat and lon must be valid coordinates")
+		return 0, 0, false
+	}
+	return lat, lon, true
+}
+
+func (s *Server) searchV2Handler(w http.ResponseWriter, r *http.Request) {
+	ctx := outgoing(r)
+
+	inDate, outDate := r.URL.Query().Get("inDate"), r.URL.Query().Get("outDate")
+	if !checkDataFormat(inDate) || !checkDataFormat(outDate) {
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "inDate and outDate must be dates (YYYY-MM-DD)")
+		return
+	}
+	lat, lon, ok := parseLocation(w, r)
+	if !ok {
+		return
+	}
+	locale := r.URL.Query().Get("locale")
+	if locale == "" {
+		locale = "en"
+	}
+
+	searchResp, err := s.searchClient.Nearby(ctx, &hotel.SearchRequest{
+		Lat:     float32(lat),
+		Lon:     float32(lon),
+		InDate:  inDate,
+		OutDate: outDate,
+	})
+	if err != nil {
+		writeError(w, http.StatusBadGateway, codeInternal, "search failed: %v", err)
+		return
+	}
+
+	reservationResp, err := s.reservationClient.CheckAvailability(ctx, &hotel.ReservationRequest{
+		HotelId:    searchResp.HotelIds,
+		InDate:     inDate,
+		OutDate:    outDate,
+		RoomNumber: 1,
+	})
+	if err != nil {
+		writeError(w, http.StatusBadGateway, codeInternal, "availability check failed: %v", err)
+		return
+	}
+
+	profileResp, err := s.profileClient.GetProfiles(ctx, &hotel.GetProfilesRequest{
+		HotelIds: reservationResp.HotelId,
+		Locale:   locale,
+		Fields:   hotelV2Fields,
+	})
+	if err != nil {
+		writeError(w, http.StatusBadGateway, codeInternal, "profile lookup failed: %v", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toHotelsV2(profileResp.Hotels, reservationResp.HotelId, nil))
+}
+
+func (s *Server) recommendV2Handler(w http.ResponseWriter, r *http.Request) {
+	ctx := outgoing(r)
+	q := r.URL.Query()
+
+	// personalized for the user of a session token, anonymous callers get
+	// the same results for everyone
+	user, err := s.bearerUser(r)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, codeUnauthenticated, "%v", err)
+		return
+	}
+
+	lat, lon, ok := parseLocation(w, r)
+	if !ok {
+		return
+	}
+	require := q.Get("require")
+	switch require {
+	case "dis", "rate", "price", "mixed", "similar":
+	default:
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "require must be one of dis, rate, price, mixed, similar")
+		return
+	}
+
+	k := 0
+	if sK := q.Get("k"); sK != "" {
+		var err error
+		if k, err = strconv.Atoi(sK); err != nil || k < 0 {
+			writeError(w, http.StatusBadRequest, codeInvalidArgument, "k must be a non-negative integer")
+			return
+		}
+	}
+	var weights [3]float64
+	for i, name := range []string{"disWeight", "rateWeight", "priceWeight"} {
+		if sW := q.Get(name); sW != "" {
+			weight, err := strconv.ParseFloat(sW, 64)
+			if err != nil || weight < 0 {
+				writeError(w, http.StatusBadRequest, codeInvalidArgument, "%v must be a non-negative number", name)
+				return
+			}
+			weights[i] = weight
+		}
+	}
+	locale := q.Get("locale")
+	if locale == "" {
+		locale = "en"
+	}
+
+	recResp, err := s.recommendationClient.GetRecommendations(ctx, &hotel.GetRecommendationsRequest{
+		Require:     require,
+		Lat:         lat,
+		Lon:         lon,
+		K:           int32(k),
+		DisWeight:   weights[0],
+		RateWeight:  weights[1],
+		PriceWeight: weights[2],
+		Username:    user.Username,
+	})
+	if err != nil {
+		writeError(w, http.StatusBadGateway, codeInternal, "recommendation failed: %v", err)
+		return
+	}
+
+	profileResp, err := s.profileClient.GetProfiles(ctx, &hotel.GetProfilesRequest{
+		HotelIds: recResp.HotelIds,
+		Locale:   locale,
+		Fields:   hotelV2Fields,
+	})
+	if err != nil {
+		writeError(w, http.StatusBadGateway, codeInternal, "profile lookup failed: %v", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toHotelsV2(profileResp.Hotels, recResp.HotelIds, recResp.Scores))
+}
+
+func (s *Server) loginV2Handler(w http.ResponseWriter, r *http.Request) {
+	var req credentialsV2
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Username == "" || req.Password == "" {
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "username and password are required")
+		return
+	}
+
+	recResp, err := s.userClient.CheckUser(outgoing(r), &hotel.CheckUserRequest{
+		Username: req.Username,
+		Password: req.Password,
+	})
+	if err != nil {
+		writeError(w, http.StatusBadGateway, codeInternal, "login failed: %v", err)
+		return
+	}
+	if writeLockedOut(w, recResp.RetryAfter) {
+		return
+	}
+	if !recResp.Correct {
+		writeError(w, http.StatusUnauthorized, codeUnauthenticated, "wrong username or password")
+		return
+	}
+
+	token, expires, err := s.newToken(checkedUser(req.Username, recResp), recResp.PasswordChanged)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, codeInternal, "failed to issue token: %v", err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, &sessionV2{Token: token, ExpiresAt: expires.UTC()})
+}
+
+func (s *Server) registerV2Handler(w http.ResponseWriter, r *http.Request) {
+	var req credentialsV2
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Username == "" || req.Password == "" {
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "username and password are required")
+		return
+	}
+
+	regResp, err := s.userClient.RegisterUser(outgoing(r), &hotel.RegisterUserRequest{
+		Username: req.Username,
+		Password: req.Password,
+	})
+	if err != nil {
+		writeError(w, http.StatusBadGateway, codeInternal, "registration failed: %v", err)
+		return
+	}
+	if !regResp.Registered {
+		writeError(w, http.StatusConflict, codeConflict, "username %q is taken", req.Username)
+		return
+	}
+
+	w.Header().Set("Location", "/api/v2/users/"+req.Username)
+	writeJSON(w, http.StatusCreated, &userV2{Username: req.Username})
+}
+
+func (s *Server) changePasswordV2Handler(w http.ResponseWriter, r *http.Request) {
+	var req passwordChangeV2
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Password == "" || req.NewPassword == "" {
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "password and newPassword are required")
+		return
+	}
+
+	chResp, err := s.userClient.ChangePassword(outgoing(r), &hotel.ChangePasswordRequest{
+		Username:    r.PathValue("username"),
+		Password:    req.Password,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		writeError(w, http.StatusBadGateway, codeInternal, "password change failed: %v", err)
+		return
+	}
+	if writeLockedOut(w, chResp.RetryAfter) {
+		return
+	}
+	if !chResp.Changed {
+		writeError(w, http.StatusUnauthorized, codeUnauthenticated, "wrong username or password")
+		return
+	}
+	s.sessions.forget(r.PathValue("username"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteUserV2Handler(w http.ResponseWriter, r *http.Request) {
+	var req passwordV2
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Password == "" {
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "password is required")
+		return
+	}
+
+	delResp, err := s.userClient.DeleteUser(outgoing(r), &hotel.DeleteUserRequest{
+		Username: r.PathValue("username"),
+		Password: req.Password,
+	})
+	if err != nil {
+		writeError(w, http.StatusBadGateway, codeInternal, "deletion failed: %v", err)
+		return
+	}
+	if writeLockedOut(w, delResp.RetryAfter) {
+		return
+	}
+	if !delResp.Deleted {
+		writeError(w, http.StatusUnauthorized, codeUnauthenticated, "wrong username or password")
+		return
+	}
+	s.sessions.forget(r.PathValue("username"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// reservationV2Handler books rooms for the user of the session token. Retries
+// sent with the same Idempotency-Key get the response of the first attempt.
+func (s *Server) reservationV2Handler(w http.ResponseWriter, r *http.Request) {
+	user, err := s.bearerUser(r)
+	if err == nil && user.Username == "" {
+		err = errors.New("a session token is required")
+	}
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, codeUnauthenticated, "%v", err)
+		return
+	}
+
+	var req reservationV2
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.CustomerName == "" {
+		req.CustomerName = user.Username
+	}
+	switch {
+	case req.HotelId == "":
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "hotelId is required")
+		return
+	case !checkDataFormat(req.InDate) || !checkDataFormat(req.OutDate):
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "inDate and outDate must be dates (YYYY-MM-DD)")
+		return
+	case req.OutDate <= req.InDate:
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "outDate must be after inDate")
+		return
+	case req.Rooms <= 0:
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "rooms must be positive")
+		return
+	case req.CustomerName != user.Username:
+		writeError(w, http.StatusForbidden, codePermission, "customerName does not match the authenticated user")
+		return
+	}
+
+	key := r.Header.Get("Idempotency-Key")
+	if key != "" {
+		body, _ := json.Marshal(&req)
+		fingerprint := fmt.Sprintf("%x", sha256.Sum256(body))
+		// keys are scoped to the user, so users cannot replay each other's bookings
+		key = user.Username + "\x00" + key
+
+		prev, mismatch := s.idempotency.begin(key, fingerprint)
+		if mismatch {
+			writeError(w, http.StatusUnprocessableEntity, codeIdempotency, "Idempotency-Key was used for a different request")
+			return
+		}
+		if prev != nil {
+			select {
+			case <-prev.done:
+			case <-r.Context().Done():
+				return
+			}
+			if prev.status == 0 {
+				writeError(w, http.StatusConflict, codeConflict, "a request with this Idempotency-Key failed, retry it")
+				return
+			}
+			w.Header().Set("Idempotent-Replayed", "true")
+			writeJSON(w, prev.status, prev.body)
+			return
+		}
+	}
+
+	ctx := auth.NewOutgoingContext(outgoing(r), user, s.AuthKey)
+	resResp, err := s.reservationClient.MakeReservation(ctx, &hotel.ReservationRequest{
+		CustomerName: req.CustomerName,
+		HotelId:      []string{req.HotelId},
+		InDate:       req.InDate,
+		OutDate:      req.OutDate,
+		RoomNumber:   int32(req.Rooms),
+	})
+	if err != nil {
+		if key != "" {
+			s.idempotency.abort(key)
+		}
+		writeError(w, http.StatusBadGateway, codeInternal, "reservation failed: %v", err)
+		return
+	}
+
+	status, body := http.StatusCreated, any(&req)
+	if len(resResp.HotelId) == 0 {
+		status = http.StatusConflict
+		body = &apiError{apiErrorDetail{Code: codeUnavailable, Message: "no rooms left for these dates"}}
+	}
+	if key != "" {
+		s.idempotency.complete(key, status, body)
+	}
+	writeJSON(w, status, body)
+}
@@ -0,0 +1,187 @@
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	hotel "github.com/appnetorg/hotel-reservation-arpc/proto"
+)
+
+// soldOutReservationClient has no rooms left.
+type soldOutReservationClient struct {
+	fakeReservationClient
+}
+
+func (c *soldOutReservationClient) MakeReservation(ctx context.Context, req *hotel.ReservationRequest) (*hotel.ReservationResult, error) {
+	c.reqs = append(c.reqs, req)
+	return &hotel.ReservationResult{}, nil
+}
+
+func newV2Mux(s *Server) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("/", http.NotFoundHandler())
+	mux.Handle("/reservation", http.HandlerFunc(s.reservationHandler))
+	s.registerV2(mux)
+	return mux
+}
+
+func postReservation(t *testing.T, mux http.Handler, token, key, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/reservations", strings.NewReader(body))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	if key != "" {
+		r.Header.Set("Idempotency-Key", key)
+	}
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	return w
+}
+
+func errorCode(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	var res apiError
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatalf("error body %q: %v", w.Body.String(), err)
+	}
+	return res.Error.Code
+}
+
+const bookingV2 = `{"hotelId":"9","inDate":"2015-04-19","outDate":"2015-04-24","rooms":1}`
+
+func TestReservationV2Idempotent(t *testing.T) {
+	s, _, reservations := newTestServer(t)
+	mux := newV2Mux(s)
+	token, _, err := s.newToken(auth.Principal{Username: "Cornell_1", Role: auth.Guest}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := postReservation(t, mux, token, "k1", bookingV2)
+	if first.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", first.Code, http.StatusCreated, first.Body.String())
+	}
+	retry := postReservation(t, mux, token, "k1", bookingV2)
+	if retry.Code != http.StatusCreated || retry.Body.String() != first.Body.String() {
+		t.Fatalf("retry = %d %s, want the first response", retry.Code, retry.Body.String())
+	}
+	if retry.Header().Get("Idempotent-Replayed") != "true" {
+		t.Error("retry is not marked as replayed")
+	}
+	if len(reservations.reqs) != 1 {
+		t.Fatalf("MakeReservation called %d times, want 1", len(reservations.reqs))
+	}
+
+	other := postReservation(t, mux, token, "k1", strings.Replace(bookingV2, `"rooms":1`, `"rooms":2`, 1))
+	if other.Code != http.StatusUnprocessableEntity || errorCode(t, other) != codeIdempotency {
+		t.Fatalf("reused key = %d %s, want %d", other.Code, other.Body.String(), http.StatusUnprocessableEntity)
+	}
+}
+
+func TestIdempotencyStoreBound(t *testing.T) {
+	defer func(n int) { maxIdempotencyKeys = n }(maxIdempotencyKeys)
+	maxIdempotencyKeys = 3
+
+	var st idempotencyStore
+	st.begin("in flight", "f")
+	for _, key := range []string{"k1", "k2"} {
+		st.begin(key, "f")
+		st.complete(key, http.StatusCreated, nil)
+	}
+
+	// the oldest completed key makes room, the one in flight is kept
+	st.begin("k3", "f")
+	if len(st.entries) != 3 || st.order.Len() != 3 {
+		t.Fatalf("holding %d keys in order %d, want 3", len(st.entries), st.order.Len())
+	}
+	if _, ok := st.entries["k1"]; ok {
+		t.Error("kept the oldest completed key")
+	}
+	for _, key := range []string{"in flight", "k2", "k3"} {
+		if _, ok := st.entries[key]; !ok {
+			t.Errorf("forgot %q", key)
+		}
+	}
+	st.complete("in flight", http.StatusCreated, nil)
+
+	st.abort("k3")
+	if _, ok := st.entries["k3"]; ok || st.order.Len() != 2 {
+		t.Errorf("aborted key kept, %d keys in order", st.order.Len())
+	}
+}
+
+func TestReservationV2Errors(t *testing.T) {
+	s, _, _ := newTestServer(t)
+	s.reservationClient = &soldOutReservationClient{}
+	mux := newV2Mux(s)
+	token, _, err := s.newToken(auth.Principal{Username: "Cornell_1", Role: auth.Guest}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		body   string
+		status int
+		code   string
+	}{
+		{"no token", "", bookingV2, http.StatusUnauthorized, codeUnauthenticated},
+		{"bad json", token, `{"hotelId":`, http.StatusBadRequest, codeInvalidArgument},
+		{"unknown field", token, `{"hotelId":"9","nights":3}`, http.StatusBadRequest, codeInvalidArgument},
+		{"no rooms", token, strings.Replace(bookingV2, `"rooms":1`, `"rooms":0`, 1), http.StatusBadRequest, codeInvalidArgument},
+		{"other customer", token, strings.Replace(bookingV2, `{`, `{"customerName":"Cornell_2",`, 1), http.StatusForbidden, codePermission},
+		{"sold out", token, bookingV2, http.StatusConflict, codeUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := postReservation(t, mux, tt.token, "", tt.body)
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			if code := errorCode(t, w); code != tt.code {
+				t.Errorf("code = %q, want %q", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestLoginV2(t *testing.T) {
+	s, _, _ := newTestServer(t)
+	mux := newV2Mux(s)
+
+	login := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v2/sessions", strings.NewReader(body)))
+		return w
+	}
+
+	w := login(`{"username":"Cornell_1","password":"secret"}`)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusCreated, w.Body.String())
+	}
+	var sess sessionV2
+	if err := json.Unmarshal(w.Body.Bytes(), &sess); err != nil {
+		t.Fatal(err)
+	}
+	if p, _, err := s.verifyToken(sess.Token); err != nil || p.Username != "Cornell_1" {
+		t.Errorf("token is for %q (err: %v), want Cornell_1", p.Username, err)
+	}
+
+	if w := login(`{"username":"Cornell_1","password":"wrong"}`); w.Code != http.StatusUnauthorized {
+		t.Errorf("wrong password status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v2/sessions", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET status = %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+}
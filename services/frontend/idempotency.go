@@ -0,0 +1,103 @@
+package frontend
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// idempotencyTTL is how long the response of an idempotent request is replayed.
+const idempotencyTTL = 24 * time.Hour
+
+// maxIdempotencyKeys is how many keys are remembered at most. Beyond it the
+// oldest completed keys are forgotten before they expire.
+var maxIdempotencyKeys = 100000
+
+// idempotencyStore remembers the responses of requests sent with an
+// Idempotency-Key, so retried bookings are not booked twice. It is held in
+// memory by each frontend replica, so only retries reaching the replica of
+// the first attempt are replayed.
+type idempotencyStore struct {
+	mu        sync.Mutex
+	entries   map[string]*idempotentResponse
+	order     *list.List // keys in the order they were claimed, oldest first
+	lastSweep time.Time
+}
+
+type idempotentResponse struct {
+	// fingerprint of the request the key was first used with
+	fingerprint string
+	// done is closed once status and body are set
+	done    chan struct{}
+	status  int
+	body    any
+	expires time.Time
+	elem    *list.Element
+}
+
+// begin claims key for a request. It returns the stored response when the
+// key was used before, and whether the key was used for a different request.
+func (st *idempotencyStore) begin(key, fingerprint string) (prev *idempotentResponse, mismatch bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	now := time.Now()
+	if st.entries == nil {
+		st.entries = make(map[string]*idempotentResponse)
+		st.order = list.New()
+	}
+	if now.Sub(st.lastSweep) > time.Minute {
+		for k, e := range st.entries {
+			if !e.expires.IsZero() && now.After(e.expires) {
+				st.remove(k, e)
+			}
+		}
+		st.lastSweep = now
+	}
+
+	if e, ok := st.entries[key]; ok {
+		return e, e.fingerprint != fingerprint
+	}
+	if len(st.entries) >= maxIdempotencyKeys {
+		st.evict()
+	}
+	e := &idempotentResponse{fingerprint: fingerprint, done: make(chan struct{})}
+	e.elem = st.order.PushBack(key)
+	st.entries[key] = e
+	return nil, false
+}
+
+// evict forgets the oldest completed key. Keys of requests in flight are
+// kept, their requests complete or abort them.
+func (st *idempotencyStore) evict() {
+	for elem := st.order.Front(); elem != nil; elem = elem.Next() {
+		key := elem.Value.(string)
+		if e := st.entries[key]; !e.expires.IsZero() {
+			st.remove(key, e)
+			return
+		}
+	}
+}
+
+func (st *idempotencyStore) remove(key string, e *idempotentResponse) {
+	st.order.Remove(e.elem)
+	delete(st.entries, key)
+}
+
+// complete stores the response of a claimed key.
+func (st *idempotencyStore) complete(key string, status int, body any) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	e := st.entries[key]
+	e.status, e.body, e.expires = status, body, time.Now().Add(idempotencyTTL)
+	close(e.done)
+}
+
+// abort releases a claimed key so the request can be retried.
+func (st *idempotencyStore) abort(key string) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	e := st.entries[key]
+	st.remove(key, e)
+	close(e.done)
+}
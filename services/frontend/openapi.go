@@ -0,0 +1,89 @@
+package frontend
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+// openAPISpec describes the HTTP API of the frontend. Handlers and the spec
+// are kept in sync by the contract tests.
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// loadSpec parses and checks openAPISpec.
+func loadSpec() (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(openAPISpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenAPI spec: %v", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI spec: %v", err)
+	}
+	return doc, nil
+}
+
+func specHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Write(openAPISpec)
+}
+
+// validateRequests rejects requests that do not match the spec with 400.
+// Requests for paths or methods the spec does not describe, like the static
+// files, are passed on unchecked.
+func validateRequests(doc *openapi3.T, next http.Handler) (http.Handler, error) {
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to route OpenAPI spec: %v", err)
+	}
+	options := &openapi3filter.Options{
+		// session tokens are checked by the handlers
+		AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+		SkipSettingDefaults: true,
+	}
+	// report what is wrong without dumping the schema
+	options.WithCustomSchemaErrorFunc(func(err *openapi3.SchemaError) string {
+		if ptr := err.JSONPointer(); len(ptr) > 0 {
+			return fmt.Sprintf("%v: %v", strings.Join(ptr, "."), err.Reason)
+		}
+		return err.Reason
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := router.FindRoute(r)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		err = openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    options,
+		})
+		if err != nil {
+			badRequest(w, route, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	}), nil
+}
+
+// badRequest replies in the error format of the API version of route.
+func badRequest(w http.ResponseWriter, route *routers.Route, err error) {
+	if strings.HasPrefix(route.Path, "/api/v2/") {
+		writeError(w, http.StatusBadRequest, codeInvalidArgument, "%v", err)
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
@@ -0,0 +1,283 @@
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	hotel "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+type fakeSearchClient struct {
+	hotel.SearchClient
+}
+
+func (c *fakeSearchClient) Nearby(ctx context.Context, req *hotel.SearchRequest) (*hotel.SearchResult, error) {
+	return &hotel.SearchResult{HotelIds: []string{"1", "2"}}, nil
+}
+
+type fakeProfileClient struct {
+	hotel.ProfileClient
+}
+
+func (c *fakeProfileClient) GetProfiles(ctx context.Context, req *hotel.GetProfilesRequest) (*hotel.GetProfilesResult, error) {
+	res := &hotel.GetProfilesResult{}
+	for _, id := range req.HotelIds {
+		res.Hotels = append(res.Hotels, &hotel.Hotel{
+			Id:          id,
+			Name:        "Hotel " + id,
+			PhoneNumber: "(415) 284-40" + id,
+			Address:     &hotel.Address{City: "San Francisco", Lat: 37.7867, Lon: -122.4112},
+		})
+	}
+	return res, nil
+}
+
+type fakeRecommendationClient struct {
+	hotel.RecommendationClient
+	usernames []string // the users recommendations were personalized for
+}
+
+func (c *fakeRecommendationClient) GetRecommendations(ctx context.Context, req *hotel.GetRecommendationsRequest) (*hotel.GetRecommendationsResult, error) {
+	c.usernames = append(c.usernames, req.Username)
+	return &hotel.GetRecommendationsResult{HotelIds: []string{"2", "1"}, Scores: []float64{0.9, 0.4}}, nil
+}
+
+// newContractServer returns a server with fake clients behind the request
+// validation, as Run serves it.
+func newContractServer(t *testing.T) (*Server, http.Handler) {
+	t.Helper()
+	s, _, _ := newTestServer(t)
+	s.searchClient = &fakeSearchClient{}
+	s.profileClient = &fakeProfileClient{}
+	s.recommendationClient = &fakeRecommendationClient{}
+
+	doc, err := loadSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/", http.NotFoundHandler())
+	s.registerRoutes(mux)
+	handler, err := validateRequests(doc, mux)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, handler
+}
+
+// patterns records the patterns routes are registered with.
+type patterns []string
+
+func (p *patterns) Handle(pattern string, handler http.Handler) {
+	*p = append(*p, pattern)
+}
+
+// TestSpecRoutes checks that every route is in the spec and every operation
+// of the spec is routed to a handler.
+func TestSpecRoutes(t *testing.T) {
+	doc, err := loadSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _, _ := newTestServer(t)
+
+	var registered patterns
+	s.registerRoutes(&registered)
+	// paths routed by method answer other methods with 405
+	byMethod := make(map[string]bool)
+	for _, pattern := range registered {
+		if _, path, ok := strings.Cut(pattern, " "); ok {
+			byMethod[path] = true
+		}
+	}
+	for _, pattern := range registered {
+		method, path, ok := strings.Cut(pattern, " ")
+		if !ok {
+			method, path = "", pattern
+		}
+		if path == "/openapi.json" || path == "/api/v2/" {
+			continue
+		}
+		item := doc.Paths.Find(path)
+		if item == nil {
+			t.Errorf("route %q is not in the spec", pattern)
+			continue
+		}
+		if method == "" && !byMethod[path] {
+			// the other v1 handlers ignore the method
+			for _, m := range []string{http.MethodGet, http.MethodPost} {
+				if item.GetOperation(m) == nil {
+					t.Errorf("%v %v is not in the spec", m, path)
+				}
+			}
+		} else if method != "" && item.GetOperation(method) == nil {
+			t.Errorf("route %q is not in the spec", pattern)
+		}
+	}
+
+	mux := http.NewServeMux()
+	s.registerRoutes(mux)
+	for path, item := range doc.Paths.Map() {
+		for method := range item.Operations() {
+			url := strings.ReplaceAll(path, "{username}", "Cornell_1")
+			_, pattern := mux.Handler(httptest.NewRequest(method, url, nil))
+			want := path
+			if byMethod[path] {
+				want = method + " " + path
+			}
+			if pattern != want {
+				t.Errorf("%v %v is routed to %q, want %q", method, path, pattern, want)
+			}
+		}
+	}
+}
+
+// TestContract sends requests through the validation to the handlers and
+// checks the responses against the spec.
+func TestContract(t *testing.T) {
+	s, handler := newContractServer(t)
+	token, _, err := s.newToken(auth.Principal{Username: "Cornell_1", Role: auth.Guest}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changing, _, err := s.newToken(auth.Principal{Username: "Cornell_4", Role: auth.Guest}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := loadSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the token stays valid as Cornell_3 and Cornell_4 change their passwords
+	// and Cornell_3 is deleted
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   string
+		token  string
+		status int
+	}{
+		{"hotels", "GET", "/hotels?inDate=2015-04-09&outDate=2015-04-10&lat=38.0235&lon=-122.095", "", "", 200},
+		{"hotels without lat", "GET", "/hotels?inDate=2015-04-09&outDate=2015-04-10&lon=-122.095", "", "", 400},
+		{"hotels bad date", "GET", "/hotels?inDate=9-4-2015&outDate=2015-04-10&lat=38.0235&lon=-122.095", "", "", 400},
+		{"recommendations", "GET", "/recommendations?require=rate&lat=38.0235&lon=-122.095", "", "", 200},
+		{"recommendations bad require", "GET", "/recommendations?require=cheap&lat=38.0235&lon=-122.095", "", "", 400},
+		{"recommendations bad k", "GET", "/recommendations?require=mixed&lat=38.0235&lon=-122.095&k=-1", "", "", 400},
+		{"user", "POST", "/user", "username=Cornell_1&password=secret", "", 200},
+		{"user without password", "POST", "/user", "username=Cornell_1", "", 400},
+		{"login", "POST", "/login", "username=Cornell_1&password=secret", "", 200},
+		{"login wrong password", "POST", "/login", "username=Cornell_1&password=wrong", "", 401},
+		{"login in the query", "POST", "/login?username=Cornell_1&password=secret", "", "", 400},
+		{"register", "POST", "/user/register", "username=Cornell_2&password=secret", "", 200},
+		{"register taken", "POST", "/user/register", "username=taken&password=secret", "", 409},
+		{"change password", "POST", "/user/password", "username=Cornell_3&password=secret&newPassword=new", "", 200},
+		{"change password without new one", "POST", "/user/password", "username=Cornell_3&password=secret", "", 400},
+		{"change password with token", "POST", "/user/password", "password=secret&newPassword=new", changing, 200},
+		{"change password of another user", "POST", "/user/password", "username=Cornell_2&password=secret&newPassword=new", token, 403},
+		{"change password without user", "POST", "/user/password", "password=secret&newPassword=new", "", 400},
+		{"delete user", "POST", "/user/delete", "username=Cornell_3&password=secret", "", 200},
+		{"delete user of another user", "POST", "/user/delete", "username=Cornell_2&password=secret", token, 403},
+		{"delete user with a forged token", "POST", "/user/delete", "password=secret", "forged", 401},
+		{"reservation", "POST", reservationURL + "&customerName=Cornell_1&username=Cornell_1&password=secret", "", "", 200},
+		{"reservation with token", "POST", reservationURL, "", token, 200},
+		{"reservation other customer", "POST", reservationURL + "&customerName=Cornell_2", "", token, 403},
+		{"reservation without hotel", "POST", "/reservation?inDate=2015-04-19&outDate=2015-04-24", "", token, 400},
+		{"v2 hotels", "GET", "/api/v2/hotels?inDate=2015-04-09&outDate=2015-04-10&lat=38.0235&lon=-122.095", "", "", 200},
+		{"v2 hotels bad lat", "GET", "/api/v2/hotels?inDate=2015-04-09&outDate=2015-04-10&lat=91&lon=-122.095", "", "", 400},
+		{"v2 recommendations", "GET", "/api/v2/recommendations?require=mixed&lat=38.0235&lon=-122.095&k=1&rateWeight=2", "", "", 200},
+		{"v2 session", "POST", "/api/v2/sessions", `{"username":"Cornell_1","password":"secret"}`, "", 201},
+		{"v2 session wrong password", "POST", "/api/v2/sessions", `{"username":"Cornell_1","password":"wrong"}`, "", 401},
+		{"v2 session without password", "POST", "/api/v2/sessions", `{"username":"Cornell_1"}`, "", 400},
+		{"v2 register", "POST", "/api/v2/users", `{"username":"Cornell_2","password":"secret"}`, "", 201},
+		{"v2 register taken", "POST", "/api/v2/users", `{"username":"taken","password":"secret"}`, "", 409},
+		{"v2 change password", "PUT", "/api/v2/users/Cornell_3/password", `{"password":"secret","newPassword":"new"}`, "", 204},
+		{"v2 change password unknown field", "PUT", "/api/v2/users/Cornell_3/password", `{"password":"secret","newPassword":"new","old":"x"}`, "", 400},
+		{"v2 delete user", "DELETE", "/api/v2/users/Cornell_3", `{"password":"secret"}`, "", 204},
+		{"v2 delete user wrong password", "DELETE", "/api/v2/users/Cornell_3", `{"password":"wrong"}`, "", 401},
+		{"v2 reservation", "POST", "/api/v2/reservations", bookingV2, token, 201},
+		{"v2 reservation without token", "POST", "/api/v2/reservations", bookingV2, "", 401},
+		{"v2 reservation no rooms", "POST", "/api/v2/reservations", strings.Replace(bookingV2, `"rooms":1`, `"rooms":0`, 1), token, 400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newRequest := func() *http.Request {
+				r := httptest.NewRequest(tt.method, tt.url, strings.NewReader(tt.body))
+				if strings.HasPrefix(tt.body, "{") {
+					r.Header.Set("Content-Type", "application/json")
+				} else if tt.body != "" {
+					r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				}
+				if tt.token != "" {
+					r.Header.Set("Authorization", "Bearer "+tt.token)
+				}
+				return r
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, newRequest())
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+
+			r := newRequest()
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			input := &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{
+					Request:    r,
+					PathParams: pathParams,
+					Route:      route,
+				},
+				Status:  w.Code,
+				Header:  w.Header(),
+				Options: &openapi3filter.Options{IncludeResponseStatus: true},
+			}
+			input.SetBodyBytes(w.Body.Bytes())
+			if err := openapi3filter.ValidateResponse(context.Background(), input); err != nil {
+				t.Errorf("response does not match the spec: %v\n%s", err, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestValidationPassesUnknownPaths(t *testing.T) {
+	_, handler := newContractServer(t)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("/openapi.json status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if w.Body.String() != string(openAPISpec) {
+		t.Error("/openapi.json does not serve the spec")
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/index.html", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("static file status = %d, want it passed on to the mux", w.Code)
+	}
+
+	for _, path := range []string{"/api/v2/sessions", "/login", "/user/password"} {
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path+"?username=Cornell_1&password=secret", nil))
+		if w.Code != http.StatusMethodNotAllowed {
+			t.Errorf("GET %v status = %d, want %d", path, w.Code, http.StatusMethodNotAllowed)
+		}
+	}
+}
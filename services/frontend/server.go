@@ -1,20 +1,23 @@
 package frontend
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/appnet-org/arpc/pkg/metadata"
 
-	"github.com/appnet-org/arpc/pkg/rpc"
-	"github.com/appnet-org/arpc/pkg/serializer"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
 	hotel "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/grpcpb"
 	"github.com/appnetorg/hotel-reservation-arpc/tls"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
 	"github.com/rs/zerolog/log"
 
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	"github.com/appnetorg/hotel-reservation-arpc/tracing"
 	"github.com/opentracing/opentracing-go"
 )
@@ -26,10 +29,20 @@ type Server struct {
 	recommendationClient hotel.RecommendationClient
 	userClient           hotel.UserClient
 	reservationClient    hotel.ReservationClient
+	idempotency          idempotencyStore
+	sessions             sessionChecks
 	KnativeDns           string
 	IpAddr               string
 	Port                 int
 	Tracer               opentracing.Tracer
+	// SessionKeys sign and verify session tokens. The first key signs, all
+	// keys verify so keys can be rotated.
+	SessionKeys [][]byte
+	SessionTTL  time.Duration
+	// AuthKey signs the user attached to downstream calls.
+	AuthKey []byte
+	// Transport picks aRPC or gRPC for the calls to each service.
+	Transport *transport.Config
 }
 
 // Run the server
@@ -38,6 +51,10 @@ func (s *Server) Run() error {
 		return fmt.Errorf("Server port must be set")
 	}
 
+	if err := s.initSessionKeys(); err != nil {
+		return err
+	}
+
 	if err := s.initSearchClient("search.default.svc.cluster.local:11002"); err != nil {
 		return err
 	}
@@ -58,17 +75,22 @@ func (s *Server) Run() error {
 		return err
 	}
 
+	doc, err := loadSpec()
+	if err != nil {
+		return err
+	}
 	mux := tracing.NewServeMux(s.Tracer)
 	mux.Handle("/", http.FileServer(http.Dir("services/frontend/static")))
-	mux.Handle("/hotels", http.HandlerFunc(s.searchHandler))
-	mux.Handle("/recommendations", http.HandlerFunc(s.recommendHandler))
-	mux.Handle("/user", http.HandlerFunc(s.userHandler))
-	mux.Handle("/reservation", http.HandlerFunc(s.reservationHandler))
+	s.registerRoutes(mux)
+	handler, err := validateRequests(doc, mux)
+	if err != nil {
+		return err
+	}
 
 	tlsconfig := tls.GetHttpsOpt()
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", s.Port),
-		Handler: mux,
+		Handler: logs.Middleware(handler),
 	}
 	if tlsconfig != nil {
 		log.Info().Msg("Serving https")
@@ -80,69 +102,68 @@ func (s *Server) Run() error {
 	}
 }
 
-func (s *Server) initSearchClient(name string) error {
-	serializer := &serializer.SymphonySerializer{}
+// registerRoutes adds the API routes, which are described by openapi.json.
+func (s *Server) registerRoutes(mux interface {
+	Handle(pattern string, handler http.Handler)
+}) {
+	mux.Handle("/openapi.json", http.HandlerFunc(specHandler))
+	mux.Handle("/hotels", http.HandlerFunc(s.searchHandler))
+	mux.Handle("/recommendations", http.HandlerFunc(s.recommendHandler))
+	mux.Handle("/reservation", http.HandlerFunc(s.reservationHandler))
 
-	client, err := rpc.NewClientWithLocalAddr(serializer, name, "0.0.0.0:0", nil)
-	if err != nil {
-		return fmt.Errorf("failed to create search aRPC client: %v", err)
-	}
+	// the routes of the user service take the passwords in a POST form body,
+	// keeping them out of URLs and access logs
+	for _, rt := range []struct {
+		path    string
+		handler http.HandlerFunc
+	}{
+		{"/login", s.loginHandler},
+		{"/user", s.userHandler},
+		{"/user/register", s.registerHandler},
+		{"/user/password", s.changePasswordHandler},
+		{"/user/delete", s.deleteUserHandler},
+	} {
+		mux.Handle(http.MethodPost+" "+rt.path, rt.handler)
+		mux.Handle(rt.path, http.HandlerFunc(postOnly))
+	}
+	s.registerV2(mux)
+}
 
-	s.searchClient = hotel.NewSearchClient(client)
-	return nil
+func (s *Server) initSearchClient(name string) error {
+	var err error
+	s.searchClient, err = transport.Dial(s.Transport, "frontend", "search", name, slices.Concat(tracing.ClientElements(s.Tracer), logs.ClientElements()), hotel.NewSearchClient, grpcpb.NewSearch)
+	return err
 }
 
 func (s *Server) initProfileClient(name string) error {
-	serializer := &serializer.SymphonySerializer{}
-
-	client, err := rpc.NewClientWithLocalAddr(serializer, name, "0.0.0.0:0", nil)
-	if err != nil {
-		return fmt.Errorf("failed to create profile aRPC client: %v", err)
-	}
-
-	s.profileClient = hotel.NewProfileClient(client)
-	return nil
+	var err error
+	s.profileClient, err = transport.Dial(s.Transport, "frontend", "profile", name, slices.Concat(tracing.ClientElements(s.Tracer), logs.ClientElements()), hotel.NewProfileClient, grpcpb.NewProfile)
+	return err
 }
 
 func (s *Server) initRecommendationClient(name string) error {
-	serializer := &serializer.SymphonySerializer{}
-
-	client, err := rpc.NewClientWithLocalAddr(serializer, name, "0.0.0.0:0", nil)
-	if err != nil {
-		return fmt.Errorf("failed to create recommendation aRPC client: %v", err)
-	}
-	s.recommendationClient = hotel.NewRecommendationClient(client)
-	return nil
+	var err error
+	s.recommendationClient, err = transport.Dial(s.Transport, "frontend", "recommendation", name, slices.Concat(tracing.ClientElements(s.Tracer), logs.ClientElements()), hotel.NewRecommendationClient, grpcpb.NewRecommendation)
+	return err
 }
 
 func (s *Server) initUserClient(name string) error {
-	serializer := &serializer.SymphonySerializer{}
-
-	client, err := rpc.NewClientWithLocalAddr(serializer, name, "0.0.0.0:0", nil)
-	if err != nil {
-		return fmt.Errorf("failed to create user aRPC client: %v", err)
-	}
-	s.userClient = hotel.NewUserClient(client)
-	return nil
+	var err error
+	s.userClient, err = transport.Dial(s.Transport, "frontend", "user", name, slices.Concat(tracing.ClientElements(s.Tracer), logs.ClientElements()), hotel.NewUserClient, grpcpb.NewUser)
+	return err
 }
 
 func (s *Server) initReservation(name string) error {
-	serializer := &serializer.SymphonySerializer{}
-
-	client, err := rpc.NewClientWithLocalAddr(serializer, name, "0.0.0.0:0", nil)
-	if err != nil {
-		return fmt.Errorf("failed to create reservation aRPC client: %v", err)
-	}
-	s.reservationClient = hotel.NewReservationClient(client)
-	return nil
+	var err error
+	s.reservationClient, err = transport.Dial(s.Transport, "frontend", "reservation", name, slices.Concat(tracing.ClientElements(s.Tracer), logs.ClientElements()), hotel.NewReservationClient, grpcpb.NewReservation)
+	return err
 }
 
 func (s *Server) searchHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
-	// ctx := r.Context()
-
+	w.Header().Set("Content-Type", "application/json")
 	md := metadata.New(map[string]string{})
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx := metadata.NewOutgoingContext(r.Context(), md)
 
 	// in/out dates from query params
 	inDate, outDate := r.URL.Query().Get("inDate"), r.URL.Query().Get("outDate")
@@ -197,6 +218,7 @@ func (s *Server) searchHandler(w http.ResponseWriter, r *http.Request) {
 	profileResp, err := s.profileClient.GetProfiles(ctx, &hotel.GetProfilesRequest{
 		HotelIds: reservationResp.HotelId,
 		Locale:   locale,
+		Fields:   geoJSONFields,
 	})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -208,6 +230,7 @@ func (s *Server) searchHandler(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) recommendHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Content-Type", "application/json")
 	ctx := r.Context()
 
 	sLat, sLon := r.URL.Query().Get("lat"), r.URL.Query().Get("lon")
@@ -221,16 +244,52 @@ func (s *Server) recommendHandler(w http.ResponseWriter, r *http.Request) {
 	lon := float64(Lon)
 
 	require := r.URL.Query().Get("require")
-	if require != "dis" && require != "rate" && require != "price" {
+	if require != "dis" && require != "rate" && require != "price" && require != "mixed" && require != "similar" {
 		http.Error(w, "Please specify require params", http.StatusBadRequest)
 		return
 	}
 
+	// personalized for the user of a session token, anonymous callers get
+	// the same results for everyone
+	user, err := s.bearerUser(r)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	// optional number of hotels and criteria weights for mixed
+	k := 0
+	if sK := r.URL.Query().Get("k"); sK != "" {
+		var err error
+		k, err = strconv.Atoi(sK)
+		if err != nil || k < 0 {
+			http.Error(w, "Please check k params", http.StatusBadRequest)
+			return
+		}
+	}
+	var weights [3]float64
+	for i, name := range []string{"disWeight", "rateWeight", "priceWeight"} {
+		if sW := r.URL.Query().Get(name); sW != "" {
+			weight, err := strconv.ParseFloat(sW, 64)
+			if err != nil || weight < 0 {
+				http.Error(w, "Please check "+name+" params", http.StatusBadRequest)
+				return
+			}
+			weights[i] = weight
+		}
+	}
+
 	// recommend hotels
 	recResp, err := s.recommendationClient.GetRecommendations(ctx, &hotel.GetRecommendationsRequest{
-		Require: require,
-		Lat:     float64(lat),
-		Lon:     float64(lon),
+		Require:     require,
+		Lat:         float64(lat),
+		Lon:         float64(lon),
+		K:           int32(k),
+		DisWeight:   weights[0],
+		RateWeight:  weights[1],
+		PriceWeight: weights[2],
+		Username:    user.Username,
 	})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -247,6 +306,7 @@ func (s *Server) recommendHandler(w http.ResponseWriter, r *http.Request) {
 	profileResp, err := s.profileClient.GetProfiles(ctx, &hotel.GetProfilesRequest{
 		HotelIds: recResp.HotelIds,
 		Locale:   locale,
+		Fields:   geoJSONFields,
 	})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -258,12 +318,10 @@ func (s *Server) recommendHandler(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) userHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
-	// ctx := r.Context()
+	w.Header().Set("Content-Type", "application/json")
+	ctx := outgoing(r)
 
-	md := metadata.New(map[string]string{})
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
-
-	username, password := r.URL.Query().Get("username"), r.URL.Query().Get("password")
+	username, password := r.PostFormValue("username"), r.PostFormValue("password")
 	if username == "" || password == "" {
 		http.Error(w, "Please specify username and password", http.StatusBadRequest)
 		return
@@ -279,6 +337,10 @@ func (s *Server) userHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if lockedOut(w, recResp.RetryAfter) {
+		return
+	}
+
 	str := "Login successfully!"
 	if recResp.Correct == false {
 		str = "Failed. Please check your username and password. "
@@ -291,12 +353,221 @@ func (s *Server) userHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
+func (s *Server) loginHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := outgoing(r)
+
+	username, password := r.PostFormValue("username"), r.PostFormValue("password")
+	if username == "" || password == "" {
+		http.Error(w, "Please specify username and password", http.StatusBadRequest)
+		return
+	}
+
+	recResp, err := s.userClient.CheckUser(ctx, &hotel.CheckUserRequest{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if lockedOut(w, recResp.RetryAfter) {
+		return
+	}
+
+	if !recResp.Correct {
+		http.Error(w, "Failed. Please check your username and password. ", http.StatusUnauthorized)
+		return
+	}
+
+	token, expires, err := s.newToken(checkedUser(username, recResp), recResp.PasswordChanged)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res := map[string]interface{}{
+		"message":   "Login successfully!",
+		"token":     token,
+		"expiresAt": expires.Format(time.RFC3339),
+	}
+
+	json.NewEncoder(w).Encode(res)
+}
+
+func (s *Server) registerHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := outgoing(r)
+
+	username, password := r.PostFormValue("username"), r.PostFormValue("password")
+	if username == "" || password == "" {
+		http.Error(w, "Please specify username and password", http.StatusBadRequest)
+		return
+	}
+
+	regResp, err := s.userClient.RegisterUser(ctx, &hotel.RegisterUserRequest{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if !regResp.Registered {
+		http.Error(w, "Failed. Username already taken. ", http.StatusConflict)
+		return
+	}
+
+	res := map[string]interface{}{
+		"message": "Register successfully!",
+	}
+
+	json.NewEncoder(w).Encode(res)
+}
+
+func (s *Server) changePasswordHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := outgoing(r)
+
+	username, ok := s.formUser(w, r)
+	if !ok {
+		return
+	}
+	password, newPassword := r.PostFormValue("password"), r.PostFormValue("newPassword")
+	if username == "" || password == "" || newPassword == "" {
+		http.Error(w, "Please specify username, password and newPassword", http.StatusBadRequest)
+		return
+	}
+
+	chResp, err := s.userClient.ChangePassword(ctx, &hotel.ChangePasswordRequest{
+		Username:    username,
+		Password:    password,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if lockedOut(w, chResp.RetryAfter) {
+		return
+	}
+
+	str := "Change password successfully!"
+	if !chResp.Changed {
+		str = "Failed. Please check your username and password. "
+	} else {
+		s.sessions.forget(username)
+	}
+
+	res := map[string]interface{}{
+		"message": str,
+	}
+
+	json.NewEncoder(w).Encode(res)
+}
+
+func (s *Server) deleteUserHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := outgoing(r)
+
+	username, ok := s.formUser(w, r)
+	if !ok {
+		return
+	}
+	password := r.PostFormValue("password")
+	if username == "" || password == "" {
+		http.Error(w, "Please specify username and password", http.StatusBadRequest)
+		return
+	}
+
+	delResp, err := s.userClient.DeleteUser(ctx, &hotel.DeleteUserRequest{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if lockedOut(w, delResp.RetryAfter) {
+		return
+	}
+
+	str := "Delete user successfully!"
+	if !delResp.Deleted {
+		str = "Failed. Please check your username and password. "
+	} else {
+		s.sessions.forget(username)
+	}
+
+	res := map[string]interface{}{
+		"message": str,
+	}
+
+	json.NewEncoder(w).Encode(res)
+}
+
+// formUser returns the user a form changes: the user of the session token,
+// else the username of the form. The password is asked for either way, the
+// user service checks it before changing the user.
+func (s *Server) formUser(w http.ResponseWriter, r *http.Request) (string, bool) {
+	user, err := s.bearerUser(r)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return "", false
+	}
+	username := r.PostFormValue("username")
+	if user.Username == "" {
+		return username, true
+	}
+	if username != "" && username != user.Username {
+		http.Error(w, "Failed. The session token is for another user. ", http.StatusForbidden)
+		return "", false
+	}
+	return user.Username, true
+}
+
+// postOnly rejects the methods other than POST of routes taking passwords.
+func postOnly(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Allow", http.MethodPost)
+	http.Error(w, "Please POST the username and password as a form", http.StatusMethodNotAllowed)
+}
+
+// lockedOut replies with 429 when the user service locked the user out.
+func lockedOut(w http.ResponseWriter, retryAfter int32) bool {
+	if retryAfter <= 0 {
+		return false
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter)))
+	http.Error(w, "Failed. Too many attempts, please try again later. ", http.StatusTooManyRequests)
+	return true
+}
+
 func (s *Server) reservationHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
-	// ctx := r.Context()
+	w.Header().Set("Content-Type", "application/json")
+	ctx := outgoing(r)
 
-	md := metadata.New(map[string]string{})
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	// a session token replaces the username and password
+	user, err := s.bearerUser(r)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
 
 	inDate, outDate := r.URL.Query().Get("inDate"), r.URL.Query().Get("outDate")
 	if inDate == "" || outDate == "" {
@@ -315,15 +586,21 @@ func (s *Server) reservationHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	customerName := r.URL.Query().Get("customerName")
-	if customerName == "" {
-		http.Error(w, "Please specify customerName params", http.StatusBadRequest)
-		return
+	username, password := user.Username, ""
+	if username == "" {
+		username, password = r.URL.Query().Get("username"), r.URL.Query().Get("password")
+		if username == "" || password == "" {
+			http.Error(w, "Please specify username and password", http.StatusBadRequest)
+			return
+		}
 	}
 
-	username, password := r.URL.Query().Get("username"), r.URL.Query().Get("password")
-	if username == "" || password == "" {
-		http.Error(w, "Please specify username and password", http.StatusBadRequest)
+	// users can only book for themselves
+	customerName := r.URL.Query().Get("customerName")
+	if customerName == "" {
+		customerName = username
+	} else if customerName != username {
+		http.Error(w, "Failed. customerName does not match the authenticated user. ", http.StatusForbidden)
 		return
 	}
 
@@ -333,20 +610,28 @@ func (s *Server) reservationHandler(w http.ResponseWriter, r *http.Request) {
 		numberOfRoom, _ = strconv.Atoi(num)
 	}
 
-	// Check username and password
-	recResp, err := s.userClient.CheckUser(ctx, &hotel.CheckUserRequest{
-		Username: username,
-		Password: password,
-	})
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
+	if user.Username == "" {
+		// Check username and password
+		recResp, err := s.userClient.CheckUser(ctx, &hotel.CheckUserRequest{
+			Username: username,
+			Password: password,
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
 
-	str := "Reserve successfully!"
-	if recResp.Correct == false {
-		str = "Failed. Please check your username and password. "
+		if lockedOut(w, recResp.RetryAfter) {
+			return
+		}
+
+		if !recResp.Correct {
+			http.Error(w, "Failed. Please check your username and password. ", http.StatusUnauthorized)
+			return
+		}
+		user = checkedUser(username, recResp)
 	}
+	ctx = auth.NewOutgoingContext(ctx, user, s.AuthKey)
 
 	// Make reservation
 	resResp, err := s.reservationClient.MakeReservation(ctx, &hotel.ReservationRequest{
@@ -360,6 +645,7 @@ func (s *Server) reservationHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	str := "Reserve successfully!"
 	if len(resResp.HotelId) == 0 {
 		str = "Failed. Already reserved. "
 	}
@@ -371,6 +657,9 @@ func (s *Server) reservationHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
+// geoJSONFields are the profile fields used by geoJSONResponse
+var geoJSONFields = []string{"name", "phoneNumber", "address.lat", "address.lon"}
+
 // return a geoJSON response that allows google map to plot points directly on map
 // https://developers.google.com/maps/documentation/javascript/datalayer#sample_geojson
 func geoJSONResponse(hs []*hotel.Hotel) map[string]interface{} {
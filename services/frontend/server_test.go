@@ -0,0 +1,373 @@
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	hotel "github.com/appnetorg/hotel-reservation-arpc/proto"
+)
+
+// fakeUserClient accepts password "secret" for every user. Changing the
+// password counts up when it was changed, deleting a user revokes their
+// sessions.
+type fakeUserClient struct {
+	hotel.UserClient
+	retryAfter int32
+	calls      int
+	clients    []string // the client addresses passwords were checked for
+	sessions   int      // CheckSession calls
+	changed    map[string]int64
+	deleted    map[string]bool
+}
+
+func (c *fakeUserClient) CheckUser(ctx context.Context, req *hotel.CheckUserRequest) (*hotel.CheckUserResult, error) {
+	c.calls++
+	c.clients = append(c.clients, metadata.FromOutgoingContext(ctx).Get(auth.ClientKey))
+	if c.retryAfter > 0 {
+		return &hotel.CheckUserResult{RetryAfter: c.retryAfter}, nil
+	}
+	return &hotel.CheckUserResult{Correct: req.Password == "secret", PasswordChanged: c.changed[req.Username]}, nil
+}
+
+func (c *fakeUserClient) CheckSession(ctx context.Context, req *hotel.CheckSessionRequest) (*hotel.CheckSessionResult, error) {
+	c.sessions++
+	return &hotel.CheckSessionResult{Valid: !c.deleted[req.Username] && c.changed[req.Username] == req.PasswordChanged}, nil
+}
+
+// RegisterUser registers every user but "taken".
+func (c *fakeUserClient) RegisterUser(ctx context.Context, req *hotel.RegisterUserRequest) (*hotel.RegisterUserResult, error) {
+	return &hotel.RegisterUserResult{Registered: req.Username != "taken"}, nil
+}
+
+func (c *fakeUserClient) ChangePassword(ctx context.Context, req *hotel.ChangePasswordRequest) (*hotel.ChangePasswordResult, error) {
+	if c.retryAfter > 0 {
+		return &hotel.ChangePasswordResult{RetryAfter: c.retryAfter}, nil
+	}
+	if req.Password != "secret" {
+		return &hotel.ChangePasswordResult{}, nil
+	}
+	c.changed[req.Username]++
+	return &hotel.ChangePasswordResult{Changed: true}, nil
+}
+
+func (c *fakeUserClient) DeleteUser(ctx context.Context, req *hotel.DeleteUserRequest) (*hotel.DeleteUserResult, error) {
+	if c.retryAfter > 0 {
+		return &hotel.DeleteUserResult{RetryAfter: c.retryAfter}, nil
+	}
+	if req.Password != "secret" {
+		return &hotel.DeleteUserResult{}, nil
+	}
+	c.deleted[req.Username] = true
+	return &hotel.DeleteUserResult{Deleted: true}, nil
+}
+
+// fakeReservationClient records the reservations it is asked to make.
+type fakeReservationClient struct {
+	hotel.ReservationClient
+	reqs      []*hotel.ReservationRequest
+	usernames []string
+}
+
+func (c *fakeReservationClient) MakeReservation(ctx context.Context, req *hotel.ReservationRequest) (*hotel.ReservationResult, error) {
+	c.reqs = append(c.reqs, req)
+	c.usernames = append(c.usernames, metadata.FromOutgoingContext(ctx).Get(auth.UsernameKey))
+	return &hotel.ReservationResult{HotelId: req.HotelId}, nil
+}
+
+// CheckAvailability finds every hotel available.
+func (c *fakeReservationClient) CheckAvailability(ctx context.Context, req *hotel.ReservationRequest) (*hotel.ReservationResult, error) {
+	return &hotel.ReservationResult{HotelId: req.HotelId}, nil
+}
+
+func newTestServer(t *testing.T) (*Server, *fakeUserClient, *fakeReservationClient) {
+	t.Helper()
+	users := &fakeUserClient{changed: make(map[string]int64), deleted: make(map[string]bool)}
+	reservations := &fakeReservationClient{}
+	s := &Server{userClient: users, reservationClient: reservations}
+	if err := s.initSessionKeys(); err != nil {
+		t.Fatal(err)
+	}
+	return s, users, reservations
+}
+
+const reservationURL = "/reservation?inDate=2015-04-19&outDate=2015-04-24&hotelId=9&number=1"
+
+func TestReservationHandlerPassword(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		retryAfter int32
+		status     int
+		customer   string
+	}{
+		{"correct", "&customerName=Cornell_1&username=Cornell_1&password=secret", 0, http.StatusOK, "Cornell_1"},
+		{"derived customer", "&username=Cornell_1&password=secret", 0, http.StatusOK, "Cornell_1"},
+		{"wrong password", "&customerName=Cornell_1&username=Cornell_1&password=wrong", 0, http.StatusUnauthorized, ""},
+		{"other customer", "&customerName=Cornell_2&username=Cornell_1&password=secret", 0, http.StatusForbidden, ""},
+		{"locked out", "&customerName=Cornell_1&username=Cornell_1&password=secret", 30, http.StatusTooManyRequests, ""},
+		{"missing password", "&customerName=Cornell_1&username=Cornell_1", 0, http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, users, reservations := newTestServer(t)
+			users.retryAfter = tt.retryAfter
+
+			w := httptest.NewRecorder()
+			s.reservationHandler(w, httptest.NewRequest(http.MethodGet, reservationURL+tt.query, nil))
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			if tt.customer == "" {
+				if len(reservations.reqs) != 0 {
+					t.Fatalf("MakeReservation called %d times, want 0", len(reservations.reqs))
+				}
+				return
+			}
+			if len(reservations.reqs) != 1 {
+				t.Fatalf("MakeReservation called %d times, want 1", len(reservations.reqs))
+			}
+			if got := reservations.reqs[0].CustomerName; got != tt.customer {
+				t.Errorf("customerName = %q, want %q", got, tt.customer)
+			}
+			if got := reservations.usernames[0]; got != tt.customer {
+				t.Errorf("username metadata = %q, want %q", got, tt.customer)
+			}
+		})
+	}
+}
+
+func TestReservationHandlerSession(t *testing.T) {
+	s, users, reservations := newTestServer(t)
+	token, _, err := s.newToken(auth.Principal{Username: "Cornell_1", Role: auth.Guest}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		auth   string
+		query  string
+		status int
+	}{
+		{"derived customer", "Bearer " + token, "", http.StatusOK},
+		{"same customer", "Bearer " + token, "&customerName=Cornell_1", http.StatusOK},
+		{"other customer", "Bearer " + token, "&customerName=Cornell_2", http.StatusForbidden},
+		{"tampered token", "Bearer " + token + "x", "&customerName=Cornell_1", http.StatusUnauthorized},
+		{"other scheme", "Basic " + token, "&customerName=Cornell_1", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reservations.reqs, reservations.usernames = nil, nil
+
+			r := httptest.NewRequest(http.MethodGet, reservationURL+tt.query, nil)
+			r.Header.Set("Authorization", tt.auth)
+			w := httptest.NewRecorder()
+			s.reservationHandler(w, r)
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			want := 0
+			if tt.status == http.StatusOK {
+				want = 1
+			}
+			if len(reservations.reqs) != want {
+				t.Fatalf("MakeReservation called %d times, want %d", len(reservations.reqs), want)
+			}
+			if want == 1 && reservations.reqs[0].CustomerName != "Cornell_1" {
+				t.Errorf("customerName = %q, want %q", reservations.reqs[0].CustomerName, "Cornell_1")
+			}
+		})
+	}
+
+	if users.calls != 0 {
+		t.Errorf("CheckUser called %d times with a session token, want 0", users.calls)
+	}
+}
+
+// postForm returns a POST of a form to url.
+func postForm(url, form string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, url, strings.NewReader(form))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func TestLoginClientIP(t *testing.T) {
+	s, users, _ := newTestServer(t)
+	r := postForm("/login", "username=Cornell_1&password=secret")
+	r.RemoteAddr = "10.0.0.7:52113"
+	r.Header.Set("X-Forwarded-For", "10.0.0.8")
+	w := httptest.NewRecorder()
+	s.loginHandler(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("login returned %d: %s", w.Code, w.Body.String())
+	}
+	if !slices.Equal(users.clients, []string{"10.0.0.7"}) {
+		t.Errorf("checked the password for clients %v, want the remote address", users.clients)
+	}
+}
+
+func TestSessionRevoked(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		url     string
+		form    string
+		bearer  bool // the form is sent with the token instead of the username
+		handler func(*Server) http.HandlerFunc
+	}{
+		{"password changed", "/user/password", "username=Cornell_1&password=secret&newPassword=new", false, func(s *Server) http.HandlerFunc { return s.changePasswordHandler }},
+		{"password changed with the token", "/user/password", "password=secret&newPassword=new", true, func(s *Server) http.HandlerFunc { return s.changePasswordHandler }},
+		{"user deleted", "/user/delete", "username=Cornell_1&password=secret", false, func(s *Server) http.HandlerFunc { return s.deleteUserHandler }},
+		{"user deleted with the token", "/user/delete", "password=secret", true, func(s *Server) http.HandlerFunc { return s.deleteUserHandler }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s, users, _ := newTestServer(t)
+			users.changed["Cornell_1"] = 1
+
+			w := httptest.NewRecorder()
+			s.loginHandler(w, postForm("/login", "username=Cornell_1&password=secret"))
+			var res struct{ Token string }
+			if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+				t.Fatalf("login returned %d %q: %v", w.Code, w.Body.String(), err)
+			}
+			reserve := func() int {
+				r := httptest.NewRequest(http.MethodGet, reservationURL, nil)
+				r.Header.Set("Authorization", "Bearer "+res.Token)
+				w := httptest.NewRecorder()
+				s.reservationHandler(w, r)
+				return w.Code
+			}
+			if code := reserve(); code != http.StatusOK {
+				t.Fatalf("status with a new token = %d, want %d", code, http.StatusOK)
+			}
+
+			r := postForm(tt.url, tt.form)
+			if tt.bearer {
+				r.Header.Set("Authorization", "Bearer "+res.Token)
+			}
+			w = httptest.NewRecorder()
+			tt.handler(s)(w, r)
+			if w.Code != http.StatusOK {
+				t.Fatalf("revoking returned %d: %s", w.Code, w.Body.String())
+			}
+			if code := reserve(); code != http.StatusUnauthorized {
+				t.Errorf("status with a revoked token = %d, want %d", code, http.StatusUnauthorized)
+			}
+		})
+	}
+}
+
+// TestSessionCheckCached checks that the user service confirms a token once
+// per sessionCheckTTL, and that a revoked token is rejected once it expired.
+func TestSessionCheckCached(t *testing.T) {
+	s, users, _ := newTestServer(t)
+	token, _, err := s.newToken(auth.Principal{Username: "Cornell_1", Role: auth.Guest}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reserve := func() int {
+		r := httptest.NewRequest(http.MethodGet, reservationURL, nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		s.reservationHandler(w, r)
+		return w.Code
+	}
+
+	for i := 0; i < 3; i++ {
+		if code := reserve(); code != http.StatusOK {
+			t.Fatalf("status = %d, want %d", code, http.StatusOK)
+		}
+	}
+	if users.sessions != 1 {
+		t.Errorf("CheckSession called %d times, want 1", users.sessions)
+	}
+
+	// revoked through another replica, the token is accepted until the
+	// check expires
+	users.deleted["Cornell_1"] = true
+	if code := reserve(); code != http.StatusOK {
+		t.Errorf("status before the check expired = %d, want %d", code, http.StatusOK)
+	}
+	for c := range s.sessions.until {
+		s.sessions.until[c] = time.Now()
+	}
+	if code := reserve(); code != http.StatusUnauthorized {
+		t.Errorf("status after the check expired = %d, want %d", code, http.StatusUnauthorized)
+	}
+}
+
+func TestSessionChecksBound(t *testing.T) {
+	defer func(n int) { maxSessionChecks = n }(maxSessionChecks)
+	maxSessionChecks = 2
+
+	var sc sessionChecks
+	sc.confirm(sessionCheck{username: "a"})
+	sc.confirm(sessionCheck{username: "b"})
+	sc.confirm(sessionCheck{username: "c"})
+	if len(sc.until) != 2 || sc.valid(sessionCheck{username: "c"}) {
+		t.Errorf("remembered %v, want only a and b", sc.until)
+	}
+
+	// expired checks make room
+	sc.until[sessionCheck{username: "a"}] = time.Now()
+	sc.confirm(sessionCheck{username: "c"})
+	if !sc.valid(sessionCheck{username: "c"}) || sc.valid(sessionCheck{username: "a"}) {
+		t.Errorf("remembered %v, want b and c", sc.until)
+	}
+
+	sc.forget("b")
+	if sc.valid(sessionCheck{username: "b"}) {
+		t.Error("b is still confirmed after forgetting it")
+	}
+}
+
+// TestRecommendUser checks that recommendations are personalized for the
+// user of the session token only.
+func TestRecommendUser(t *testing.T) {
+	s, handler := newContractServer(t)
+	recommendations := &fakeRecommendationClient{}
+	s.recommendationClient = recommendations
+	token, _, err := s.newToken(auth.Principal{Username: "Cornell_1", Role: auth.Guest}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{"/recommendations", "/api/v2/recommendations"} {
+		for _, tt := range []struct {
+			name     string
+			token    string
+			status   int
+			username string
+		}{
+			{"session", token, http.StatusOK, "Cornell_1"},
+			{"anonymous", "", http.StatusOK, ""},
+			{"bad token", "forged", http.StatusUnauthorized, ""},
+		} {
+			recommendations.usernames = nil
+			r := httptest.NewRequest(http.MethodGet, path+"?require=similar&lat=38.0235&lon=-122.095&username=Cornell_2", nil)
+			if tt.token != "" {
+				r.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tt.status {
+				t.Errorf("%v %v: status %d, want %d: %s", path, tt.name, w.Code, tt.status, w.Body)
+				continue
+			}
+			if tt.status == http.StatusOK && !slices.Equal(recommendations.usernames, []string{tt.username}) {
+				t.Errorf("%v %v: personalized for %q, want %q", path, tt.name, recommendations.usernames, tt.username)
+			}
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package frontend
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	hotel "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultSessionTTL = time.Hour
+
+// sessionCheckTTL is how long a session the user service confirmed is
+// trusted without asking again, so a token revoked through another replica
+// is accepted for up to this long.
+const sessionCheckTTL = 5 * time.Second
+
+// maxSessionChecks is how many confirmed sessions are remembered at most.
+var maxSessionChecks = 100000
+
+// session is the payload of a session token.
+type session struct {
+	Username string   `json:"sub"`
+	Role     string   `json:"role,omitempty"`
+	Hotels   []string `json:"hotels,omitempty"`
+	Expires  int64    `json:"exp"`
+	// PasswordChanged is the passwordChanged of the CheckUser call the token
+	// was issued after. The token is revoked once it changes.
+	PasswordChanged int64 `json:"pwd,omitempty"`
+}
+
+// initSessionKeys generates a random key to sign tokens with when none are
+// configured.
+func (s *Server) initSessionKeys() error {
+	if len(s.SessionKeys) > 0 {
+		return nil
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return fmt.Errorf("failed to generate session key: %v", err)
+	}
+	log.Warn().Msg("No session keys configured, tokens will not survive a restart")
+	s.SessionKeys = [][]byte{key}
+	return nil
+}
+
+// newToken returns a signed token for a user whose password was set at
+// passwordChanged and when it expires.
+func (s *Server) newToken(p auth.Principal, passwordChanged int64) (string, time.Time, error) {
+	ttl := s.SessionTTL
+	if ttl <= 0 {
+		ttl = defaultSessionTTL
+	}
+	expires := time.Now().Add(ttl)
+
+	payload, err := json.Marshal(&session{
+		Username:        p.Username,
+		Role:            string(p.Role),
+		Hotels:          p.Hotels,
+		Expires:         expires.Unix(),
+		PasswordChanged: passwordChanged,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	body := base64.RawURLEncoding.EncodeToString(payload)
+	sig := base64.RawURLEncoding.EncodeToString(sign(s.SessionKeys[0], body))
+	return body + "." + sig, expires, nil
+}
+
+// verifyToken returns the user a token was issued to and when their password
+// was set then.
+func (s *Server) verifyToken(token string) (auth.Principal, int64, error) {
+	body, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return auth.Principal{}, 0, fmt.Errorf("malformed token")
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return auth.Principal{}, 0, fmt.Errorf("malformed token signature")
+	}
+
+	valid := false
+	for _, key := range s.SessionKeys {
+		if hmac.Equal(mac, sign(key, body)) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return auth.Principal{}, 0, fmt.Errorf("invalid token signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return auth.Principal{}, 0, fmt.Errorf("malformed token payload")
+	}
+	var sess session
+	if err := json.Unmarshal(payload, &sess); err != nil {
+		return auth.Principal{}, 0, fmt.Errorf("malformed token payload")
+	}
+	if time.Now().Unix() >= sess.Expires {
+		return auth.Principal{}, 0, fmt.Errorf("token expired")
+	}
+	role, err := auth.ParseRole(sess.Role)
+	if err != nil {
+		return auth.Principal{}, 0, err
+	}
+	return auth.Principal{Username: sess.Username, Role: role, Hotels: sess.Hotels}, sess.PasswordChanged, nil
+}
+
+// sessionChecks remembers the sessions the user service confirmed recently.
+type sessionChecks struct {
+	mu    sync.Mutex
+	until map[sessionCheck]time.Time
+}
+
+// sessionCheck is a user and when their password was set.
+type sessionCheck struct {
+	username        string
+	passwordChanged int64
+}
+
+// valid reports whether c was confirmed less than sessionCheckTTL ago.
+func (sc *sessionChecks) valid(c sessionCheck) bool {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	return time.Now().Before(sc.until[c])
+}
+
+// confirm remembers that the user service confirmed c.
+func (sc *sessionChecks) confirm(c sessionCheck) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	now := time.Now()
+	if sc.until == nil {
+		sc.until = make(map[sessionCheck]time.Time)
+	}
+	if len(sc.until) >= maxSessionChecks {
+		for k, until := range sc.until {
+			if !now.Before(until) {
+				delete(sc.until, k)
+			}
+		}
+		if len(sc.until) >= maxSessionChecks {
+			return
+		}
+	}
+	sc.until[c] = now.Add(sessionCheckTTL)
+}
+
+// forget drops the confirmed sessions of a user, once this replica changed
+// their password or deleted them.
+func (sc *sessionChecks) forget(username string) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	for k := range sc.until {
+		if k.username == username {
+			delete(sc.until, k)
+		}
+	}
+}
+
+// bearerUser returns the user of the bearer token of a request, or an empty
+// username when the request carries none. Tokens are revoked when their user
+// changes the password or is deleted. The user service is asked at most once
+// per sessionCheckTTL for each user.
+func (s *Server) bearerUser(r *http.Request) (auth.Principal, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return auth.Principal{}, nil
+	}
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok {
+		return auth.Principal{}, fmt.Errorf("unsupported authorization scheme")
+	}
+	user, passwordChanged, err := s.verifyToken(token)
+	if err != nil {
+		return auth.Principal{}, err
+	}
+
+	check := sessionCheck{username: user.Username, passwordChanged: passwordChanged}
+	if s.sessions.valid(check) {
+		return user, nil
+	}
+	res, err := s.userClient.CheckSession(outgoing(r), &hotel.CheckSessionRequest{
+		Username:        user.Username,
+		PasswordChanged: passwordChanged,
+	})
+	if err != nil {
+		return auth.Principal{}, fmt.Errorf("failed to check token: %v", err)
+	}
+	if !res.Valid {
+		return auth.Principal{}, fmt.Errorf("token revoked")
+	}
+	s.sessions.confirm(check)
+	return user, nil
+}
+
+// checkedUser returns the user a successful CheckUser call authenticated.
+func checkedUser(username string, res *hotel.CheckUserResult) auth.Principal {
+	role, err := auth.ParseRole(res.Role)
+	if err != nil {
+		log.Warn().Msgf("User [%v] has %v, treating as guest", username, err)
+		role = auth.Guest
+	}
+	return auth.Principal{Username: username, Role: role, Hotels: res.Hotels}
+}
+
+func sign(key []byte, body string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(body))
+	return mac.Sum(nil)
+}
+
+// ParseSessionKeys decodes a comma separated list of base64 keys.
+func ParseSessionKeys(keys string) ([][]byte, error) {
+	var res [][]byte
+	for _, k := range strings.Split(keys, ",") {
+		k = strings.TrimSpace(k)
+		if k == "" {
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid session key: %v", err)
+		}
+		if len(key) < 32 {
+			return nil, fmt.Errorf("session keys must be at least 32 bytes")
+		}
+		res = append(res, key)
+	}
+	return res, nil
+}
@@ -3,20 +3,27 @@ package geo
 import (
 	// "encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
+	"sync"
 
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 
 	"context"
 
-	"github.com/appnet-org/arpc/pkg/rpc"
-	"github.com/appnet-org/arpc/pkg/serializer"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/grpcpb"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/rawpb"
+	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
 	"github.com/google/uuid"
 	"github.com/hailocab/go-geoindex"
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -27,12 +34,15 @@ const (
 // Server implements the geo service
 type Server struct {
 	index *geoindex.ClusteringIndex
+	mu    sync.RWMutex
 	uuid  string
 
 	Tracer       opentracing.Tracer
 	Port         int
 	IpAddr       string
 	MongoSession *mgo.Session
+	AuthKey      []byte            // verifies callers of methods that need a role
+	Transport    *transport.Config // picks aRPC or gRPC per hop
 }
 
 // Run starts the server
@@ -47,16 +57,35 @@ func (s *Server) Run() error {
 
 	s.uuid = uuid.New().String()
 
-	serializer := &serializer.SymphonySerializer{}
-	server, err := rpc.NewServer(s.IpAddr+":"+strconv.Itoa(s.Port), serializer, nil)
+	elements := slices.Concat(tracing.ServerElements(s.Tracer), logs.ServerElements(), auth.Elements(s.AuthKey))
+	server, err := s.Transport.NewServer("geo", s.IpAddr+":"+strconv.Itoa(s.Port), elements)
 
 	if err != nil {
 		log.Error().Msgf("Failed to start aRPC server: %v", err)
 		return err
 	}
 
-	pb.RegisterGeoServer(server, s)
+	if s.Transport.ServesRaw("geo") {
+		if err := rawpb.RegisterGeo(server, s); err != nil {
+			return err
+		}
+	} else {
+		pb.RegisterGeoServer(server, s)
+	}
+
+	if s.Transport.ServesGRPC("geo") {
+		err := transport.ServeGRPC(s.IpAddr+":"+strconv.Itoa(s.Port), elements, func(g grpc.ServiceRegistrar) {
+			grpcpb.RegisterGeo(g, s)
+		})
+		if err != nil {
+			return err
+		}
+	}
 
+	// finish the spans of calls failing in their handler
+	if err := transport.WrapHandlers(server, tracing.WrapHandler); err != nil {
+		return err
+	}
 	server.Start()
 
 	return nil
@@ -68,19 +97,29 @@ func (s *Server) Shutdown() {
 
 // NearbyGeo returns all hotels within a given distance.
 func (s *Server) NearbyGeo(ctx context.Context, req *pb.NearbyRequest) (*pb.NearbyResult, context.Context, error) {
+	return s.nearby(ctx, req.Lat, req.Lon)
+}
+
+// NearbyGeoRaw is NearbyGeo reading the location straight from the request
+// payload.
+func (s *Server) NearbyGeoRaw(ctx context.Context, req pb.NearbyRequestRaw) (*pb.NearbyResult, context.Context, error) {
+	return s.nearby(ctx, req.GetLat(), req.GetLon())
+}
+
+func (s *Server) nearby(ctx context.Context, lat, lon float32) (*pb.NearbyResult, context.Context, error) {
 	// Check if index is initialized
 	if s.index == nil {
-		log.Error().Msg("Geo index is nil, initializing now")
+		log.Ctx(ctx).Error().Msg("Geo index is nil, initializing now")
 		if s.MongoSession == nil {
-			log.Error().Msg("MongoSession is nil, cannot initialize index")
+			log.Ctx(ctx).Error().Msg("MongoSession is nil, cannot initialize index")
 			return &pb.NearbyResult{}, ctx, fmt.Errorf("geo index not initialized and MongoSession is nil")
 		}
 		s.index = newGeoIndex(s.MongoSession)
-		log.Info().Msg("Geo index initialized")
+		log.Ctx(ctx).Info().Msg("Geo index initialized")
 	}
 
-	points := s.getNearbyPoints(ctx, float64(req.Lat), float64(req.Lon))
-	res := &pb.NearbyResult{}
+	points := s.getNearbyPoints(ctx, float64(lat), float64(lon))
+	res := &pb.NearbyResult{HotelIds: make([]string, 0, len(points))}
 
 	for _, p := range points {
 		res.HotelIds = append(res.HotelIds, p.Id())
@@ -101,6 +140,8 @@ func (s *Server) getNearbyPoints(_ context.Context, lat, lon float64) []geoindex
 		Plon: lon,
 	}
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	points := s.index.KNearest(
 		center,
 		maxSearchResults,
@@ -111,6 +152,35 @@ func (s *Server) getNearbyPoints(_ context.Context, lat, lon float64) []geoindex
 	return points
 }
 
+// SetLocation stores the location of a hotel and adds it to the index,
+// replacing its previous location if there was one.
+func (s *Server) SetLocation(ctx context.Context, req *pb.SetLocationRequest) (*pb.SetLocationResult, context.Context, error) {
+	if req.HotelId == "" {
+		return nil, ctx, fmt.Errorf("hotel id must be set")
+	}
+
+	p := &point{
+		Pid:  req.HotelId,
+		Plat: float64(req.Lat),
+		Plon: float64(req.Lon),
+	}
+
+	session := s.MongoSession.Copy()
+	defer session.Close()
+	c := session.DB("geo-db").C("geo")
+
+	if _, err := c.Upsert(&bson.M{"hotelId": p.Pid}, p); err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to store location of hotel [%v]: %v", p.Pid, err)
+		return nil, ctx, err
+	}
+
+	s.mu.Lock()
+	s.index.Add(p)
+	s.mu.Unlock()
+
+	return &pb.SetLocationResult{HotelId: p.Pid}, ctx, nil
+}
+
 // newGeoIndex returns a geo index with points loaded
 func newGeoIndex(session *mgo.Session) *geoindex.ClusteringIndex {
 	// session, err := mgo.Dial("mongodb-geo")
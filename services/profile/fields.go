@@ -0,0 +1,218 @@
+package profile
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// profilePaths are the profile fields a GetProfilesRequest can select, named
+// after their profile-db document keys.
+var profilePaths = []string{
+	"name",
+	"phoneNumber",
+	"description",
+	"images",
+	"address.streetNumber",
+	"address.streetName",
+	"address.city",
+	"address.state",
+	"address.country",
+	"address.postalCode",
+	"address.lat",
+	"address.lon",
+}
+
+// fieldMask is a set of profile paths. A nil mask selects the full profile.
+type fieldMask map[string]struct{}
+
+// newFieldMask builds a mask from the requested fields. "address" selects the
+// whole address and "id" is ignored since the id is always returned.
+func newFieldMask(fields []string) (fieldMask, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	m := make(fieldMask)
+	for _, f := range fields {
+		switch {
+		case f == "id":
+		case f == "address":
+			for _, p := range profilePaths {
+				if strings.HasPrefix(p, "address.") {
+					m[p] = struct{}{}
+				}
+			}
+		case isProfilePath(f):
+			m[f] = struct{}{}
+		default:
+			return nil, fmt.Errorf("unknown profile field %q", f)
+		}
+	}
+	return m, nil
+}
+
+func isProfilePath(f string) bool {
+	for _, p := range profilePaths {
+		if p == f {
+			return true
+		}
+	}
+	return false
+}
+
+// coveredBy reports whether every path of m is also selected by c.
+func (m fieldMask) coveredBy(c fieldMask) bool {
+	if c == nil {
+		return true
+	}
+	if m == nil {
+		return false
+	}
+	for p := range m {
+		if _, ok := c[p]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// union returns a mask selecting the paths of both m and c.
+func (m fieldMask) union(c fieldMask) fieldMask {
+	if m == nil || c == nil {
+		return nil
+	}
+	u := make(fieldMask, len(m)+len(c))
+	for p := range m {
+		u[p] = struct{}{}
+	}
+	for p := range c {
+		u[p] = struct{}{}
+	}
+	return u
+}
+
+// paths returns "id" followed by the selected paths in order, or nil for the
+// full profile.
+func (m fieldMask) paths() []string {
+	if m == nil {
+		return nil
+	}
+	ps := make([]string, 0, len(m))
+	for p := range m {
+		ps = append(ps, p)
+	}
+	sort.Strings(ps)
+	return append([]string{"id"}, ps...)
+}
+
+// projection returns the mongo projection fetching only the selected paths.
+func (m fieldMask) projection() bson.M {
+	if m == nil {
+		return nil
+	}
+	proj := bson.M{"id": 1}
+	for p := range m {
+		proj[p] = 1
+	}
+	return proj
+}
+
+func (m fieldMask) has(p string) bool {
+	if m == nil {
+		return true
+	}
+	_, ok := m[p]
+	return ok
+}
+
+// trim returns a copy of h holding only the selected fields.
+func (m fieldMask) trim(h *pb.Hotel) *pb.Hotel {
+	if m == nil {
+		return h
+	}
+
+	res := &pb.Hotel{Id: h.Id}
+	m.copy(res, h)
+	return res
+}
+
+// copy sets the selected fields of dst to the ones of src.
+func (m fieldMask) copy(dst, src *pb.Hotel) {
+	if m.has("name") {
+		dst.Name = src.Name
+	}
+	if m.has("phoneNumber") {
+		dst.PhoneNumber = src.PhoneNumber
+	}
+	if m.has("description") {
+		dst.Description = src.Description
+	}
+	if m.has("images") {
+		dst.Images = src.Images
+	}
+
+	if src.Address == nil {
+		return
+	}
+	addr := dst.Address
+	if addr == nil {
+		addr = &pb.Address{}
+	}
+	selected := false
+	for _, f := range []struct {
+		path string
+		set  func()
+	}{
+		{"address.streetNumber", func() { addr.StreetNumber = src.Address.StreetNumber }},
+		{"address.streetName", func() { addr.StreetName = src.Address.StreetName }},
+		{"address.city", func() { addr.City = src.Address.City }},
+		{"address.state", func() { addr.State = src.Address.State }},
+		{"address.country", func() { addr.Country = src.Address.Country }},
+		{"address.postalCode", func() { addr.PostalCode = src.Address.PostalCode }},
+		{"address.lat", func() { addr.Lat = src.Address.Lat }},
+		{"address.lon", func() { addr.Lon = src.Address.Lon }},
+	} {
+		if m.has(f.path) {
+			f.set()
+			selected = true
+		}
+	}
+	if selected {
+		dst.Address = addr
+	}
+}
+
+// cachedHotel is the memcached value of a profile. Fields lists the paths it
+// holds; values written without it hold the full profile.
+type cachedHotel struct {
+	Fields []string `json:"fields,omitempty"`
+	*pb.Hotel
+}
+
+// mask returns the paths held by the cached profile.
+func (c *cachedHotel) mask() fieldMask {
+	m, _ := newFieldMask(c.Fields)
+	return m
+}
+
+// merge returns the profile holding the fields of both c and newer, taking
+// the ones of newer where both hold a field. The cached profiles are not
+// changed since reads share them.
+func (c cachedHotel) merge(newer cachedHotel) cachedHotel {
+	if c.Hotel == nil || c.Id != newer.Id {
+		return newer
+	}
+	mask, newerMask := c.mask(), newer.mask()
+	if mask.coveredBy(newerMask) {
+		return newer
+	}
+
+	h := proto.Clone(c.Hotel).(*pb.Hotel)
+	newerMask.copy(h, newer.Hotel)
+	return cachedHotel{Fields: mask.union(newerMask).paths(), Hotel: h}
+}
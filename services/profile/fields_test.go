@@ -0,0 +1,225 @@
+package profile
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// mask builds a field mask from paths, failing the test on unknown ones.
+func mask(t *testing.T, paths ...string) fieldMask {
+	t.Helper()
+	m, err := newFieldMask(paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestNewFieldMask(t *testing.T) {
+	address := []string{"address.city", "address.country", "address.lat", "address.lon", "address.postalCode", "address.state", "address.streetName", "address.streetNumber"}
+	tests := []struct {
+		name   string
+		fields []string
+		paths  []string
+		err    bool
+	}{
+		{"none", nil, nil, false},
+		{"only id", []string{"id"}, []string{"id"}, false},
+		{"fields", []string{"name", "address.city"}, []string{"id", "address.city", "name"}, false},
+		{"address", []string{"address"}, append([]string{"id"}, address...), false},
+		{"duplicates", []string{"name", "name", "id"}, []string{"id", "name"}, false},
+		{"unknown", []string{"name", "rating"}, nil, true},
+		{"unknown address path", []string{"address.planet"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := newFieldMask(tt.fields)
+			if (err != nil) != tt.err {
+				t.Fatalf("got err %v, want err %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(m.paths(), tt.paths) {
+				t.Errorf("got paths %v, want %v", m.paths(), tt.paths)
+			}
+		})
+	}
+}
+
+var fullHotel = &pb.Hotel{
+	Id:          "9",
+	Name:        "Hotel Nine",
+	PhoneNumber: "(415) 000-0009",
+	Description: "Near the market",
+	Images:      []*pb.Image{{Url: "9.jpg", Default: true}},
+	Address: &pb.Address{
+		StreetNumber: "1",
+		StreetName:   "Market St",
+		City:         "San Francisco",
+		State:        "CA",
+		Country:      "United States",
+		PostalCode:   "94103",
+		Lat:          37.78,
+		Lon:          -122.41,
+	},
+}
+
+func TestTrim(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		want  *pb.Hotel
+	}{
+		{"full", nil, fullHotel},
+		{"only id", []string{"id"}, &pb.Hotel{Id: "9"}},
+		{"name", []string{"name", "phoneNumber"}, &pb.Hotel{Id: "9", Name: "Hotel Nine", PhoneNumber: "(415) 000-0009"}},
+		{"images", []string{"images"}, &pb.Hotel{Id: "9", Images: fullHotel.Images}},
+		{"coordinates", []string{"address.lat", "address.lon"}, &pb.Hotel{Id: "9", Address: &pb.Address{Lat: 37.78, Lon: -122.41}}},
+		{"address", []string{"address"}, &pb.Hotel{Id: "9", Address: fullHotel.Address}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mask(t, tt.paths...).trim(fullHotel); !proto.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// a profile fetched without an address is trimmed without one
+	if got := mask(t, "address.city").trim(&pb.Hotel{Id: "9"}); got.Address != nil {
+		t.Errorf("trimmed address %v out of none", got.Address)
+	}
+}
+
+func TestCoveredBy(t *testing.T) {
+	tests := []struct {
+		name string
+		m, c []string
+		want bool
+	}{
+		{"both full", nil, nil, true},
+		{"partial by full", []string{"name"}, nil, true},
+		{"full by partial", nil, []string{"name"}, false},
+		{"subset", []string{"name"}, []string{"name", "address.city"}, true},
+		{"same", []string{"name"}, []string{"name"}, true},
+		{"missing path", []string{"name", "images"}, []string{"name"}, false},
+		{"only id", []string{"id"}, []string{"name"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mask(t, tt.m...).coveredBy(mask(t, tt.c...)); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name string
+		m, c []string
+		want []string
+	}{
+		{"both full", nil, nil, nil},
+		{"full and partial", nil, []string{"name"}, nil},
+		{"partial and full", []string{"name"}, nil, nil},
+		{"disjoint", []string{"name"}, []string{"address.city"}, []string{"id", "address.city", "name"}},
+		{"overlapping", []string{"name", "images"}, []string{"name"}, []string{"id", "images", "name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mask(t, tt.m...).union(mask(t, tt.c...)).paths(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjection(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		want  bson.M
+	}{
+		{"full", nil, nil},
+		{"only id", []string{"id"}, bson.M{"id": 1}},
+		{"fields", []string{"name", "address.lat"}, bson.M{"id": 1, "name": 1, "address.lat": 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mask(t, tt.paths...).projection(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	renamed := proto.Clone(fullHotel).(*pb.Hotel)
+	renamed.Name = "Hotel Nine Renamed"
+	tests := []struct {
+		name          string
+		cached, newer cachedHotel
+		want          cachedHotel
+	}{
+		{
+			"nothing cached",
+			cachedHotel{},
+			cachedHotel{Fields: []string{"id", "name"}, Hotel: &pb.Hotel{Id: "9", Name: "Hotel Nine"}},
+			cachedHotel{Fields: []string{"id", "name"}, Hotel: &pb.Hotel{Id: "9", Name: "Hotel Nine"}},
+		},
+		{
+			"partial after full",
+			cachedHotel{Hotel: fullHotel},
+			cachedHotel{Fields: []string{"id", "name"}, Hotel: &pb.Hotel{Id: "9", Name: "Hotel Nine Renamed"}},
+			cachedHotel{Hotel: renamed},
+		},
+		{
+			"full after partial",
+			cachedHotel{Fields: []string{"id", "name"}, Hotel: &pb.Hotel{Id: "9", Name: "Old Name"}},
+			cachedHotel{Hotel: fullHotel},
+			cachedHotel{Hotel: fullHotel},
+		},
+		{
+			"disjoint partials",
+			cachedHotel{Fields: []string{"id", "name"}, Hotel: &pb.Hotel{Id: "9", Name: "Hotel Nine"}},
+			cachedHotel{Fields: []string{"id", "address.city"}, Hotel: &pb.Hotel{Id: "9", Address: &pb.Address{City: "San Francisco"}}},
+			cachedHotel{Fields: []string{"id", "address.city", "name"}, Hotel: &pb.Hotel{Id: "9", Name: "Hotel Nine", Address: &pb.Address{City: "San Francisco"}}},
+		},
+		{
+			"address paths",
+			cachedHotel{Fields: []string{"id", "address.lat", "address.lon"}, Hotel: &pb.Hotel{Id: "9", Address: &pb.Address{Lat: 37.78, Lon: -122.41}}},
+			cachedHotel{Fields: []string{"id", "address.city"}, Hotel: &pb.Hotel{Id: "9", Address: &pb.Address{City: "San Francisco"}}},
+			cachedHotel{Fields: []string{"id", "address.city", "address.lat", "address.lon"}, Hotel: &pb.Hotel{Id: "9", Address: &pb.Address{City: "San Francisco", Lat: 37.78, Lon: -122.41}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var before *pb.Hotel
+			if tt.cached.Hotel != nil {
+				before = proto.Clone(tt.cached.Hotel).(*pb.Hotel)
+			}
+			got := tt.cached.merge(tt.newer)
+			if !reflect.DeepEqual(got.Fields, tt.want.Fields) || !proto.Equal(got.Hotel, tt.want.Hotel) {
+				t.Errorf("got %v %v, want %v %v", got.Fields, got.Hotel, tt.want.Fields, tt.want.Hotel)
+			}
+			if before != nil && !proto.Equal(tt.cached.Hotel, before) {
+				t.Errorf("merging changed the cached profile to %v", tt.cached.Hotel)
+			}
+		})
+	}
+}
+
+func TestCacheProfile(t *testing.T) {
+	s := newOnboardServer(&fakeServices{})
+	s.cacheProfile("9", cachedHotel{Hotel: fullHotel})
+
+	// a partial profile fetched later must not shrink the cached one
+	s.cacheProfile("9", cachedHotel{Fields: []string{"id", "name"}, Hotel: &pb.Hotel{Id: "9", Name: "Hotel Nine"}})
+	c, err := s.profiles.Get("9")
+	if err != nil || c.Fields != nil || !proto.Equal(c.Hotel, fullHotel) {
+		t.Errorf("cached %v %v, %v, want the full profile", c.Fields, c.Hotel, err)
+	}
+}
@@ -0,0 +1,259 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/opentracing/opentracing-go"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/mgo.v2"
+)
+
+// hotel mirrors the profile-db document layout written by the seed data.
+type hotel struct {
+	Id          string   `bson:"id"`
+	Name        string   `bson:"name"`
+	PhoneNumber string   `bson:"phoneNumber"`
+	Description string   `bson:"description"`
+	Address     *address `bson:"address"`
+	Images      []image  `bson:"images,omitempty"`
+}
+
+type address struct {
+	StreetNumber string  `bson:"streetNumber"`
+	StreetName   string  `bson:"streetName"`
+	City         string  `bson:"city"`
+	State        string  `bson:"state"`
+	Country      string  `bson:"country"`
+	PostalCode   string  `bson:"postalCode"`
+	Lat          float32 `bson:"lat"`
+	Lon          float32 `bson:"lon"`
+}
+
+type image struct {
+	Url     string `bson:"url"`
+	Default bool   `bson:"default"`
+}
+
+// CreateHotel stores the profile of a new hotel and onboards it in the other services.
+// Creating a hotel again with the same profile resumes an onboarding that failed
+// after the profile was stored.
+func (s *Server) CreateHotel(ctx context.Context, req *pb.HotelRequest) (*pb.HotelResult, context.Context, error) {
+	if err := validateHotel(req); err != nil {
+		return nil, ctx, err
+	}
+
+	doc := newHotel(req.Hotel)
+	mongoSpan, _ := opentracing.StartSpanFromContext(ctx, "mongo_profile_create")
+	mongoSpan.SetTag("span.kind", "client")
+	err := s.store.insert(doc)
+	mongoSpan.Finish()
+	if mgo.IsDup(err) {
+		if err := s.resumable(ctx, doc); err != nil {
+			return nil, ctx, err
+		}
+	} else if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to create hotel [id: %v]: %v", req.Hotel.Id, err)
+		return nil, ctx, err
+	}
+
+	if err := s.onboardHotel(ctx, req); err != nil {
+		return nil, ctx, err
+	}
+
+	return &pb.HotelResult{HotelId: req.Hotel.Id}, ctx, nil
+}
+
+// resumable checks that a hotel whose id is taken was stored with the same
+// profile, so creating it again is a retry and not a second hotel.
+func (s *Server) resumable(ctx context.Context, doc *hotel) error {
+	mongoSpan, _ := opentracing.StartSpanFromContext(ctx, "mongo_profile")
+	mongoSpan.SetTag("span.kind", "client")
+	stored, err := s.store.find(doc.Id, nil)
+	mongoSpan.Finish()
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to get hotel [id: %v]: %v", doc.Id, err)
+		return err
+	}
+	if !reflect.DeepEqual(stored, doc) {
+		return fmt.Errorf("hotel [id: %v] already exists", doc.Id)
+	}
+	return nil
+}
+
+// UpdateHotel replaces the profile of an existing hotel and its data in the other services.
+func (s *Server) UpdateHotel(ctx context.Context, req *pb.HotelRequest) (*pb.HotelResult, context.Context, error) {
+	if err := validateHotel(req); err != nil {
+		return nil, ctx, err
+	}
+
+	mongoSpan, _ := opentracing.StartSpanFromContext(ctx, "mongo_profile_update")
+	mongoSpan.SetTag("span.kind", "client")
+	err := s.store.update(newHotel(req.Hotel))
+	mongoSpan.Finish()
+	if err == mgo.ErrNotFound {
+		return nil, ctx, fmt.Errorf("hotel [id: %v] does not exist", req.Hotel.Id)
+	} else if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to update hotel [id: %v]: %v", req.Hotel.Id, err)
+		return nil, ctx, err
+	}
+
+	if err := s.onboardHotel(ctx, req); err != nil {
+		return nil, ctx, err
+	}
+
+	return &pb.HotelResult{HotelId: req.Hotel.Id}, ctx, nil
+}
+
+// onboardHotel refreshes the cached profile and pushes the hotel to the services
+// owning the rest of its data. Every step overwrites, so a failed CreateHotel or
+// UpdateHotel can be retried with the same request.
+func (s *Server) onboardHotel(ctx context.Context, req *pb.HotelRequest) error {
+	h := req.Hotel
+
+	// other replicas keep their near copy for up to NEAR_CACHE_TTL
+	lock := s.profileLock(h.Id)
+	lock.Lock()
+	err := s.profiles.Set(h.Id, cachedHotel{Hotel: h})
+	lock.Unlock()
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to cache hotel [id: %v] with err: %v", h.Id, err)
+	}
+
+	// the other services check the caller of the profile call
+	ctx = auth.Forward(ctx)
+
+	if _, err := s.geoClient.SetLocation(ctx, &pb.SetLocationRequest{
+		HotelId: h.Id,
+		Lat:     h.Address.Lat,
+		Lon:     h.Address.Lon,
+	}); err != nil {
+		return fmt.Errorf("geoClient.SetLocation failed for hotel [id: %v]: %w", h.Id, err)
+	}
+
+	if _, err := s.rateClient.SetRatePlans(ctx, &pb.SetRatePlansRequest{
+		HotelId:   h.Id,
+		RatePlans: req.RatePlans,
+	}); err != nil {
+		return fmt.Errorf("rateClient.SetRatePlans failed for hotel [id: %v]: %w", h.Id, err)
+	}
+
+	if _, err := s.recommendationClient.SetHotel(ctx, &pb.SetHotelRequest{
+		HotelId: h.Id,
+		Lat:     float64(h.Address.Lat),
+		Lon:     float64(h.Address.Lon),
+		Rate:    req.Rate,
+		Price:   req.Price,
+	}); err != nil {
+		return fmt.Errorf("recommendationClient.SetHotel failed for hotel [id: %v]: %w", h.Id, err)
+	}
+
+	if _, err := s.reservationClient.SetCapacity(ctx, &pb.SetCapacityRequest{
+		HotelId:      h.Id,
+		NumberOfRoom: req.Capacity,
+	}); err != nil {
+		return fmt.Errorf("reservationClient.SetCapacity failed for hotel [id: %v]: %w", h.Id, err)
+	}
+
+	return nil
+}
+
+// validateHotel checks that a request carries a complete hotel profile.
+func validateHotel(req *pb.HotelRequest) error {
+	h := req.Hotel
+	if h == nil {
+		return fmt.Errorf("hotel profile must be set")
+	}
+	if h.Id == "" || h.Name == "" || h.PhoneNumber == "" {
+		return fmt.Errorf("hotel id, name and phone number must be set")
+	}
+	if h.Address == nil {
+		return fmt.Errorf("hotel [id: %v] has no address", h.Id)
+	}
+	if h.Address.StreetName == "" || h.Address.City == "" || h.Address.Country == "" {
+		return fmt.Errorf("hotel [id: %v] address needs a street, city and country", h.Id)
+	}
+	if h.Address.Lat < -90 || h.Address.Lat > 90 || h.Address.Lon < -180 || h.Address.Lon > 180 {
+		return fmt.Errorf("hotel [id: %v] has invalid coordinates [%v, %v]", h.Id, h.Address.Lat, h.Address.Lon)
+	}
+	if req.Capacity <= 0 {
+		return fmt.Errorf("hotel [id: %v] must have at least one room", h.Id)
+	}
+	if req.Rate < 0 || req.Price < 0 {
+		return fmt.Errorf("hotel [id: %v] rate and price must not be negative", h.Id)
+	}
+
+	for _, r := range req.RatePlans {
+		if r.HotelId != "" && r.HotelId != h.Id {
+			return fmt.Errorf("rate plan [Code: %v] belongs to hotel [id: %v], not [id: %v]", r.Code, r.HotelId, h.Id)
+		}
+		if r.Code == "" || r.RoomType == nil {
+			return fmt.Errorf("rate plans of hotel [id: %v] need a code and a room type", h.Id)
+		}
+		inDate, err := time.Parse("2006-01-02", r.InDate)
+		if err != nil {
+			return fmt.Errorf("rate plan [Code: %v] has invalid inDate %q", r.Code, r.InDate)
+		}
+		outDate, err := time.Parse("2006-01-02", r.OutDate)
+		if err != nil {
+			return fmt.Errorf("rate plan [Code: %v] has invalid outDate %q", r.Code, r.OutDate)
+		}
+		if !inDate.Before(outDate) {
+			return fmt.Errorf("rate plan [Code: %v] ends before it starts", r.Code)
+		}
+	}
+
+	return nil
+}
+
+func newHotel(h *pb.Hotel) *hotel {
+	doc := &hotel{
+		Id:          h.Id,
+		Name:        h.Name,
+		PhoneNumber: h.PhoneNumber,
+		Description: h.Description,
+		Address: &address{
+			StreetNumber: h.Address.StreetNumber,
+			StreetName:   h.Address.StreetName,
+			City:         h.Address.City,
+			State:        h.Address.State,
+			Country:      h.Address.Country,
+			PostalCode:   h.Address.PostalCode,
+			Lat:          h.Address.Lat,
+			Lon:          h.Address.Lon,
+		},
+	}
+	for _, img := range h.Images {
+		doc.Images = append(doc.Images, image{Url: img.Url, Default: img.Default})
+	}
+	return doc
+}
+
+func (h *hotel) toProto() *pb.Hotel {
+	res := &pb.Hotel{
+		Id:          h.Id,
+		Name:        h.Name,
+		PhoneNumber: h.PhoneNumber,
+		Description: h.Description,
+	}
+	if h.Address != nil {
+		res.Address = &pb.Address{
+			StreetNumber: h.Address.StreetNumber,
+			StreetName:   h.Address.StreetName,
+			City:         h.Address.City,
+			State:        h.Address.State,
+			Country:      h.Address.Country,
+			PostalCode:   h.Address.PostalCode,
+			Lat:          h.Address.Lat,
+			Lon:          h.Address.Lon,
+		}
+	}
+	for _, img := range h.Images {
+		res.Images = append(res.Images, &pb.Image{Url: img.Url, Default: img.Default})
+	}
+	return res
+}
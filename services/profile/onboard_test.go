@@ -0,0 +1,321 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	"github.com/appnetorg/hotel-reservation-arpc/cache"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// fakeServices records the calls made to the setters of the other services
+// and the user each one was made for, failing the one named by fail.
+type fakeServices struct {
+	pb.GeoClient
+	pb.RateClient
+	pb.RecommendationClient
+	pb.ReservationClient
+	fail string
+
+	calls []string
+	users []string
+	reqs  []proto.Message
+}
+
+func (f *fakeServices) record(ctx context.Context, method string, req proto.Message) error {
+	f.calls = append(f.calls, method)
+	f.users = append(f.users, metadata.FromOutgoingContext(ctx).Get(auth.UsernameKey))
+	f.reqs = append(f.reqs, req)
+	if method == f.fail {
+		return errors.New("unavailable")
+	}
+	return nil
+}
+
+func (f *fakeServices) SetLocation(ctx context.Context, req *pb.SetLocationRequest) (*pb.SetLocationResult, error) {
+	return &pb.SetLocationResult{}, f.record(ctx, "SetLocation", req)
+}
+
+func (f *fakeServices) SetRatePlans(ctx context.Context, req *pb.SetRatePlansRequest) (*pb.SetRatePlansResult, error) {
+	return &pb.SetRatePlansResult{}, f.record(ctx, "SetRatePlans", req)
+}
+
+func (f *fakeServices) SetHotel(ctx context.Context, req *pb.SetHotelRequest) (*pb.SetHotelResult, error) {
+	return &pb.SetHotelResult{}, f.record(ctx, "SetHotel", req)
+}
+
+func (f *fakeServices) SetCapacity(ctx context.Context, req *pb.SetCapacityRequest) (*pb.SetCapacityResult, error) {
+	return &pb.SetCapacityResult{}, f.record(ctx, "SetCapacity", req)
+}
+
+// fakeStore keeps hotels in memory like the profile-db collection.
+type fakeStore struct {
+	mu     sync.Mutex
+	hotels map[string]*hotel
+}
+
+func (f *fakeStore) insert(h *hotel) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.hotels[h.Id]; ok {
+		return &mgo.LastError{Code: 11000, Err: "E11000 duplicate key error"}
+	}
+	f.hotels[h.Id] = h
+	return nil
+}
+
+func (f *fakeStore) find(id string, projection bson.M) (*hotel, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	h, ok := f.hotels[id]
+	if !ok {
+		return nil, mgo.ErrNotFound
+	}
+	return h, nil
+}
+
+func (f *fakeStore) update(h *hotel) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.hotels[h.Id]; !ok {
+		return mgo.ErrNotFound
+	}
+	f.hotels[h.Id] = h
+	return nil
+}
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func newOnboardServer(f *fakeServices) *Server {
+	return &Server{
+		geoClient:            f,
+		rateClient:           f,
+		recommendationClient: f,
+		reservationClient:    f,
+		profiles:             cache.NewNear("profile-onboard-test", cache.NewStore[cachedHotel](cache.NewLocal(1<<20, false))),
+		store:                &fakeStore{hotels: make(map[string]*hotel)},
+	}
+}
+
+// managerCall returns the context of a call made by the manager of hotel 9.
+func managerCall() context.Context {
+	out := auth.NewOutgoingContext(context.Background(), auth.Principal{Username: "manager", Role: auth.HotelManager, Hotels: []string{"9"}}, testKey)
+	return metadata.NewIncomingContext(context.Background(), metadata.FromOutgoingContext(out))
+}
+
+var newHotelReq = &pb.HotelRequest{
+	Hotel: &pb.Hotel{
+		Id:          "9",
+		Name:        "Hotel Nine",
+		PhoneNumber: "(415) 000-0009",
+		Address:     &pb.Address{StreetName: "Market St", City: "San Francisco", Country: "United States", Lat: 37.78, Lon: -122.41},
+	},
+	RatePlans: []*pb.RatePlan{{Code: "RACK", InDate: "2015-04-09", OutDate: "2015-04-10", RoomType: &pb.RoomType{BookableRate: 109}}},
+	Rate:      4.5,
+	Price:     109,
+	Capacity:  20,
+}
+
+func TestOnboardHotel(t *testing.T) {
+	f := &fakeServices{}
+	s := newOnboardServer(f)
+	if err := s.onboardHotel(managerCall(), newHotelReq); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []proto.Message{
+		&pb.SetLocationRequest{HotelId: "9", Lat: 37.78, Lon: -122.41},
+		&pb.SetRatePlansRequest{HotelId: "9", RatePlans: newHotelReq.RatePlans},
+		&pb.SetHotelRequest{HotelId: "9", Lat: float64(float32(37.78)), Lon: float64(float32(-122.41)), Rate: 4.5, Price: 109},
+		&pb.SetCapacityRequest{HotelId: "9", NumberOfRoom: 20},
+	}
+	if len(f.reqs) != len(want) {
+		t.Fatalf("called %v, want the 4 setters", f.calls)
+	}
+	for i := range want {
+		if !proto.Equal(f.reqs[i], want[i]) {
+			t.Errorf("%v got %v, want %v", f.calls[i], f.reqs[i], want[i])
+		}
+		if f.users[i] != "manager" {
+			t.Errorf("%v made for %q, want the manager", f.calls[i], f.users[i])
+		}
+	}
+
+	if h, err := s.profiles.Get("9"); err != nil || !proto.Equal(h.Hotel, newHotelReq.Hotel) {
+		t.Errorf("cached profile = %v, %v", h.Hotel, err)
+	}
+}
+
+func TestOnboardHotelFails(t *testing.T) {
+	for i, fail := range setters {
+		f := &fakeServices{fail: fail}
+		err := newOnboardServer(f).onboardHotel(managerCall(), newHotelReq)
+		if err == nil {
+			t.Errorf("onboarding succeeded while %v fails", fail)
+		}
+		// later steps are skipped, retrying the request redoes all of them
+		if !slices.Equal(f.calls, setters[:i+1]) {
+			t.Errorf("with %v failing called %v, want %v", fail, f.calls, setters[:i+1])
+		}
+	}
+}
+
+// withHotel returns a copy of newHotelReq changed by set.
+func withHotel(set func(req *pb.HotelRequest)) *pb.HotelRequest {
+	req := proto.Clone(newHotelReq).(*pb.HotelRequest)
+	set(req)
+	return req
+}
+
+var setters = []string{"SetLocation", "SetRatePlans", "SetHotel", "SetCapacity"}
+
+func TestCreateHotel(t *testing.T) {
+	f := &fakeServices{}
+	s := newOnboardServer(f)
+	if _, _, err := s.CreateHotel(managerCall(), newHotelReq); err != nil {
+		t.Fatal(err)
+	}
+	if h, err := s.store.find("9", nil); err != nil || !reflect.DeepEqual(h, newHotel(newHotelReq.Hotel)) {
+		t.Errorf("stored %v, %v", h, err)
+	}
+	if !slices.Equal(f.calls, setters) {
+		t.Errorf("called %v, want %v", f.calls, setters)
+	}
+}
+
+func TestCreateHotelRetry(t *testing.T) {
+	f := &fakeServices{fail: "SetHotel"}
+	s := newOnboardServer(f)
+	if _, _, err := s.CreateHotel(managerCall(), newHotelReq); err == nil {
+		t.Fatal("created hotel while SetHotel fails")
+	}
+
+	// the profile is stored, retrying finishes the onboarding
+	f.fail, f.calls = "", nil
+	if _, _, err := s.CreateHotel(managerCall(), newHotelReq); err != nil {
+		t.Fatalf("retry failed: %v", err)
+	}
+	if !slices.Equal(f.calls, setters) {
+		t.Errorf("retry called %v, want %v", f.calls, setters)
+	}
+}
+
+func TestCreateHotelExists(t *testing.T) {
+	f := &fakeServices{}
+	s := newOnboardServer(f)
+	if _, _, err := s.CreateHotel(managerCall(), newHotelReq); err != nil {
+		t.Fatal(err)
+	}
+
+	f.calls = nil
+	other := withHotel(func(req *pb.HotelRequest) { req.Hotel.Name = "Other Hotel" })
+	_, _, err := s.CreateHotel(managerCall(), other)
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("creating a different hotel 9 got %v, want already exists", err)
+	}
+	if len(f.calls) != 0 {
+		t.Errorf("called %v for a hotel that exists", f.calls)
+	}
+	if h, _ := s.store.find("9", nil); h.Name != newHotelReq.Hotel.Name {
+		t.Errorf("stored name %q, want the first hotel kept", h.Name)
+	}
+}
+
+func TestUpdateHotel(t *testing.T) {
+	f := &fakeServices{}
+	s := newOnboardServer(f)
+	_, _, err := s.UpdateHotel(managerCall(), newHotelReq)
+	if err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("updating a missing hotel got %v, want does not exist", err)
+	}
+	if len(f.calls) != 0 {
+		t.Errorf("called %v for a missing hotel", f.calls)
+	}
+
+	if _, _, err := s.CreateHotel(managerCall(), newHotelReq); err != nil {
+		t.Fatal(err)
+	}
+	f.calls = nil
+	renamed := withHotel(func(req *pb.HotelRequest) { req.Hotel.Name = "Hotel Nine Renamed" })
+	if _, _, err := s.UpdateHotel(managerCall(), renamed); err != nil {
+		t.Fatal(err)
+	}
+	if h, _ := s.store.find("9", nil); h.Name != "Hotel Nine Renamed" {
+		t.Errorf("stored name %q after update", h.Name)
+	}
+	if c, err := s.profiles.Get("9"); err != nil || c.Name != "Hotel Nine Renamed" {
+		t.Errorf("cached profile = %v, %v", c.Hotel, err)
+	}
+	if !slices.Equal(f.calls, setters) {
+		t.Errorf("called %v, want %v", f.calls, setters)
+	}
+}
+
+func TestUpdateHotelOwnership(t *testing.T) {
+	e := auth.NewElement(testKey)
+	other := withHotel(func(req *pb.HotelRequest) { req.Hotel.Id = "10" })
+	tests := []struct {
+		name    string
+		method  string
+		req     *pb.HotelRequest
+		allowed bool
+	}{
+		{"own hotel", "UpdateHotel", newHotelReq, true},
+		{"other hotel", "UpdateHotel", other, false},
+		{"create", "CreateHotel", newHotelReq, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &element.RPCRequest{ServiceName: "Profile", Method: tt.method, Payload: tt.req}
+			if _, _, err := e.ProcessRequest(managerCall(), req); (err == nil) != tt.allowed {
+				t.Errorf("manager of hotel 9 calling %v for hotel %v got %v, allowed %v", tt.method, tt.req.Hotel.Id, err, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestValidateHotel(t *testing.T) {
+	tests := []struct {
+		name string
+		set  func(req *pb.HotelRequest)
+		err  string
+	}{
+		{"valid", func(req *pb.HotelRequest) {}, ""},
+		{"no profile", func(req *pb.HotelRequest) { req.Hotel = nil }, "must be set"},
+		{"no name", func(req *pb.HotelRequest) { req.Hotel.Name = "" }, "must be set"},
+		{"no address", func(req *pb.HotelRequest) { req.Hotel.Address = nil }, "has no address"},
+		{"no city", func(req *pb.HotelRequest) { req.Hotel.Address.City = "" }, "street, city and country"},
+		{"bad lat", func(req *pb.HotelRequest) { req.Hotel.Address.Lat = 91 }, "invalid coordinates"},
+		{"bad lon", func(req *pb.HotelRequest) { req.Hotel.Address.Lon = -181 }, "invalid coordinates"},
+		{"no rooms", func(req *pb.HotelRequest) { req.Capacity = 0 }, "at least one room"},
+		{"negative price", func(req *pb.HotelRequest) { req.Price = -1 }, "must not be negative"},
+		{"plan of other hotel", func(req *pb.HotelRequest) { req.RatePlans[0].HotelId = "10" }, "belongs to hotel"},
+		{"plan of this hotel", func(req *pb.HotelRequest) { req.RatePlans[0].HotelId = "9" }, ""},
+		{"plan without room type", func(req *pb.HotelRequest) { req.RatePlans[0].RoomType = nil }, "need a code and a room type"},
+		{"bad inDate", func(req *pb.HotelRequest) { req.RatePlans[0].InDate = "04/09/2015" }, "invalid inDate"},
+		{"bad outDate", func(req *pb.HotelRequest) { req.RatePlans[0].OutDate = "" }, "invalid outDate"},
+		{"plan ends first", func(req *pb.HotelRequest) { req.RatePlans[0].OutDate = "2015-04-09" }, "ends before it starts"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHotel(withHotel(tt.set))
+			if tt.err == "" && err != nil {
+				t.Errorf("got %v, want valid", err)
+			}
+			if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+				t.Errorf("got %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
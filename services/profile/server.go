@@ -1,12 +1,12 @@
 package profile
 
 import (
-	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"slices"
 	"strconv"
 
 	"gopkg.in/mgo.v2"
-	"gopkg.in/mgo.v2/bson"
 
 	// "os"
 	"sync"
@@ -15,13 +15,17 @@ import (
 
 	"context"
 
-	"github.com/appnet-org/arpc/pkg/rpc"
-	"github.com/appnet-org/arpc/pkg/serializer"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	"github.com/appnetorg/hotel-reservation-arpc/cache"
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/grpcpb"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/rawpb"
+	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
-
-	"github.com/bradfitz/gomemcache/memcache"
+	"google.golang.org/grpc"
 	// "strings"
 )
 
@@ -29,12 +33,24 @@ const _ = "srv-profile"
 
 // Server implements the profile service
 type Server struct {
+	geoClient            pb.GeoClient
+	rateClient           pb.RateClient
+	recommendationClient pb.RecommendationClient
+	reservationClient    pb.ReservationClient
+
 	Tracer       opentracing.Tracer
 	uuid         string
 	Port         int
 	IpAddr       string
 	MongoSession *mgo.Session
-	MemcClient   *memcache.Client
+	AuthKey      []byte            // verifies callers of methods that need a role
+	Transport    *transport.Config // picks aRPC or gRPC per hop
+	Cache        cache.Cache       // caches the profiles of hotels
+
+	profiles *cache.Near[cachedHotel]
+	store    store
+	// profileLocks serialize the cache writes of a hotel in this replica
+	profileLocks [64]sync.Mutex
 }
 
 // Run starts the server
@@ -46,17 +62,54 @@ func (s *Server) Run() error {
 	}
 
 	s.uuid = uuid.New().String()
+	s.profiles = cache.NewNear("profile", cache.NewStore[cachedHotel](s.Cache))
+	if s.store == nil {
+		s.store = mongoStore{session: s.MongoSession}
+	}
 
-	serializer := &serializer.SymphonySerializer{}
-	server, err := rpc.NewServer(s.IpAddr+":"+strconv.Itoa(s.Port), serializer, nil)
+	elements := slices.Concat(tracing.ServerElements(s.Tracer), logs.ServerElements(), auth.Elements(s.AuthKey))
+	server, err := s.Transport.NewServer("profile", s.IpAddr+":"+strconv.Itoa(s.Port), elements)
 
 	if err != nil {
 		log.Error().Msgf("Failed to start aRPC server: %v", err)
 		return err
 	}
 
-	pb.RegisterProfileServer(server, s)
+	if s.Transport.ServesRaw("profile") {
+		if err := rawpb.RegisterProfile(server, s); err != nil {
+			return err
+		}
+	} else {
+		pb.RegisterProfileServer(server, s)
+	}
 
+	if s.Transport.ServesGRPC("profile") {
+		err := transport.ServeGRPC(s.IpAddr+":"+strconv.Itoa(s.Port), elements, func(g grpc.ServiceRegistrar) {
+			grpcpb.RegisterProfile(g, s)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// init clients used to onboard hotels before starting the server
+	if err := s.initGeoClient("geo.default.svc.cluster.local:11003"); err != nil {
+		return err
+	}
+	if err := s.initRateClient("rate.default.svc.cluster.local:11004"); err != nil {
+		return err
+	}
+	if err := s.initRecommendationClient("recommendation.default.svc.cluster.local:11005"); err != nil {
+		return err
+	}
+	if err := s.initReservationClient("reservation.default.svc.cluster.local:11007"); err != nil {
+		return err
+	}
+
+	// finish the spans of calls failing in their handler
+	if err := transport.WrapHandlers(server, tracing.WrapHandler); err != nil {
+		return err
+	}
 	server.Start()
 
 	return nil
@@ -66,72 +119,110 @@ func (s *Server) Run() error {
 func (s *Server) Shutdown() {
 }
 
-// GetProfiles returns hotel profiles for requested IDs
+func (s *Server) initGeoClient(name string) error {
+	var err error
+	s.geoClient, err = transport.Dial(s.Transport, "profile", "geo", name, slices.Concat(tracing.ClientElements(s.Tracer), logs.ClientElements()), pb.NewGeoClient, grpcpb.NewGeo)
+	return err
+}
+
+func (s *Server) initRateClient(name string) error {
+	var err error
+	s.rateClient, err = transport.Dial(s.Transport, "profile", "rate", name, slices.Concat(tracing.ClientElements(s.Tracer), logs.ClientElements()), pb.NewRateClient, grpcpb.NewRate)
+	return err
+}
+
+func (s *Server) initRecommendationClient(name string) error {
+	var err error
+	s.recommendationClient, err = transport.Dial(s.Transport, "profile", "recommendation", name, slices.Concat(tracing.ClientElements(s.Tracer), logs.ClientElements()), pb.NewRecommendationClient, grpcpb.NewRecommendation)
+	return err
+}
+
+func (s *Server) initReservationClient(name string) error {
+	var err error
+	s.reservationClient, err = transport.Dial(s.Transport, "profile", "reservation", name, slices.Concat(tracing.ClientElements(s.Tracer), logs.ClientElements()), pb.NewReservationClient, grpcpb.NewReservation)
+	return err
+}
+
+// GetProfiles returns hotel profiles for requested IDs, trimmed to the requested fields
 func (s *Server) GetProfiles(ctx context.Context, req *pb.GetProfilesRequest) (*pb.GetProfilesResult, context.Context, error) {
+	return s.getProfiles(ctx, req.HotelIds, req.Fields)
+}
+
+// GetProfilesRaw is GetProfiles reading the hotel ids and fields straight
+// from the request payload.
+func (s *Server) GetProfilesRaw(ctx context.Context, req pb.GetProfilesRequestRaw) (*pb.GetProfilesResult, context.Context, error) {
+	return s.getProfiles(ctx, req.GetHotelIds(), req.GetFields())
+}
+
+func (s *Server) getProfiles(ctx context.Context, ids, fields []string) (*pb.GetProfilesResult, context.Context, error) {
 	// session, err := mgo.Dial("mongodb-profile")
 	// if err != nil {
 	// 	panic(err)
 	// }
 	// defer session.Close()
 
+	mask, err := newFieldMask(fields)
+	if err != nil {
+		return nil, ctx, err
+	}
+
 	res := new(pb.GetProfilesResult)
 	hotels := make([]*pb.Hotel, 0)
 	var wg sync.WaitGroup
 	var mutex sync.Mutex
 
 	// one hotel should only have one profile
-	hotelIds := make([]string, 0)
-	profileMap := make(map[string]struct{})
-	for _, hotelId := range req.HotelIds {
+	hotelIds := make([]string, 0, len(ids))
+	profileMap := make(map[string]fieldMask, len(ids))
+	for _, hotelId := range ids {
 		hotelIds = append(hotelIds, hotelId)
-		profileMap[hotelId] = struct{}{}
+		profileMap[hotelId] = mask
 	}
 
 	memSpan, _ := opentracing.StartSpanFromContext(ctx, "memcached_get_profile")
 	memSpan.SetTag("span.kind", "client")
-	resMap, err := s.MemcClient.GetMulti(hotelIds)
+	resMap, err := s.profiles.GetMulti(hotelIds)
 	memSpan.Finish()
-	if err != nil && err != memcache.ErrCacheMiss {
-		log.Panic().Msgf("Tried to get hotelIds [%v], but got memmcached error = %s", hotelIds, err)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Tried to get hotelIds [%v], but got cache error = %s", hotelIds, err)
+		return nil, ctx, err
 	} else {
-		for hotelId, item := range resMap {
-			hotelProf := new(pb.Hotel)
-			json.Unmarshal(item.Value, hotelProf)
-			hotels = append(hotels, hotelProf)
+		for hotelId, cached := range resMap {
+			if cached.Hotel == nil {
+				continue
+			}
+			// a partial profile missing requested fields is refetched together
+			// with the fields it already holds, so cached profiles only grow
+			if !mask.coveredBy(cached.mask()) {
+				profileMap[hotelId] = mask.union(cached.mask())
+				continue
+			}
+			hotels = append(hotels, mask.trim(cached.Hotel))
 			delete(profileMap, hotelId)
 		}
 
 		wg.Add(len(profileMap))
-		for hotelId := range profileMap {
-			go func(hotelId string) {
-				session := s.MongoSession.Copy()
-				defer session.Close()
-				c := session.DB("profile-db").C("hotels")
-
-				hotelProf := new(pb.Hotel)
+		for hotelId, fetchMask := range profileMap {
+			go func(hotelId string, fetchMask fieldMask) {
+				defer wg.Done()
 				mongoSpan, _ := opentracing.StartSpanFromContext(ctx, "mongo_profile")
 				mongoSpan.SetTag("span.kind", "client")
-				err := c.Find(bson.M{"id": hotelId}).One(&hotelProf)
+				hotelDoc, err := s.store.find(hotelId, fetchMask.projection())
 				mongoSpan.Finish()
 
 				if err != nil {
-					log.Error().Msgf("Failed get hotels data: %v", err)
+					log.Ctx(ctx).Error().Msgf("Failed get hotels data: %v", err)
+					return
 				}
+				hotelProf := hotelDoc.toProto()
 
 				mutex.Lock()
-				hotels = append(hotels, hotelProf)
+				hotels = append(hotels, mask.trim(hotelProf))
 				mutex.Unlock()
 
-				profJson, err := json.Marshal(hotelProf)
-				if err != nil {
-					log.Error().Msgf("Failed to marshal hotel [id: %v] with err: %v", hotelProf.Id, err)
-				}
-				memcStr := string(profJson)
-
-				// write to memcached
-				go s.MemcClient.Set(&memcache.Item{Key: hotelId, Value: []byte(memcStr)})
-				defer wg.Done()
-			}(hotelId)
+				// write to the cache
+				go s.cacheProfile(hotelId, cachedHotel{Fields: fetchMask.paths(), Hotel: hotelProf})
+			}(hotelId, fetchMask)
 		}
 	}
 	wg.Wait()
@@ -139,3 +230,25 @@ func (s *Server) GetProfiles(ctx context.Context, req *pb.GetProfilesRequest) (*
 	res.Hotels = hotels
 	return res, ctx, nil
 }
+
+// profileLock returns the lock guarding the cached profile of a hotel.
+func (s *Server) profileLock(hotelId string) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write([]byte(hotelId))
+	return &s.profileLocks[h.Sum32()%uint32(len(s.profileLocks))]
+}
+
+// cacheProfile merges a fetched profile into the cached one, so a partial
+// profile written after a fuller one does not drop fields.
+func (s *Server) cacheProfile(hotelId string, fetched cachedHotel) {
+	lock := s.profileLock(hotelId)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if cached, err := s.profiles.Get(hotelId); err == nil {
+		fetched = cached.merge(fetched)
+	}
+	if err := s.profiles.Set(hotelId, fetched); err != nil {
+		log.Error().Msgf("Failed to cache hotel [id: %v] with err: %v", hotelId, err)
+	}
+}
@@ -0,0 +1,51 @@
+package profile
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// store keeps the hotel profiles. It is mongodb outside of tests.
+type store interface {
+	// insert adds a hotel, or returns an error mgo.IsDup accepts when the id
+	// is taken.
+	insert(h *hotel) error
+	// find returns the hotel with the id holding the paths of projection, or
+	// mgo.ErrNotFound when there is none. A nil projection returns all of it.
+	find(id string, projection bson.M) (*hotel, error)
+	// update replaces the hotel with the same id, or returns mgo.ErrNotFound
+	// when there is none.
+	update(h *hotel) error
+}
+
+type mongoStore struct {
+	session *mgo.Session
+}
+
+func (m mongoStore) insert(h *hotel) error {
+	session := m.session.Copy()
+	defer session.Close()
+	c := session.DB("profile-db").C("hotels")
+
+	// the unique index on id rejects a hotel created twice, also when
+	// both requests arrive at once
+	return c.Insert(h)
+}
+
+func (m mongoStore) find(id string, projection bson.M) (*hotel, error) {
+	session := m.session.Copy()
+	defer session.Close()
+	c := session.DB("profile-db").C("hotels")
+
+	h := new(hotel)
+	err := c.Find(bson.M{"id": id}).Select(projection).One(h)
+	return h, err
+}
+
+func (m mongoStore) update(h *hotel) error {
+	session := m.session.Copy()
+	defer session.Close()
+	c := session.DB("profile-db").C("hotels")
+
+	return c.Update(&bson.M{"id": h.Id}, h)
+}
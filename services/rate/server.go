@@ -1,8 +1,8 @@
 package rate
 
 import (
-	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"context"
@@ -14,17 +14,18 @@ import (
 	"sort"
 	"sync"
 
-	"github.com/appnet-org/arpc/pkg/rpc"
-	"github.com/appnet-org/arpc/pkg/serializer"
 	"github.com/rs/zerolog/log"
 
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	"github.com/appnetorg/hotel-reservation-arpc/cache"
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/grpcpb"
+	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
-
-	"strings"
-
-	"github.com/bradfitz/gomemcache/memcache"
+	"google.golang.org/grpc"
 )
 
 const _ = "srv-rate"
@@ -35,8 +36,12 @@ type Server struct {
 	Port         int
 	IpAddr       string
 	MongoSession *mgo.Session
-	MemcClient   *memcache.Client
-	uuid         string
+	AuthKey      []byte            // verifies callers of methods that need a role
+	Transport    *transport.Config // picks aRPC or gRPC per hop
+	Cache        cache.Cache       // caches the rate plans of hotels
+
+	plans *cache.Near[RatePlans]
+	uuid  string
 }
 
 // Run starts the server
@@ -48,9 +53,10 @@ func (s *Server) Run() error {
 	}
 
 	s.uuid = uuid.New().String()
+	s.plans = cache.NewNear("rate", cache.NewStore[RatePlans](s.Cache))
 
-	serializer := &serializer.SymphonySerializer{}
-	server, err := rpc.NewServer(s.IpAddr+":"+strconv.Itoa(s.Port), serializer, nil)
+	elements := slices.Concat(tracing.ServerElements(s.Tracer), logs.ServerElements(), auth.Elements(s.AuthKey))
+	server, err := s.Transport.NewServer("rate", s.IpAddr+":"+strconv.Itoa(s.Port), elements)
 
 	if err != nil {
 		log.Error().Msgf("Failed to start aRPC server: %v", err)
@@ -59,6 +65,19 @@ func (s *Server) Run() error {
 
 	pb.RegisterRateServer(server, s)
 
+	if s.Transport.ServesGRPC("rate") {
+		err := transport.ServeGRPC(s.IpAddr+":"+strconv.Itoa(s.Port), elements, func(g grpc.ServiceRegistrar) {
+			grpcpb.RegisterRate(g, s)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// finish the spans of calls failing in their handler
+	if err := transport.WrapHandlers(server, tracing.WrapHandler); err != nil {
+		return err
+	}
 	server.Start()
 
 	return nil
@@ -88,24 +107,17 @@ func (s *Server) GetRates(ctx context.Context, req *pb.GetRatesRequest) (*pb.Get
 	// first check memcached(get-multi)
 	memSpan, _ := opentracing.StartSpanFromContext(ctx, "memcached_get_multi_rate")
 	memSpan.SetTag("span.kind", "client")
-	resMap, err := s.MemcClient.GetMulti(hotelIds)
+	resMap, err := s.plans.GetMulti(hotelIds)
 	memSpan.Finish()
 	var wg sync.WaitGroup
 	var mutex sync.Mutex
-	if err != nil && err != memcache.ErrCacheMiss {
-		log.Panic().Msgf("Memmcached error while trying to get hotel [id: %v]= %s", hotelIds, err)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Cache error while trying to get hotel [id: %v]= %s", hotelIds, err)
+		return nil, ctx, err
 	} else {
-		for _, item := range resMap {
-			_ = strings.Split(string(item.Value), "\n") // unused for now
-
-			// for _, rateStr := range rateStrs {
-			// 	if len(rateStr) != 0 {
-			// 		log.Info().Msgf("rateStr: %v", rateStr)
-			// 		rateP := new(RatePlan)
-			// 		json.Unmarshal([]byte(rateStr), rateP)
-			// 		ratePlans = append(ratePlans, rateP)
-			// 	}
-			// }
+		for hotelId, plans := range resMap {
+			ratePlans = append(ratePlans, plans...)
+			delete(rateMap, hotelId)
 		}
 		wg.Add(len(rateMap))
 		for hotelId := range rateMap {
@@ -114,7 +126,6 @@ func (s *Server) GetRates(ctx context.Context, req *pb.GetRatesRequest) (*pb.Get
 				session := s.MongoSession.Copy()
 				defer session.Close()
 				c := session.DB("rate-db").C("inventory")
-				memcStr := ""
 				tmpRatePlans := make(RatePlans, 0)
 				mongoSpan, _ := opentracing.StartSpanFromContext(ctx, "mongo_rate")
 				mongoSpan.SetTag("span.kind", "client")
@@ -123,18 +134,11 @@ func (s *Server) GetRates(ctx context.Context, req *pb.GetRatesRequest) (*pb.Get
 				if err != nil {
 					log.Panic().Msgf("Tried to find hotelId [%v], but got error: %s", id, err.Error())
 				} else {
-					for _, r := range tmpRatePlans {
-						mutex.Lock()
-						ratePlans = append(ratePlans, r)
-						mutex.Unlock()
-						rateJson, err := json.Marshal(r)
-						if err != nil {
-							log.Error().Msgf("Failed to marshal plan [Code: %v] with error: %s", r.Code, err)
-						}
-						memcStr = memcStr + string(rateJson) + "\n"
-					}
+					mutex.Lock()
+					ratePlans = append(ratePlans, tmpRatePlans...)
+					mutex.Unlock()
 				}
-				go s.MemcClient.Set(&memcache.Item{Key: id, Value: []byte(memcStr)})
+				go s.plans.Set(id, tmpRatePlans)
 
 				defer wg.Done()
 			}(hotelId)
@@ -170,6 +174,56 @@ func (s *Server) GetRates(ctx context.Context, req *pb.GetRatesRequest) (*pb.Get
 	return result, ctx, nil
 }
 
+// SetRatePlans replaces all rate plans of a hotel.
+func (s *Server) SetRatePlans(ctx context.Context, req *pb.SetRatePlansRequest) (*pb.SetRatePlansResult, context.Context, error) {
+	if req.HotelId == "" {
+		return nil, ctx, fmt.Errorf("hotel id must be set")
+	}
+
+	ratePlans := make([]interface{}, 0, len(req.RatePlans))
+	for _, r := range req.RatePlans {
+		if r.RoomType == nil {
+			return nil, ctx, fmt.Errorf("rate plan [Code: %v] of hotel [%v] has no room type", r.Code, req.HotelId)
+		}
+		ratePlans = append(ratePlans, &RatePlan{
+			HotelId: req.HotelId,
+			Code:    r.Code,
+			InDate:  r.InDate,
+			OutDate: r.OutDate,
+			RoomType: &RoomType{
+				BookableRate:       r.RoomType.BookableRate,
+				Code:               r.RoomType.Code,
+				RoomDescription:    r.RoomType.RoomDescription,
+				TotalRate:          r.RoomType.TotalRate,
+				TotalRateInclusive: r.RoomType.TotalRateInclusive,
+			},
+		})
+	}
+
+	session := s.MongoSession.Copy()
+	defer session.Close()
+	c := session.DB("rate-db").C("inventory")
+
+	if _, err := c.RemoveAll(&bson.M{"hotelId": req.HotelId}); err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to remove rate plans of hotel [%v]: %v", req.HotelId, err)
+		return nil, ctx, err
+	}
+	if len(ratePlans) > 0 {
+		if err := c.Insert(ratePlans...); err != nil {
+			log.Ctx(ctx).Error().Msgf("Failed to insert rate plans of hotel [%v]: %v", req.HotelId, err)
+			return nil, ctx, err
+		}
+	}
+
+	// drop the cached plans so the next read goes to mongo, other replicas
+	// keep their near copy for up to NEAR_CACHE_TTL
+	if err := s.plans.Delete(req.HotelId); err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to invalidate rate plans of hotel [%v] in the cache: %v", req.HotelId, err)
+	}
+
+	return &pb.SetRatePlansResult{HotelId: req.HotelId}, ctx, nil
+}
+
 type RoomType struct {
 	BookableRate       float64 `bson:"bookableRate"`
 	Code               string  `bson:"code"`
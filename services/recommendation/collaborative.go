@@ -0,0 +1,142 @@
+package recommendation
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// defaultModelInterval is how often the model is rebuilt unless
+// MODEL_RELOAD_INTERVAL is set.
+var defaultModelInterval = time.Hour
+
+// cfModel is an item-item collaborative filtering model built from past
+// reservations: two hotels are similar when the same guests booked both.
+type cfModel struct {
+	// hotels booked by each guest
+	booked map[string][]string
+	// similar hotels of each hotel, most similar first
+	similar map[string][]neighbor
+	// share of guests who booked each hotel, relative to the most booked one
+	popularity map[string]float64
+}
+
+type neighbor struct {
+	hotelId string
+	sim     float64
+}
+
+// loadCFModel builds the model from the distinct guest/hotel pairs in reservation-db.
+func loadCFModel(session *mgo.Session) (*cfModel, error) {
+	sess := session.Copy()
+	defer sess.Close()
+	c := sess.DB("reservation-db").C("reservation")
+
+	// every night of a stay is stored as its own reservation, so count each
+	// guest once per hotel
+	pipe := c.Pipe([]bson.M{
+		{"$group": bson.M{"_id": bson.M{"customerName": "$customerName", "hotelId": "$hotelId"}}},
+	}).AllowDiskUse()
+
+	var pair struct {
+		ID struct {
+			CustomerName string `bson:"customerName"`
+			HotelId      string `bson:"hotelId"`
+		} `bson:"_id"`
+	}
+	booked := make(map[string][]string)
+	iter := pipe.Iter()
+	for iter.Next(&pair) {
+		booked[pair.ID.CustomerName] = append(booked[pair.ID.CustomerName], pair.ID.HotelId)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	return newCFModel(booked), nil
+}
+
+func newCFModel(booked map[string][]string) *cfModel {
+	guests := make(map[string]int)
+	together := make(map[string]map[string]int)
+	for _, hotelIds := range booked {
+		for i, a := range hotelIds {
+			guests[a]++
+			for _, b := range hotelIds[i+1:] {
+				if together[a] == nil {
+					together[a] = make(map[string]int)
+				}
+				if together[b] == nil {
+					together[b] = make(map[string]int)
+				}
+				together[a][b]++
+				together[b][a]++
+			}
+		}
+	}
+
+	model := &cfModel{
+		booked:     booked,
+		similar:    make(map[string][]neighbor, len(together)),
+		popularity: make(map[string]float64, len(guests)),
+	}
+
+	// cosine similarity between the sets of guests of two hotels
+	for a, others := range together {
+		ns := make([]neighbor, 0, len(others))
+		for b, n := range others {
+			ns = append(ns, neighbor{b, float64(n) / math.Sqrt(float64(guests[a]*guests[b]))})
+		}
+		sort.Slice(ns, func(i, j int) bool {
+			return ns[i].sim > ns[j].sim || (ns[i].sim == ns[j].sim && ns[i].hotelId < ns[j].hotelId)
+		})
+		model.similar[a] = ns
+	}
+
+	most := 0
+	for _, n := range guests {
+		if n > most {
+			most = n
+		}
+	}
+	for h, n := range guests {
+		model.popularity[h] = float64(n) / float64(most)
+	}
+
+	return model
+}
+
+// scores returns how likely the user is to book each hotel they have not booked
+// yet. Guests without history, or whose hotels were booked by nobody else,
+// get the most popular hotels.
+func (m *cfModel) scores(username string) map[string]float64 {
+	hotelIds := m.booked[username]
+	if len(hotelIds) == 0 {
+		return m.popularity
+	}
+
+	seen := make(map[string]struct{}, len(hotelIds))
+	for _, h := range hotelIds {
+		seen[h] = struct{}{}
+	}
+
+	res := make(map[string]float64)
+	for _, h := range hotelIds {
+		for _, n := range m.similar[h] {
+			if _, ok := seen[n.hotelId]; !ok {
+				res[n.hotelId] += n.sim / float64(len(hotelIds))
+			}
+		}
+	}
+	if len(res) == 0 {
+		for h, p := range m.popularity {
+			if _, ok := seen[h]; !ok {
+				res[h] = p
+			}
+		}
+	}
+	return res
+}
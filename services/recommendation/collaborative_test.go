@@ -0,0 +1,89 @@
+package recommendation
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+)
+
+var testBookings = map[string][]string{
+	"Cornell_1": {"1", "2"},
+	"Cornell_2": {"1", "2", "3"},
+	"Cornell_3": {"3"},
+	"Cornell_4": {"3"},
+	// booked a hotel nobody else did
+	"Cornell_5": {"4"},
+}
+
+func TestNewCFModel(t *testing.T) {
+	m := newCFModel(testBookings)
+
+	// cosine of the guest sets: 1 and 2 share both guests, 1 and 3 one of
+	// 2 and 3 guests
+	want := map[string][]neighbor{
+		"1": {{"2", 1}, {"3", 1 / math.Sqrt(6)}},
+		"2": {{"1", 1}, {"3", 1 / math.Sqrt(6)}},
+		"3": {{"1", 1 / math.Sqrt(6)}, {"2", 1 / math.Sqrt(6)}},
+	}
+	if !reflect.DeepEqual(m.similar, want) {
+		t.Errorf("similar = %v, want %v", m.similar, want)
+	}
+
+	wantPopularity := map[string]float64{"1": 2.0 / 3, "2": 2.0 / 3, "3": 1, "4": 1.0 / 3}
+	if !reflect.DeepEqual(m.popularity, wantPopularity) {
+		t.Errorf("popularity = %v, want %v", m.popularity, wantPopularity)
+	}
+}
+
+func TestScores(t *testing.T) {
+	m := newCFModel(testBookings)
+	for _, tt := range []struct {
+		username string
+		want     map[string]float64
+	}{
+		// the booked hotels are left out
+		{"Cornell_1", map[string]float64{"3": 1 / math.Sqrt(6)}},
+		{"Cornell_3", map[string]float64{"1": 1 / math.Sqrt(6), "2": 1 / math.Sqrt(6)}},
+		// no neighbours not booked yet, the popular hotels not booked yet
+		{"Cornell_2", map[string]float64{"4": 1.0 / 3}},
+		{"Cornell_5", map[string]float64{"1": 2.0 / 3, "2": 2.0 / 3, "3": 1}},
+		// no history
+		{"Cornell_6", m.popularity},
+	} {
+		if got := m.scores(tt.username); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("scores(%v) = %v, want %v", tt.username, got, tt.want)
+		}
+	}
+}
+
+func TestRecommendSimilar(t *testing.T) {
+	hotels := make(map[string]Hotel)
+	for _, id := range []string{"1", "2", "3", "4", "5"} {
+		hotels[id] = Hotel{HId: id}
+	}
+	s := &Server{index: newHotelIndex(hotels), cf: newCFModel(testBookings)}
+
+	for _, tt := range []struct {
+		username string
+		k        int32
+		want     []string
+	}{
+		{"Cornell_1", 0, []string{"3"}},
+		{"Cornell_1", 5, []string{"3"}},
+		{"Cornell_3", 5, []string{"1", "2"}},
+		{"Cornell_2", 5, []string{"4"}},
+		{"Cornell_5", 0, []string{"3"}},
+		{"Cornell_5", 5, []string{"3", "1", "2"}},
+	} {
+		res, _, err := s.GetRecommendations(context.Background(), &pb.GetRecommendationsRequest{Require: "similar", Username: tt.username, K: tt.k})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res.HotelIds, tt.want) {
+			t.Errorf("similar for %v, k %d = %v, want %v", tt.username, tt.k, res.HotelIds, tt.want)
+		}
+	}
+}
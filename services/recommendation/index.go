@@ -0,0 +1,239 @@
+package recommendation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/hailocab/go-geoindex"
+)
+
+const (
+	// geo cell size and search radius used for distance lookups. Requests
+	// not satisfied within the radius fall back to scanning every hotel.
+	geoResolution   = 10
+	maxSearchRadius = 100
+
+	// earthRadius is the radius geoindex.Distance assumes, in meters.
+	earthRadius = 6371000
+)
+
+// hotelIndex holds the hotels ordered by every criterion, so single-criterion
+// requests only touch the hotels they return. It is immutable once built.
+type hotelIndex struct {
+	hotels  map[string]Hotel
+	byRate  []Hotel // best rated first
+	byPrice []Hotel // cheapest first
+	points  *geoindex.PointsIndex
+
+	minRate, maxRate   float64
+	minPrice, maxPrice float64
+}
+
+// scored is a ranked hotel.
+type scored struct {
+	hotel Hotel
+	score float64
+}
+
+func newHotelIndex(hotels map[string]Hotel) *hotelIndex {
+	idx := &hotelIndex{
+		hotels:   hotels,
+		byRate:   make([]Hotel, 0, len(hotels)),
+		byPrice:  make([]Hotel, 0, len(hotels)),
+		points:   geoindex.NewPointsIndex(geoindex.Km(geoResolution)),
+		minRate:  math.MaxFloat64,
+		minPrice: math.MaxFloat64,
+	}
+
+	for _, hotel := range hotels {
+		idx.byRate = append(idx.byRate, hotel)
+		idx.byPrice = append(idx.byPrice, hotel)
+		idx.points.Add(point(hotel))
+		idx.minRate = math.Min(idx.minRate, hotel.HRate)
+		idx.maxRate = math.Max(idx.maxRate, hotel.HRate)
+		idx.minPrice = math.Min(idx.minPrice, hotel.HPrice)
+		idx.maxPrice = math.Max(idx.maxPrice, hotel.HPrice)
+	}
+
+	// ties are ordered by id so results are stable between requests
+	sort.Slice(idx.byRate, func(i, j int) bool {
+		a, b := idx.byRate[i], idx.byRate[j]
+		return a.HRate > b.HRate || (a.HRate == b.HRate && a.HId < b.HId)
+	})
+	sort.Slice(idx.byPrice, func(i, j int) bool {
+		a, b := idx.byPrice[i], idx.byPrice[j]
+		return a.HPrice < b.HPrice || (a.HPrice == b.HPrice && a.HId < b.HId)
+	})
+
+	return idx
+}
+
+// with returns a new index with hotel added or replaced.
+func (idx *hotelIndex) with(hotel Hotel) *hotelIndex {
+	hotels := make(map[string]Hotel, len(idx.hotels)+1)
+	for id, h := range idx.hotels {
+		hotels[id] = h
+	}
+	hotels[hotel.HId] = hotel
+	return newHotelIndex(hotels)
+}
+
+// rateScore maps the rate of a hotel to [0, 1], best rated is 1.
+func (idx *hotelIndex) rateScore(h Hotel) float64 {
+	if idx.maxRate == idx.minRate {
+		return 1
+	}
+	return (h.HRate - idx.minRate) / (idx.maxRate - idx.minRate)
+}
+
+// priceScore maps the price of a hotel to [0, 1], cheapest is 1.
+func (idx *hotelIndex) priceScore(h Hotel) float64 {
+	if idx.maxPrice == idx.minPrice {
+		return 1
+	}
+	return (idx.maxPrice - h.HPrice) / (idx.maxPrice - idx.minPrice)
+}
+
+// disScore maps the distance between a hotel and a point to (0, 1], closest is 1.
+func disScore(p geoindex.Point, h Hotel) float64 {
+	km := float64(geoindex.Distance(p, point(h))) / 1000
+	return 1 / (1 + km)
+}
+
+// topByRate returns the k best rated hotels.
+func (idx *hotelIndex) topByRate(k int) []scored {
+	res := make([]scored, 0, len(idx.byRate))
+	for _, h := range idx.byRate {
+		res = append(res, scored{h, idx.rateScore(h)})
+		if k > 0 && len(res) == k || k <= 0 && h.HRate != idx.byRate[0].HRate {
+			break
+		}
+	}
+	return trimTies(res, k)
+}
+
+// topByPrice returns the k cheapest hotels.
+func (idx *hotelIndex) topByPrice(k int) []scored {
+	res := make([]scored, 0, len(idx.byPrice))
+	for _, h := range idx.byPrice {
+		res = append(res, scored{h, idx.priceScore(h)})
+		if k > 0 && len(res) == k || k <= 0 && h.HPrice != idx.byPrice[0].HPrice {
+			break
+		}
+	}
+	return trimTies(res, k)
+}
+
+// nearest returns the k hotels closest to p, the same as ranking every hotel
+// by disScore. It looks in boxes around p of growing size until the box
+// holds k hotels and the k-th closest of them is within the box, so no hotel
+// outside is closer.
+func (idx *hotelIndex) nearest(p geoindex.Point, k int) []scored {
+	want := k
+	if k <= 0 {
+		// hotels tied with the closest are as close as it
+		want = 1
+	}
+	byDistance := func(h Hotel) float64 { return disScore(p, h) }
+	if want > len(idx.hotels) {
+		return idx.rank(byDistance, k)
+	}
+
+	radius := geoindex.Km(geoResolution)
+	for radius <= geoindex.Km(maxSearchRadius) {
+		res, ok := idx.within(p, radius)
+		if !ok {
+			break
+		}
+		if len(res) < want {
+			radius *= 2
+			continue
+		}
+		if d := geoindex.Distance(p, point(res[want-1].hotel)); d > radius {
+			// the corners of the box are farther than radius
+			radius = d
+			continue
+		}
+		return trimTies(res, k)
+	}
+	return idx.rank(byDistance, k)
+}
+
+// within returns the hotels in a box around p holding every point within
+// radius of it, ranked by distance. It fails when the box would reach over
+// a pole or the antimeridian.
+func (idx *hotelIndex) within(p geoindex.Point, radius geoindex.Meters) ([]scored, bool) {
+	// a little larger, so rounding cannot leave out a hotel on the edge
+	angle := float64(radius) / earthRadius * 1.0001
+	dLat := angle * 180 / math.Pi
+	if p.Lat()+dLat >= 90 || p.Lat()-dLat <= -90 {
+		return nil, false
+	}
+	dLon := math.Asin(math.Sin(angle)/math.Cos(p.Lat()*math.Pi/180)) * 180 / math.Pi
+	if p.Lon()+dLon > 180 || p.Lon()-dLon < -180 {
+		return nil, false
+	}
+
+	topLeft := &geoindex.GeoPoint{Plat: p.Lat() + dLat, Plon: p.Lon() - dLon}
+	bottomRight := &geoindex.GeoPoint{Plat: p.Lat() - dLat, Plon: p.Lon() + dLon}
+	points := idx.points.Range(topLeft, bottomRight)
+	res := make([]scored, 0, len(points))
+	for _, found := range points {
+		h := idx.hotels[found.Id()]
+		res = append(res, scored{h, disScore(p, h)})
+	}
+	sortScored(res)
+	return res, true
+}
+
+// point returns the location of a hotel.
+func point(h Hotel) geoindex.Point {
+	return &geoindex.GeoPoint{Pid: h.HId, Plat: h.HLat, Plon: h.HLon}
+}
+
+// rank scores every hotel in a single pass and returns the k best.
+func (idx *hotelIndex) rank(score func(Hotel) float64, k int) []scored {
+	res := make([]scored, 0, len(idx.hotels))
+	for _, h := range idx.hotels {
+		res = append(res, scored{h, score(h)})
+	}
+	sortScored(res)
+	return trimTies(res, k)
+}
+
+// rankScores returns the k best of the hotels with a positive score in
+// scores, leaving out the others.
+func (idx *hotelIndex) rankScores(scores map[string]float64, k int) []scored {
+	res := make([]scored, 0, len(scores))
+	for id, score := range scores {
+		if h, ok := idx.hotels[id]; ok && score > 0 {
+			res = append(res, scored{h, score})
+		}
+	}
+	sortScored(res)
+	return trimTies(res, k)
+}
+
+// sortScored orders a ranking best first, ties by id.
+func sortScored(res []scored) {
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].score > res[j].score || (res[i].score == res[j].score && res[i].hotel.HId < res[j].hotel.HId)
+	})
+}
+
+// trimTies cuts a ranking to its k best entries, or to the entries tied for
+// the best score when k is not positive.
+func trimTies(res []scored, k int) []scored {
+	if k > 0 {
+		if k < len(res) {
+			res = res[:k]
+		}
+		return res
+	}
+	for i := range res {
+		if res[i].score != res[0].score {
+			return res[:i]
+		}
+	}
+	return res
+}
@@ -0,0 +1,95 @@
+package recommendation
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hailocab/go-geoindex"
+)
+
+// TestNearest compares the index lookups with ranking every hotel.
+func TestNearest(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	hotels := make(map[string]Hotel)
+	for i := 0; i < 300; i++ {
+		id := strconv.Itoa(i)
+		// most around San Francisco, some far out
+		lat, lon := 37.7+r.Float64()*0.5, -122.5+r.Float64()*0.5
+		if i%10 == 0 {
+			lat, lon = r.Float64()*170-85, r.Float64()*360-180
+		}
+		hotels[id] = Hotel{HId: id, HLat: lat, HLon: lon}
+	}
+	// two hotels tied for the closest to the last point below
+	hotels["tied-1"] = Hotel{HId: "tied-1", HLat: 10.01, HLon: 20}
+	hotels["tied-2"] = Hotel{HId: "tied-2", HLat: 9.99, HLon: 20}
+	idx := newHotelIndex(hotels)
+
+	points := []*geoindex.GeoPoint{
+		{Plat: 37.8, Plon: -122.4},
+		{Plat: 37.75, Plon: -122.25},
+		{Plat: 38.5, Plon: -121},
+		{Plat: 89.5, Plon: 0},
+		{Plat: 0, Plon: 179.9},
+		{Plat: 10, Plon: 20},
+	}
+	for i := 0; i < 20; i++ {
+		points = append(points, &geoindex.GeoPoint{Plat: 37.6 + r.Float64()*0.7, Plon: -122.6 + r.Float64()*0.7})
+	}
+	for _, p := range points {
+		for _, k := range []int{0, 1, 5, 30, 400} {
+			want := idx.rank(func(h Hotel) float64 { return disScore(p, h) }, k)
+			if got := idx.nearest(p, k); !reflect.DeepEqual(got, want) {
+				t.Errorf("nearest(%v, %v, %d) = %v, want %v", p.Plat, p.Plon, k, ids(got), ids(want))
+			}
+		}
+	}
+}
+
+// TestNearestCorner has a hotel just outside the cells next to the point but
+// closer than the ones inside them.
+func TestNearestCorner(t *testing.T) {
+	idx := newHotelIndex(map[string]Hotel{
+		"far":   {HId: "far", HLat: 37.8 + 0.12, HLon: -122.4 + 0.16},
+		"close": {HId: "close", HLat: 37.8, HLon: -122.4 + 0.13},
+	})
+	p := &geoindex.GeoPoint{Plat: 37.8, Plon: -122.4}
+	if got := ids(idx.nearest(p, 1)); !reflect.DeepEqual(got, []string{"close"}) {
+		t.Errorf("nearest = %v, want close", got)
+	}
+}
+
+func ids(res []scored) []string {
+	var ids []string
+	for _, r := range res {
+		ids = append(ids, r.hotel.HId)
+	}
+	return ids
+}
+
+func TestUserAffinity(t *testing.T) {
+	idx := newHotelIndex(map[string]Hotel{
+		"1": {HId: "1", HRate: 5, HPrice: 300},
+		"2": {HId: "2", HRate: 1, HPrice: 100},
+		"3": {HId: "3", HRate: 4.5, HPrice: 250},
+	})
+	cf := newCFModel(map[string][]string{"Cornell_1": {"1"}, "Cornell_2": {"gone"}})
+
+	affinity := userAffinity(idx, cf, "Cornell_1")
+	if affinity == nil {
+		t.Fatal("no affinity for a user with bookings")
+	}
+	if a, b, c := affinity(idx.hotels["1"]), affinity(idx.hotels["2"]), affinity(idx.hotels["3"]); !(a == 1 && a > c && c > b) {
+		t.Errorf("affinities of the booked, a similar and a different hotel = %v, %v, %v", a, c, b)
+	}
+	for _, username := range []string{"", "Cornell_2", "Cornell_3"} {
+		if userAffinity(idx, cf, username) != nil {
+			t.Errorf("affinity for %q, who booked no known hotel", username)
+		}
+	}
+	if userAffinity(idx, nil, "Cornell_1") != nil {
+		t.Error("affinity without a model")
+	}
+}
@@ -0,0 +1,38 @@
+package recommendation
+
+import (
+	"math"
+)
+
+// personalWeight is the share of a personalized score that comes from the
+// user's booking history.
+const personalWeight = 0.3
+
+// userAffinity returns how close a hotel is to the rate and price level of the
+// hotels the user booked before, or nil when there is no history to go on.
+// The history is the one of the collaborative filtering model, so bookings
+// count once the model is reloaded.
+func userAffinity(idx *hotelIndex, cf *cfModel, username string) func(Hotel) float64 {
+	if username == "" || cf == nil {
+		return nil
+	}
+
+	var rate, price float64
+	n := 0
+	for _, id := range cf.booked[username] {
+		if h, ok := idx.hotels[id]; ok {
+			rate += idx.rateScore(h)
+			price += idx.priceScore(h)
+			n++
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	rate /= float64(n)
+	price /= float64(n)
+
+	return func(h Hotel) float64 {
+		return 1 - (math.Abs(idx.rateScore(h)-rate)+math.Abs(idx.priceScore(h)-price))/2
+	}
+}
@@ -0,0 +1,69 @@
+package recommendation
+
+import (
+	"context"
+
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/rs/zerolog/log"
+)
+
+// ReloadHotels reloads the hotels and the collaborative filtering model.
+func (s *Server) ReloadHotels(ctx context.Context, req *pb.ReloadHotelsRequest) (*pb.ReloadHotelsResult, context.Context, error) {
+	n, err := s.reload()
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to reload hotels: %v", err)
+		return nil, ctx, err
+	}
+	return &pb.ReloadHotelsResult{Count: int32(n)}, ctx, nil
+}
+
+// reload reloads the hotels and, when reservations are available, rebuilds
+// the collaborative filtering model.
+func (s *Server) reload() (int, error) {
+	n, err := s.reloadHotels()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.reloadModel(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// reloadModel rebuilds the collaborative filtering model, when reservations
+// are available.
+func (s *Server) reloadModel() error {
+	if s.loadModel == nil {
+		return nil
+	}
+	model, err := s.loadModel()
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.cf = model
+	s.mu.Unlock()
+
+	log.Debug().Msgf("Rebuilt the collaborative filtering model of %d guests", len(model.booked))
+	return nil
+}
+
+// reloadHotels swaps in a fresh index of the hotels in mongodb.
+func (s *Server) reloadHotels() (int, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	hotels, err := s.loadHotels()
+	if err != nil {
+		return 0, err
+	}
+	idx := newHotelIndex(hotels)
+
+	s.mu.Lock()
+	s.index = idx
+	s.mu.Unlock()
+
+	log.Debug().Msgf("Reloaded %d hotels", len(hotels))
+	return len(hotels), nil
+}
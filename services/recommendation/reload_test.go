@@ -0,0 +1,62 @@
+package recommendation
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+)
+
+// TestReloadWhileRecommending runs lookups while the hotels and the model are
+// reloaded, for go test -race.
+func TestReloadWhileRecommending(t *testing.T) {
+	var reloads int
+	s := &Server{
+		loadHotels: func() (map[string]Hotel, error) {
+			reloads++
+			hotels := make(map[string]Hotel)
+			for i := 0; i < 50; i++ {
+				id := strconv.Itoa(i + 1)
+				hotels[id] = Hotel{HId: id, HLat: 37.7 + float64(i)/100, HLon: -122.4, HRate: float64(i%5 + reloads%3), HPrice: float64(100 + i*reloads%7)}
+			}
+			return hotels, nil
+		},
+		loadModel: func() (*cfModel, error) {
+			return newCFModel(map[string][]string{
+				"Cornell_1": {"1", "2"},
+				"Cornell_2": {"2", "3", strconv.Itoa(reloads%50 + 1)},
+			}), nil
+		},
+	}
+	if _, err := s.reload(); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for _, require := range []string{"dis", "rate", "price", "mixed", "similar"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				req := &pb.GetRecommendationsRequest{Require: require, Lat: 37.8, Lon: -122.4, K: 5, Username: "Cornell_1"}
+				res, _, err := s.GetRecommendations(context.Background(), req)
+				if err != nil {
+					t.Errorf("GetRecommendations(%v): %v", require, err)
+					return
+				}
+				if require != "similar" && len(res.HotelIds) < 5 {
+					t.Errorf("GetRecommendations(%v) returned %d hotels, want at least 5", require, len(res.HotelIds))
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 50; i++ {
+		if _, err := s.reload(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wg.Wait()
+}
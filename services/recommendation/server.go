@@ -3,50 +3,100 @@ package recommendation
 import (
 	// "encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
+	"sync"
 
 	"context"
 
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/grpcpb"
+	"github.com/appnetorg/hotel-reservation-arpc/reload"
+	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
 	"github.com/google/uuid"
 	"github.com/hailocab/go-geoindex"
 	"github.com/opentracing/opentracing-go"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
-
-	"math"
-
-	"github.com/appnet-org/arpc/pkg/rpc"
-	"github.com/appnet-org/arpc/pkg/serializer"
 )
 
 const _ = "srv-recommendation"
 
 // Server implements the recommendation service
 type Server struct {
-	hotels       map[string]Hotel
+	index   *hotelIndex
+	cf      *cfModel
+	mu      sync.RWMutex
+	writeMu sync.Mutex // orders index updates so a reload cannot drop a SetHotel
+
 	Tracer       opentracing.Tracer
 	Port         int
 	IpAddr       string
 	MongoSession *mgo.Session
-	uuid         string
+	AuthKey      []byte            // verifies callers of methods that need a role
+	Transport    *transport.Config // picks aRPC or gRPC per hop
+	// ReservationSession reads past reservations to build the collaborative
+	// filtering model, which also personalizes rankings; both are disabled
+	// when it is nil.
+	ReservationSession *mgo.Session
+	uuid               string
+
+	// loadHotels and loadModel read the hotels and build the collaborative
+	// filtering model, from MongoSession and ReservationSession unless set.
+	loadHotels func() (map[string]Hotel, error)
+	loadModel  func() (*cfModel, error)
 }
 
 // Run starts the server
 func (s *Server) Run() error {
+	opentracing.SetGlobalTracer(s.Tracer)
+
 	if s.Port == 0 {
 		return fmt.Errorf("server port must be set")
 	}
 
-	if s.hotels == nil {
-		s.hotels = loadRecommendations(s.MongoSession)
+	if s.loadHotels == nil {
+		s.loadHotels = func() (map[string]Hotel, error) { return loadRecommendations(s.MongoSession) }
+	}
+	if s.loadModel == nil && s.ReservationSession != nil {
+		s.loadModel = func() (*cfModel, error) { return loadCFModel(s.ReservationSession) }
+	}
+
+	if s.index == nil {
+		hotels, err := s.loadHotels()
+		if err != nil {
+			log.Error().Msgf("Failed get hotels data: %v", err)
+		}
+		s.index = newHotelIndex(hotels)
+	}
+
+	if s.loadModel != nil && s.cf == nil {
+		model, err := s.loadModel()
+		if err != nil {
+			log.Error().Msgf("Failed to build collaborative filtering model: %v", err)
+			model = newCFModel(nil)
+		}
+		s.cf = model
+	}
+
+	reload.Watch("hotels", reload.Interval(), func() error {
+		_, err := s.reloadHotels()
+		return err
+	})
+	if s.loadModel != nil {
+		// the model aggregates every reservation, so it is rebuilt less often
+		reload.Watch("the collaborative filtering model", reload.IntervalOf("MODEL_RELOAD_INTERVAL", defaultModelInterval), s.reloadModel)
 	}
 
 	s.uuid = uuid.New().String()
 
-	serializer := &serializer.SymphonySerializer{}
-	server, err := rpc.NewServer(s.IpAddr+":"+strconv.Itoa(s.Port), serializer, nil)
+	elements := slices.Concat(tracing.ServerElements(s.Tracer), logs.ServerElements(), auth.Elements(s.AuthKey))
+	server, err := s.Transport.NewServer("recommendation", s.IpAddr+":"+strconv.Itoa(s.Port), elements)
 
 	if err != nil {
 		log.Error().Msgf("Failed to start aRPC server: %v", err)
@@ -55,6 +105,19 @@ func (s *Server) Run() error {
 
 	pb.RegisterRecommendationServer(server, s)
 
+	if s.Transport.ServesGRPC("recommendation") {
+		err := transport.ServeGRPC(s.IpAddr+":"+strconv.Itoa(s.Port), elements, func(g grpc.ServiceRegistrar) {
+			grpcpb.RegisterRecommendation(g, s)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// finish the spans of calls failing in their handler
+	if err := transport.WrapHandlers(server, tracing.WrapHandler); err != nil {
+		return err
+	}
 	server.Start()
 
 	return nil
@@ -64,71 +127,128 @@ func (s *Server) Run() error {
 func (s *Server) Shutdown() {
 }
 
-// GiveRecommendation returns recommendations within a given requirement.
+// GetRecommendations returns the hotels ranking best for a given requirement.
 func (s *Server) GetRecommendations(ctx context.Context, req *pb.GetRecommendationsRequest) (*pb.GetRecommendationsResult, context.Context, error) {
 	res := new(pb.GetRecommendationsResult)
 	require := req.Require
+
+	s.mu.RLock()
+	idx := s.index
+	cf := s.cf
+	s.mu.RUnlock()
+
+	p := &geoindex.GeoPoint{
+		Pid:  "",
+		Plat: req.Lat,
+		Plon: req.Lon,
+	}
+
+	var score func(Hotel) float64
+	var scores map[string]float64 // of the hotels to recommend for similar
 	switch require {
 	case "dis":
-		p1 := &geoindex.GeoPoint{
-			Pid:  "",
-			Plat: req.Lat,
-			Plon: req.Lon,
-		}
-		min := math.MaxFloat64
-		for _, hotel := range s.hotels {
-			tmp := float64(geoindex.Distance(p1, &geoindex.GeoPoint{
-				Pid:  "",
-				Plat: hotel.HLat,
-				Plon: hotel.HLon,
-			})) / 1000
-			if tmp < min {
-				min = tmp
-			}
-		}
-		for _, hotel := range s.hotels {
-			tmp := float64(geoindex.Distance(p1, &geoindex.GeoPoint{
-				Pid:  "",
-				Plat: hotel.HLat,
-				Plon: hotel.HLon,
-			})) / 1000
-			if tmp == min {
-				res.HotelIds = append(res.HotelIds, hotel.HId)
-			}
-		}
+		score = func(h Hotel) float64 { return disScore(p, h) }
 	case "rate":
-		max := 0.0
-		for _, hotel := range s.hotels {
-			if hotel.HRate > max {
-				max = hotel.HRate
-			}
+		score = idx.rateScore
+	case "price":
+		score = idx.priceScore
+	case "mixed":
+		wDis, wRate, wPrice := req.DisWeight, req.RateWeight, req.PriceWeight
+		if wDis < 0 || wRate < 0 || wPrice < 0 {
+			return nil, ctx, fmt.Errorf("recommendation weights must not be negative")
 		}
-		for _, hotel := range s.hotels {
-			if hotel.HRate == max {
-				res.HotelIds = append(res.HotelIds, hotel.HId)
-			}
+		if wDis+wRate+wPrice == 0 {
+			wDis, wRate, wPrice = 1, 1, 1
 		}
-	case "price":
-		min := math.MaxFloat64
-		for _, hotel := range s.hotels {
-			if hotel.HPrice < min {
-				min = hotel.HPrice
-			}
+		sum := wDis + wRate + wPrice
+		score = func(h Hotel) float64 {
+			return (wDis*disScore(p, h) + wRate*idx.rateScore(h) + wPrice*idx.priceScore(h)) / sum
 		}
-		for _, hotel := range s.hotels {
-			if hotel.HPrice == min {
-				res.HotelIds = append(res.HotelIds, hotel.HId)
-			}
+	case "similar":
+		if cf == nil {
+			return nil, ctx, fmt.Errorf("collaborative filtering is not available")
 		}
+		scores = cf.scores(req.Username)
+		score = func(h Hotel) float64 { return scores[h.HId] }
 	default:
-		log.Warn().Msgf("Wrong require parameter: %v", require)
+		return nil, ctx, fmt.Errorf("unknown require parameter %q", require)
+	}
+
+	var ranked []scored
+	if require == "similar" {
+		// already built from the user's bookings
+		ranked = idx.rankScores(scores, int(req.K))
+	} else if affinity := userAffinity(idx, cf, req.Username); affinity != nil {
+		ranked = idx.rank(func(h Hotel) float64 {
+			return (1-personalWeight)*score(h) + personalWeight*affinity(h)
+		}, int(req.K))
+	} else {
+		switch require {
+		case "dis":
+			ranked = idx.nearest(p, int(req.K))
+		case "rate":
+			ranked = idx.topByRate(int(req.K))
+		case "price":
+			ranked = idx.topByPrice(int(req.K))
+		default:
+			ranked = idx.rank(score, int(req.K))
+		}
+	}
+
+	for _, r := range ranked {
+		res.HotelIds = append(res.HotelIds, r.hotel.HId)
+		res.Scores = append(res.Scores, r.score)
 	}
 
 	return res, ctx, nil
 }
 
+// SetHotel adds or replaces the data a hotel is recommended on.
+func (s *Server) SetHotel(ctx context.Context, req *pb.SetHotelRequest) (*pb.SetHotelResult, context.Context, error) {
+	if req.HotelId == "" {
+		return nil, ctx, fmt.Errorf("hotel id must be set")
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	session := s.MongoSession.Copy()
+	defer session.Close()
+	c := session.DB("recommendation-db").C("recommendation")
+
+	hotel := Hotel{
+		HId:    req.HotelId,
+		HLat:   req.Lat,
+		HLon:   req.Lon,
+		HRate:  req.Rate,
+		HPrice: req.Price,
+	}
+	info, err := c.Upsert(&bson.M{"hotelId": hotel.HId}, &bson.M{"$set": &bson.M{
+		"hotelId": hotel.HId,
+		"lat":     hotel.HLat,
+		"lon":     hotel.HLon,
+		"rate":    hotel.HRate,
+		"price":   hotel.HPrice,
+	}})
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to store recommendation data of hotel [%v]: %v", hotel.HId, err)
+		return nil, ctx, err
+	}
+
+	s.mu.Lock()
+	if old, ok := s.index.hotels[hotel.HId]; ok {
+		hotel.ID = old.ID
+	} else if id, ok := info.UpsertedId.(bson.ObjectId); ok {
+		hotel.ID = id
+	}
+	s.index = s.index.with(hotel)
+	s.mu.Unlock()
+
+	return &pb.SetHotelResult{HotelId: hotel.HId}, ctx, nil
+}
+
 // loadRecommendations loads hotel recommendations from mongodb.
-func loadRecommendations(session *mgo.Session) map[string]Hotel {
+func loadRecommendations(session *mgo.Session) (map[string]Hotel, error) {
 	// session, err := mgo.Dial("mongodb-recommendation")
 	// if err != nil {
 	// 	panic(err)
@@ -142,16 +262,13 @@ func loadRecommendations(session *mgo.Session) map[string]Hotel {
 	// unmarshal json profiles
 	var hotels []Hotel
 	err := c.Find(bson.M{}).All(&hotels)
-	if err != nil {
-		log.Error().Msgf("Failed get hotels data: %v", err)
-	}
 
 	profiles := make(map[string]Hotel)
 	for _, hotel := range hotels {
 		profiles[hotel.HId] = hotel
 	}
 
-	return profiles
+	return profiles, err
 }
 
 type Hotel struct {
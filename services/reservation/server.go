@@ -3,21 +3,25 @@ package reservation
 import (
 	// "encoding/json"
 	"fmt"
+	"slices"
 
 	"context"
 
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	"github.com/appnetorg/hotel-reservation-arpc/cache"
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/grpcpb"
+	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 
 	"time"
 
-	"github.com/appnet-org/arpc/pkg/rpc"
-	"github.com/appnet-org/arpc/pkg/serializer"
-
-	"github.com/bradfitz/gomemcache/memcache"
 	"github.com/rs/zerolog/log"
 
 	"strconv"
@@ -33,8 +37,12 @@ type Server struct {
 	Port         int
 	IpAddr       string
 	MongoSession *mgo.Session
-	MemcClient   *memcache.Client
-	uuid         string
+	AuthKey      []byte            // verifies callers of methods that need a role
+	Transport    *transport.Config // picks aRPC or gRPC per hop
+	Cache        cache.Cache       // caches reservation counts and capacities
+
+	counts cache.Store[int]
+	uuid   string
 }
 
 // Run starts the server
@@ -46,8 +54,9 @@ func (s *Server) Run() error {
 	}
 
 	s.uuid = uuid.New().String()
-	serializer := &serializer.SymphonySerializer{}
-	server, err := rpc.NewServer(s.IpAddr+":"+strconv.Itoa(s.Port), serializer, nil)
+	s.counts = cache.NewStore[int](s.Cache)
+	elements := slices.Concat(tracing.ServerElements(s.Tracer), logs.ServerElements(), auth.Elements(s.AuthKey))
+	server, err := s.Transport.NewServer("reservation", s.IpAddr+":"+strconv.Itoa(s.Port), elements)
 
 	if err != nil {
 		log.Error().Msgf("Failed to start aRPC server: %v", err)
@@ -56,6 +65,19 @@ func (s *Server) Run() error {
 
 	pb.RegisterReservationServer(server, s)
 
+	if s.Transport.ServesGRPC("reservation") {
+		err := transport.ServeGRPC(s.IpAddr+":"+strconv.Itoa(s.Port), elements, func(g grpc.ServiceRegistrar) {
+			grpcpb.RegisterReservation(g, s)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// finish the spans of calls failing in their handler
+	if err := transport.WrapHandlers(server, tracing.WrapHandler); err != nil {
+		return err
+	}
 	server.Start()
 
 	return nil
@@ -96,20 +118,18 @@ func (s *Server) MakeReservation(ctx context.Context, req *pb.ReservationRequest
 
 	for inDate.Before(outDate) {
 		// check reservations
-		count := 0
 		inDate = inDate.AddDate(0, 0, 1)
 		outdate := inDate.String()[0:10]
 
 		// first check memc
 		memc_key := hotelId + "_" + inDate.String()[0:10] + "_" + outdate
-		item, err := s.MemcClient.Get(memc_key)
+		count, err := s.counts.Get(memc_key)
 		switch err {
 		case nil:
 			// memcached hit
-			count, _ = strconv.Atoi(string(item.Value))
 			memc_date_num_map[memc_key] = count + int(req.RoomNumber)
 
-		case memcache.ErrCacheMiss:
+		case cache.ErrMiss:
 			// memcached miss
 			reserve := make([]reservation, 0)
 			err := c.Find(&bson.M{"hotelId": hotelId, "inDate": indate, "outDate": outdate}).All(&reserve)
@@ -124,19 +144,18 @@ func (s *Server) MakeReservation(ctx context.Context, req *pb.ReservationRequest
 			memc_date_num_map[memc_key] = count + int(req.RoomNumber)
 
 		default:
-			log.Panic().Msgf("Tried to get memc_key [%v], but got memmcached error = %s", memc_key, err)
+			log.Ctx(ctx).Error().Msgf("Tried to get memc_key [%v], but got cache error = %s", memc_key, err)
+			return nil, ctx, err
 		}
 
 		// check capacity
 		// check memc capacity
 		memc_cap_key := hotelId + "_cap"
-		item, err = s.MemcClient.Get(memc_cap_key)
-		hotel_cap := 0
+		hotel_cap, err := s.counts.Get(memc_cap_key)
 		switch err {
 		case nil:
 			// memcached hit
-			hotel_cap, _ = strconv.Atoi(string(item.Value))
-		case memcache.ErrCacheMiss:
+		case cache.ErrMiss:
 			// memcached miss
 			var num number
 			err = c1.Find(&bson.M{"hotelId": hotelId}).One(&num)
@@ -146,9 +165,10 @@ func (s *Server) MakeReservation(ctx context.Context, req *pb.ReservationRequest
 			hotel_cap = int(num.Number)
 
 			// write to memcache
-			s.MemcClient.Set(&memcache.Item{Key: memc_cap_key, Value: []byte(strconv.Itoa(hotel_cap))})
+			s.counts.Set(memc_cap_key, hotel_cap)
 		default:
-			log.Panic().Msgf("Tried to get memc_cap_key [%v], but got memmcached error = %s", memc_cap_key, err)
+			log.Ctx(ctx).Error().Msgf("Tried to get memc_cap_key [%v], but got cache error = %s", memc_cap_key, err)
+			return nil, ctx, err
 		}
 
 		if count+int(req.RoomNumber) > hotel_cap {
@@ -159,7 +179,7 @@ func (s *Server) MakeReservation(ctx context.Context, req *pb.ReservationRequest
 
 	// only update reservation number cache after check succeeds
 	for key, val := range memc_date_num_map {
-		s.MemcClient.Set(&memcache.Item{Key: key, Value: []byte(strconv.Itoa(val))})
+		s.counts.Set(key, val)
 	}
 
 	inDate, _ = time.Parse(
@@ -193,16 +213,6 @@ func (s *Server) CheckAvailability(ctx context.Context, req *pb.ReservationReque
 	res := new(pb.ReservationResult)
 	res.HotelId = make([]string, 0)
 
-	// session, err := mgo.Dial("mongodb-reservation")
-	// if err != nil {
-	// 	panic(err)
-	// }
-	// defer session.Close()
-	session := s.MongoSession.Copy()
-	defer session.Close()
-
-	c1 := session.DB("reservation-db").C("number")
-
 	hotelMemKeys := []string{}
 	keysMap := make(map[string]struct{})
 	resMap := make(map[string]bool)
@@ -214,42 +224,51 @@ func (s *Server) CheckAvailability(ctx context.Context, req *pb.ReservationReque
 	}
 	capMemSpan, _ := opentracing.StartSpanFromContext(ctx, "memcached_capacity_get_multi_number")
 	capMemSpan.SetTag("span.kind", "client")
-	cacheMemRes, err := s.MemcClient.GetMulti(hotelMemKeys)
+	cacheMemRes, err := s.counts.GetMulti(hotelMemKeys)
 	capMemSpan.Finish()
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Tried to get memc_cap_key [%v], but got cache error = %s", hotelMemKeys, err)
+		return nil, ctx, err
+	}
 	misKeys := []string{}
 	// gather cache miss key to query in mongodb
-	if err == memcache.ErrCacheMiss {
-		for key := range keysMap {
-			if _, ok := cacheMemRes[key]; !ok {
-				misKeys = append(misKeys, key)
-			}
+	for key := range keysMap {
+		if _, ok := cacheMemRes[key]; !ok {
+			misKeys = append(misKeys, key)
 		}
-	} else if err != nil {
-		log.Panic().Msgf("Tried to get memc_cap_key [%v], but got memmcached error = %s", hotelMemKeys, err)
 	}
 	// store whole capacity result in cacheCap
 	cacheCap := make(map[string]int)
-	for k, v := range cacheMemRes {
-		hotelCap, _ := strconv.Atoi(string(v.Value))
-		cacheCap[k] = hotelCap
+	for k, hotelCap := range cacheMemRes {
+		cacheCap[strings.TrimSuffix(k, "_cap")] = hotelCap
 	}
 	if len(misKeys) > 0 {
 		queryMissKeys := []string{}
 		for _, k := range misKeys {
 			queryMissKeys = append(queryMissKeys, strings.Split(k, "_")[0])
 		}
+		// session, err := mgo.Dial("mongodb-reservation")
+		// if err != nil {
+		// 	panic(err)
+		// }
+		// defer session.Close()
+		session := s.MongoSession.Copy()
+		defer session.Close()
+
+		c1 := session.DB("reservation-db").C("number")
 		nums := []number{}
 		capMongoSpan, _ := opentracing.StartSpanFromContext(ctx, "mongodb_capacity_get_multi_number")
 		capMongoSpan.SetTag("span.kind", "client")
 		err = c1.Find(bson.M{"hotelId": bson.M{"$in": queryMissKeys}}).All(&nums)
 		capMongoSpan.Finish()
 		if err != nil {
-			log.Panic().Msgf("Tried to find hotelId [%v], but got error: %s", misKeys, err.Error())
+			log.Ctx(ctx).Error().Msgf("Tried to find hotelId [%v], but got error: %s", misKeys, err.Error())
+			return nil, ctx, err
 		}
 		for _, num := range nums {
 			cacheCap[num.HotelId] = num.Number
 			// we don't care set successfully or not
-			go s.MemcClient.Set(&memcache.Item{Key: num.HotelId + "_cap", Value: []byte(strconv.Itoa(num.Number))})
+			go s.counts.Set(num.HotelId+"_cap", num.Number)
 		}
 	}
 
@@ -279,21 +298,34 @@ func (s *Server) CheckAvailability(ctx context.Context, req *pb.ReservationReque
 	type taskRes struct {
 		hotelId  string
 		checkRes bool
+		err      error
 	}
 	reserveMemSpan, _ := opentracing.StartSpanFromContext(ctx, "memcached_reserve_get_multi_number")
 	ch := make(chan taskRes)
 	reserveMemSpan.SetTag("span.kind", "client")
 	// check capacity in memcached and mongodb
-	if itemsMap, err := s.MemcClient.GetMulti(reqCommand); err != nil && err != memcache.ErrCacheMiss {
-		reserveMemSpan.Finish()
-		log.Panic().Msgf("Tried to get memc_key [%v], but got memmcached error = %s", reqCommand, err)
+	itemsMap, err := s.counts.GetMulti(reqCommand)
+	reserveMemSpan.Finish()
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Tried to get memc_key [%v], but got cache error = %s", reqCommand, err)
+		return nil, ctx, err
 	} else {
-		reserveMemSpan.Finish()
+		// use miss reservation to get data from mongo
+		// rever string to indata and outdate
+		for k := range itemsMap {
+			delete(queryMap, k)
+		}
+		var wg sync.WaitGroup
+		wg.Add(1 + len(queryMap))
+		go func() {
+			wg.Wait()
+			close(ch)
+		}()
 		// go through reservation count from memcached
 		go func() {
-			for k, v := range itemsMap {
+			defer wg.Done()
+			for k, val := range itemsMap {
 				id := strings.Split(k, "_")[0]
-				val, _ := strconv.Atoi(string(v.Value))
 				var res bool
 				if val+int(req.RoomNumber) <= cacheCap[id] {
 					res = true
@@ -303,62 +335,55 @@ func (s *Server) CheckAvailability(ctx context.Context, req *pb.ReservationReque
 					checkRes: res,
 				}
 			}
-			if err == nil {
-				close(ch)
-			}
 		}()
-		// use miss reservation to get data from mongo
-		// rever string to indata and outdate
-		if err == memcache.ErrCacheMiss {
-			var wg sync.WaitGroup
-			for k := range itemsMap {
-				delete(queryMap, k)
-			}
-			wg.Add(len(queryMap))
-			go func() {
-				wg.Wait()
-				close(ch)
-			}()
-			for command := range queryMap {
-				go func(comm string) {
-					defer wg.Done()
-					reserve := []reservation{}
-					tmpSess := s.MongoSession.Copy()
-					defer tmpSess.Close()
-					queryItem := queryMap[comm]
-					c := tmpSess.DB("reservation-db").C("reservation")
-					reserveMongoSpan, _ := opentracing.StartSpanFromContext(ctx, "mongodb_capacity_get_multi_number"+comm)
-					reserveMongoSpan.SetTag("span.kind", "client")
-					err := c.Find(&bson.M{"hotelId": queryItem["hotelId"], "inDate": queryItem["startDate"], "outDate": queryItem["endDate"]}).All(&reserve)
-					reserveMongoSpan.Finish()
-					if err != nil {
-						log.Panic().Msgf("Tried to find hotelId [%v] from date [%v] to date [%v], but got error: %s",
-							queryItem["hotelId"], queryItem["startDate"], queryItem["endDate"], err.Error())
-					}
-					var count int
-					for _, r := range reserve {
-						count += r.Number
-					}
-					// update memcached
-					go s.MemcClient.Set(&memcache.Item{Key: comm, Value: []byte(strconv.Itoa(count))})
-					var res bool
-					if count+int(req.RoomNumber) <= cacheCap[queryItem["hotelId"]] {
-						res = true
-					}
-					ch <- taskRes{
-						hotelId:  queryItem["hotelId"],
-						checkRes: res,
-					}
-				}(command)
-			}
+		for command := range queryMap {
+			go func(comm string) {
+				defer wg.Done()
+				reserve := []reservation{}
+				tmpSess := s.MongoSession.Copy()
+				defer tmpSess.Close()
+				queryItem := queryMap[comm]
+				c := tmpSess.DB("reservation-db").C("reservation")
+				reserveMongoSpan, _ := opentracing.StartSpanFromContext(ctx, "mongodb_capacity_get_multi_number"+comm)
+				reserveMongoSpan.SetTag("span.kind", "client")
+				err := c.Find(&bson.M{"hotelId": queryItem["hotelId"], "inDate": queryItem["startDate"], "outDate": queryItem["endDate"]}).All(&reserve)
+				reserveMongoSpan.Finish()
+				if err != nil {
+					log.Ctx(ctx).Error().Msgf("Tried to find hotelId [%v] from date [%v] to date [%v], but got error: %s",
+						queryItem["hotelId"], queryItem["startDate"], queryItem["endDate"], err.Error())
+					ch <- taskRes{hotelId: queryItem["hotelId"], err: err}
+					return
+				}
+				var count int
+				for _, r := range reserve {
+					count += r.Number
+				}
+				// update memcached
+				go s.counts.Set(comm, count)
+				var res bool
+				if count+int(req.RoomNumber) <= cacheCap[queryItem["hotelId"]] {
+					res = true
+				}
+				ch <- taskRes{
+					hotelId:  queryItem["hotelId"],
+					checkRes: res,
+				}
+			}(command)
 		}
 	}
 
+	// drain every task before failing, so no goroutine blocks on ch
 	for task := range ch {
+		if task.err != nil && err == nil {
+			err = task.err
+		}
 		if !task.checkRes {
 			resMap[task.hotelId] = false
 		}
 	}
+	if err != nil {
+		return nil, ctx, err
+	}
 	for k, v := range resMap {
 		if v {
 			res.HotelId = append(res.HotelId, k)
@@ -368,6 +393,34 @@ func (s *Server) CheckAvailability(ctx context.Context, req *pb.ReservationReque
 	return res, ctx, nil
 }
 
+// SetCapacity sets the number of rooms of a hotel
+func (s *Server) SetCapacity(ctx context.Context, req *pb.SetCapacityRequest) (*pb.SetCapacityResult, context.Context, error) {
+	if req.HotelId == "" {
+		return nil, ctx, fmt.Errorf("hotel id must be set")
+	}
+	if req.NumberOfRoom <= 0 {
+		return nil, ctx, fmt.Errorf("hotel [%v] must have at least one room", req.HotelId)
+	}
+
+	session := s.MongoSession.Copy()
+	defer session.Close()
+	c := session.DB("reservation-db").C("number")
+
+	num := number{HotelId: req.HotelId, Number: int(req.NumberOfRoom)}
+	if _, err := c.Upsert(&bson.M{"hotelId": num.HotelId}, &num); err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to store capacity of hotel [%v]: %v", num.HotelId, err)
+		return nil, ctx, err
+	}
+
+	// write through so reservations check against the new capacity
+	memc_cap_key := num.HotelId + "_cap"
+	if err := s.counts.Set(memc_cap_key, num.Number); err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to set memc_cap_key [%v]: %v", memc_cap_key, err)
+	}
+
+	return &pb.SetCapacityResult{HotelId: num.HotelId}, ctx, nil
+}
+
 type reservation struct {
 	HotelId      string `bson:"hotelId"`
 	CustomerName string `bson:"customerName"`
@@ -0,0 +1,41 @@
+package reservation
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/appnetorg/hotel-reservation-arpc/cache"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+)
+
+// TestCheckAvailabilityCachedCapacity checks that capacities cached under
+// their "<hotelId>_cap" keys are found by hotel id. They were looked up by
+// key, so every hotel with a cached capacity had none left.
+func TestCheckAvailabilityCachedCapacity(t *testing.T) {
+	s := &Server{counts: cache.NewStore[int](cache.NewLocal(1<<20, false))}
+	for key, v := range map[string]int{
+		"9_cap":                    2,
+		"10_cap":                   2,
+		"9_2015-04-20_2015-04-20":  1,
+		"10_2015-04-20_2015-04-20": 2,
+	} {
+		if err := s.counts.Set(key, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// every count is cached, so Mongo is not asked
+	res, _, err := s.CheckAvailability(context.Background(), &pb.ReservationRequest{
+		HotelId:    []string{"9", "10"},
+		InDate:     "2015-04-19",
+		OutDate:    "2015-04-20",
+		RoomNumber: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(res.HotelId, []string{"9"}) {
+		t.Errorf("available hotels %v, want [9]", res.HotelId)
+	}
+}
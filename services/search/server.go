@@ -3,15 +3,19 @@ package search
 import (
 	// "encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/rs/zerolog/log"
 
 	// "os"
-	"github.com/appnet-org/arpc/pkg/rpc"
-	"github.com/appnet-org/arpc/pkg/serializer"
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	hotel "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/grpcpb"
+	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
+	"google.golang.org/grpc"
 
 	"context"
 
@@ -30,6 +34,7 @@ type Server struct {
 	Port       int
 	IpAddr     string
 	KnativeDns string
+	Transport  *transport.Config // picks aRPC or gRPC per hop
 	uuid       string
 }
 
@@ -43,8 +48,8 @@ func (s *Server) Run() error {
 
 	s.uuid = uuid.New().String()
 
-	serializer := &serializer.SymphonySerializer{}
-	server, err := rpc.NewServer(s.IpAddr+":"+strconv.Itoa(s.Port), serializer, nil)
+	elements := slices.Concat(tracing.ServerElements(s.Tracer), logs.ServerElements())
+	server, err := s.Transport.NewServer("search", s.IpAddr+":"+strconv.Itoa(s.Port), elements)
 
 	if err != nil {
 		log.Error().Msgf("Failed to start aRPC server: %v", err)
@@ -53,7 +58,16 @@ func (s *Server) Run() error {
 
 	hotel.RegisterSearchServer(server, s)
 
-	// init arpc clients before starting the server
+	if s.Transport.ServesGRPC("search") {
+		err := transport.ServeGRPC(s.IpAddr+":"+strconv.Itoa(s.Port), elements, func(g grpc.ServiceRegistrar) {
+			grpcpb.RegisterSearch(g, s)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// init clients before starting the server
 	if err := s.initGeoClient("geo.default.svc.cluster.local:11003"); err != nil {
 		return err
 	}
@@ -61,6 +75,10 @@ func (s *Server) Run() error {
 		return err
 	}
 
+	// finish the spans of calls failing in their handler
+	if err := transport.WrapHandlers(server, tracing.WrapHandler); err != nil {
+		return err
+	}
 	server.Start()
 
 	return nil
@@ -71,33 +89,21 @@ func (s *Server) Shutdown() {
 }
 
 func (s *Server) initGeoClient(name string) error {
-	serializer := &serializer.SymphonySerializer{}
-
-	client, err := rpc.NewClientWithLocalAddr(serializer, name, "0.0.0.0:0", nil)
-	if err != nil {
-		return fmt.Errorf("failed to create geo aRPC client: %v", err)
-	}
-
-	s.geoClient = hotel.NewGeoClient(client)
-	return nil
+	var err error
+	s.geoClient, err = transport.Dial(s.Transport, "search", "geo", name, slices.Concat(tracing.ClientElements(s.Tracer), logs.ClientElements()), hotel.NewGeoClient, grpcpb.NewGeo)
+	return err
 }
 
 func (s *Server) initRateClient(name string) error {
-	serializer := &serializer.SymphonySerializer{}
-
-	client, err := rpc.NewClientWithLocalAddr(serializer, name, "0.0.0.0:0", nil)
-	if err != nil {
-		return fmt.Errorf("failed to create rate aRPC client: %v", err)
-	}
-
-	s.rateClient = hotel.NewRateClient(client)
-	return nil
+	var err error
+	s.rateClient, err = transport.Dial(s.Transport, "search", "rate", name, slices.Concat(tracing.ClientElements(s.Tracer), logs.ClientElements()), hotel.NewRateClient, grpcpb.NewRate)
+	return err
 }
 
 // Nearby returns ids of nearby hotels ordered by ranking algo
 func (s *Server) Nearby(ctx context.Context, req *hotel.SearchRequest) (*hotel.SearchResult, context.Context, error) {
 	if s.geoClient == nil {
-		log.Error().Msg("geo client not initialized")
+		log.Ctx(ctx).Error().Msg("geo client not initialized")
 		return nil, ctx, fmt.Errorf("geo client not initialized")
 	}
 
@@ -111,13 +117,13 @@ func (s *Server) Nearby(ctx context.Context, req *hotel.SearchRequest) (*hotel.S
 		Latstring: fmt.Sprintf("%f", req.Lat),
 	})
 	if err != nil {
-		log.Error().Msgf("geoClient.NearbyGeo failed: %v", err)
+		log.Ctx(ctx).Error().Msgf("geoClient.NearbyGeo failed: %v", err)
 		return nil, ctx, fmt.Errorf("geoClient.NearbyGeo failed: %w", err)
 	}
 
 	// find rates for hotels
 	if s.rateClient == nil {
-		log.Error().Msg("rate client not initialized")
+		log.Ctx(ctx).Error().Msg("rate client not initialized")
 		return nil, ctx, fmt.Errorf("rate client not initialized")
 	}
 
@@ -131,7 +137,7 @@ func (s *Server) Nearby(ctx context.Context, req *hotel.SearchRequest) (*hotel.S
 		OutDate:  req.OutDate,
 	})
 	if err != nil {
-		log.Error().Msgf("rateClient.GetRates failed: %v", err)
+		log.Ctx(ctx).Error().Msgf("rateClient.GetRates failed: %v", err)
 		return nil, ctx, fmt.Errorf("rateClient.GetRates failed: %w", err)
 	}
 
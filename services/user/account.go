@@ -0,0 +1,128 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// RegisterUser creates a user unless the username is taken.
+func (s *Server) RegisterUser(ctx context.Context, req *pb.RegisterUserRequest) (*pb.RegisterUserResult, context.Context, error) {
+	if req.Username == "" || req.Password == "" {
+		return nil, ctx, fmt.Errorf("username and password must be set")
+	}
+
+	hash, err := HashPassword(req.Password)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to hash password of user [%v]: %v", req.Username, err)
+		return nil, ctx, err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	user := User{Username: req.Username, Password: hash, PasswordChanged: time.Now().UnixNano()}
+	inserted, err := s.store.insert(user)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to register user [%v]: %v", req.Username, err)
+		return nil, ctx, err
+	}
+	if !inserted {
+		return &pb.RegisterUserResult{Registered: false}, ctx, nil
+	}
+
+	s.mu.Lock()
+	s.users[req.Username] = user
+	s.mu.Unlock()
+
+	return &pb.RegisterUserResult{Registered: true}, ctx, nil
+}
+
+// ChangePassword replaces the password of a user after checking the current one.
+func (s *Server) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResult, context.Context, error) {
+	if req.NewPassword == "" {
+		return nil, ctx, fmt.Errorf("new password must be set")
+	}
+
+	res := new(pb.ChangePasswordResult)
+	user, correct, retryAfter := s.authenticate(ctx, req.Username, req.Password)
+	if !correct {
+		res.RetryAfter = retryAfter
+		return res, ctx, nil
+	}
+
+	hash, err := HashPassword(req.NewPassword)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to hash password of user [%v]: %v", req.Username, err)
+		return nil, ctx, err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	// only replace the hash that was checked, so of two concurrent changes
+	// with the same current password only one succeeds
+	changed := time.Now().UnixNano()
+	err = s.store.update(bson.M{"username": req.Username, "password": user.Password}, bson.M{"password": hash, "passwordChanged": changed})
+	if err == mgo.ErrNotFound {
+		// changed or deleted in the meantime
+		return res, ctx, nil
+	} else if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to change password of user [%v]: %v", req.Username, err)
+		return nil, ctx, err
+	}
+
+	s.mu.Lock()
+	if current, ok := s.users[req.Username]; ok && current.Password == user.Password {
+		current.Password, current.PasswordChanged = hash, changed
+		s.users[req.Username] = current
+	}
+	s.mu.Unlock()
+
+	res.Changed = true
+	return res, ctx, nil
+}
+
+// DeleteUser removes a user after checking their password.
+func (s *Server) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResult, context.Context, error) {
+	res := new(pb.DeleteUserResult)
+	_, correct, retryAfter := s.authenticate(ctx, req.Username, req.Password)
+	if !correct {
+		res.RetryAfter = retryAfter
+		return res, ctx, nil
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	err := s.store.remove(req.Username)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to delete user [%v]: %v", req.Username, err)
+		return nil, ctx, err
+	}
+
+	s.mu.Lock()
+	delete(s.users, req.Username)
+	s.mu.Unlock()
+	s.lockout.forget(req.Username)
+
+	res.Deleted = true
+	return res, ctx, nil
+}
+
+// CheckSession returns whether a session token issued to a user is still
+// valid: the user exists and did not change the password since. Like the
+// passwords, changes made through other replicas are seen after they reload
+// the users.
+func (s *Server) CheckSession(ctx context.Context, req *pb.CheckSessionRequest) (*pb.CheckSessionResult, context.Context, error) {
+	s.mu.RLock()
+	user, found := s.users[req.Username]
+	s.mu.RUnlock()
+
+	return &pb.CheckSessionResult{Valid: found && user.PasswordChanged == req.PasswordChanged}, ctx, nil
+}
@@ -0,0 +1,229 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestCheckSession(t *testing.T) {
+	s := &Server{users: map[string]User{
+		"Cornell_1": {Username: "Cornell_1"},
+		"Cornell_2": {Username: "Cornell_2", PasswordChanged: 42},
+	}}
+	tests := []struct {
+		username        string
+		passwordChanged int64
+		valid           bool
+	}{
+		{"Cornell_1", 0, true},
+		{"Cornell_2", 42, true},
+		{"Cornell_2", 0, false},
+		{"Cornell_3", 0, false},
+	}
+	for _, tt := range tests {
+		res, _, err := s.CheckSession(context.Background(), &pb.CheckSessionRequest{Username: tt.username, PasswordChanged: tt.passwordChanged})
+		if err != nil || res.Valid != tt.valid {
+			t.Errorf("CheckSession(%v, %v) = %v, %v, want %v", tt.username, tt.passwordChanged, res.GetValid(), err, tt.valid)
+		}
+	}
+}
+
+// fakeStore keeps the users in memory.
+type fakeStore struct {
+	mu    sync.Mutex
+	users map[string]User
+}
+
+func (f *fakeStore) insert(user User) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.users[user.Username]; ok {
+		return false, nil
+	}
+	f.users[user.Username] = user
+	return true, nil
+}
+
+func (f *fakeStore) update(query, set bson.M) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	user, ok := f.users[query["username"].(string)]
+	if password, match := query["password"]; !ok || match && user.Password != password {
+		return mgo.ErrNotFound
+	}
+	if password, ok := set["password"]; ok {
+		user.Password = password.(string)
+	}
+	if changed, ok := set["passwordChanged"]; ok {
+		user.PasswordChanged = changed.(int64)
+	}
+	f.users[user.Username] = user
+	return nil
+}
+
+func (f *fakeStore) remove(username string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.users, username)
+	return nil
+}
+
+func (f *fakeStore) all() ([]User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var users []User
+	for _, user := range f.users {
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// newAccountServer returns a server with the given users both loaded and
+// stored.
+func newAccountServer(t *testing.T, passwords map[string]string) (*Server, *fakeStore) {
+	st := &fakeStore{users: make(map[string]User)}
+	for username, password := range passwords {
+		hash, err := HashPassword(password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		st.users[username] = User{Username: username, Password: hash}
+	}
+	users, err := loadUsers(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Server{users: users, store: st}, st
+}
+
+func TestRegisterUser(t *testing.T) {
+	s, st := newAccountServer(t, map[string]string{"Cornell_1": "1111111111"})
+	ctx := context.Background()
+
+	res, _, err := s.RegisterUser(ctx, &pb.RegisterUserRequest{Username: "Cornell_1", Password: "2222222222"})
+	if err != nil || res.Registered {
+		t.Errorf("registering a taken name = %v, %v, want not registered", res.GetRegistered(), err)
+	}
+	if correct, _ := checkPassword(st.users["Cornell_1"].Password, "1111111111"); !correct {
+		t.Error("registering a taken name replaced the password")
+	}
+
+	// of concurrent registrations of one name, only one succeeds
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	registered := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, _, err := s.RegisterUser(ctx, &pb.RegisterUserRequest{Username: "Cornell_2", Password: fmt.Sprint(i)})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if res.Registered {
+				mu.Lock()
+				registered++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if registered != 1 {
+		t.Errorf("%d of 10 concurrent registrations succeeded, want 1", registered)
+	}
+	if !reflect.DeepEqual(s.users["Cornell_2"], st.users["Cornell_2"]) {
+		t.Errorf("loaded user %v differs from the stored %v", s.users["Cornell_2"], st.users["Cornell_2"])
+	}
+
+	if _, _, err := s.RegisterUser(ctx, &pb.RegisterUserRequest{Username: "Cornell_3"}); err == nil {
+		t.Error("registered a user without password")
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	s, st := newAccountServer(t, map[string]string{"Cornell_1": "1111111111"})
+	ctx := context.Background()
+
+	res, _, err := s.ChangePassword(ctx, &pb.ChangePasswordRequest{Username: "Cornell_1", Password: "wrong", NewPassword: "2222222222"})
+	if err != nil || res.Changed {
+		t.Errorf("changing with a wrong password = %v, %v, want not changed", res.GetChanged(), err)
+	}
+
+	res, _, err = s.ChangePassword(ctx, &pb.ChangePasswordRequest{Username: "Cornell_1", Password: "1111111111", NewPassword: "2222222222"})
+	if err != nil || !res.Changed {
+		t.Fatalf("changing the password = %v, %v, want changed", res.GetChanged(), err)
+	}
+	if user := st.users["Cornell_1"]; !reflect.DeepEqual(user, s.users["Cornell_1"]) || user.PasswordChanged == 0 {
+		t.Errorf("stored %v and loaded %v, want the same with a change time", user, s.users["Cornell_1"])
+	}
+	if _, correct, _ := s.authenticate(ctx, "Cornell_1", "1111111111"); correct {
+		t.Error("old password still accepted")
+	}
+	if _, correct, _ := s.authenticate(ctx, "Cornell_1", "2222222222"); !correct {
+		t.Error("new password rejected")
+	}
+}
+
+func TestChangePasswordChangedMeanwhile(t *testing.T) {
+	s, st := newAccountServer(t, map[string]string{"Cornell_1": "1111111111"})
+	ctx := context.Background()
+
+	// changed through another replica, this one has not reloaded yet
+	hash, err := HashPassword("3333333333")
+	if err != nil {
+		t.Fatal(err)
+	}
+	st.users["Cornell_1"] = User{Username: "Cornell_1", Password: hash, PasswordChanged: 1}
+
+	res, _, err := s.ChangePassword(ctx, &pb.ChangePasswordRequest{Username: "Cornell_1", Password: "1111111111", NewPassword: "2222222222"})
+	if err != nil || res.Changed {
+		t.Errorf("changing a password changed meanwhile = %v, %v, want not changed", res.GetChanged(), err)
+	}
+	if st.users["Cornell_1"].Password != hash {
+		t.Error("overwrote the password changed meanwhile")
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	s, st := newAccountServer(t, map[string]string{"Cornell_1": "1111111111", "Cornell_2": "2222222222"})
+	ctx := context.Background()
+
+	res, _, err := s.DeleteUser(ctx, &pb.DeleteUserRequest{Username: "Cornell_1", Password: "wrong"})
+	if err != nil || res.Deleted {
+		t.Errorf("deleting with a wrong password = %v, %v, want not deleted", res.GetDeleted(), err)
+	}
+
+	res, _, err = s.DeleteUser(ctx, &pb.DeleteUserRequest{Username: "Cornell_1", Password: "1111111111"})
+	if err != nil || !res.Deleted {
+		t.Fatalf("deleting = %v, %v, want deleted", res.GetDeleted(), err)
+	}
+	if _, ok := st.users["Cornell_1"]; ok {
+		t.Error("user still stored")
+	}
+	if _, correct, _ := s.authenticate(ctx, "Cornell_1", "1111111111"); correct {
+		t.Error("deleted user still logs in")
+	}
+
+	res, _, err = s.DeleteUser(ctx, &pb.DeleteUserRequest{Username: "Cornell_1", Password: "1111111111"})
+	if err != nil || res.Deleted {
+		t.Errorf("deleting a missing user = %v, %v, want not deleted", res.GetDeleted(), err)
+	}
+
+	// deleted through another replica, this one has not reloaded yet
+	delete(st.users, "Cornell_2")
+	res, _, err = s.DeleteUser(ctx, &pb.DeleteUserRequest{Username: "Cornell_2", Password: "2222222222"})
+	if err != nil || !res.Deleted {
+		t.Errorf("deleting a user deleted elsewhere = %v, %v, want deleted", res.GetDeleted(), err)
+	}
+	if _, ok := s.users["Cornell_2"]; ok {
+		t.Error("user still loaded")
+	}
+}
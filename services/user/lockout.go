@@ -0,0 +1,111 @@
+package user
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// failures in a row a user gets from a client before being locked out
+	maxFailures = 5
+	// the first lockout lasts minLockout, each further failure doubles it
+	minLockout = time.Second
+	maxLockout = 15 * time.Minute
+	// failures are forgotten after maxLockout without any, once this many
+	// users and clients are tracked
+	maxTracked = 100000
+)
+
+// lockout tracks consecutive failed password checks per user and client, so
+// guessing the password of a user from one address does not lock the user
+// out everywhere else. Each replica counts the checks it makes, so a client
+// spreading its guesses gets up to maxFailures per replica.
+type lockout struct {
+	mu       sync.Mutex
+	failures map[attempt]*failures
+}
+
+// attempt is who checks the password of a user, identified by the address
+// the frontend saw. Callers without one share the empty client.
+type attempt struct {
+	username, client string
+}
+
+type failures struct {
+	count int
+	last  time.Time
+	until time.Time
+}
+
+// retryAfter returns how long the user is still locked out for the client.
+func (l *lockout) retryAfter(a attempt) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if f, ok := l.failures[a]; ok {
+		if d := time.Until(f.until); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// fail records a failed check and locks the user out for the client once it
+// failed too often.
+func (l *lockout) fail(a attempt) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.failures == nil {
+		l.failures = make(map[attempt]*failures)
+	}
+	f, ok := l.failures[a]
+	if !ok {
+		if len(l.failures) >= maxTracked {
+			l.prune()
+		}
+		f = &failures{}
+		l.failures[a] = f
+	}
+	f.count++
+	f.last = time.Now()
+	if f.count >= maxFailures {
+		d := time.Duration(float64(minLockout) * math.Pow(2, float64(f.count-maxFailures)))
+		if d > maxLockout || d <= 0 {
+			d = maxLockout
+		}
+		f.until = f.last.Add(d)
+	}
+}
+
+// prune forgets the failures that stopped maxLockout ago, whose lockouts are
+// over.
+func (l *lockout) prune() {
+	for a, f := range l.failures {
+		if time.Since(f.last) > maxLockout {
+			delete(l.failures, a)
+		}
+	}
+}
+
+// reset forgets the failures of a user from a client.
+func (l *lockout) reset(a attempt) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.failures, a)
+}
+
+// forget forgets the failures of a user from every client.
+func (l *lockout) forget(username string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for a := range l.failures {
+		if a.username == username {
+			delete(l.failures, a)
+		}
+	}
+}
+
+// seconds rounds a lockout up to whole seconds.
+func seconds(d time.Duration) int32 {
+	return int32(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,136 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+)
+
+func TestLockout(t *testing.T) {
+	var l lockout
+	a := attempt{username: "Cornell_1", client: "10.0.0.1"}
+	for i := 1; i < maxFailures; i++ {
+		l.fail(a)
+		if d := l.retryAfter(a); d != 0 {
+			t.Fatalf("locked out for %v after %d failures", d, i)
+		}
+	}
+
+	// each further failure doubles the lockout
+	for _, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second} {
+		l.fail(a)
+		if d := l.retryAfter(a); d > want || d < want-time.Second/2 {
+			t.Errorf("locked out for %v, want %v", d, want)
+		}
+	}
+	if d := l.retryAfter(attempt{username: "Cornell_2", client: "10.0.0.1"}); d != 0 {
+		t.Errorf("other user locked out for %v", d)
+	}
+	if d := l.retryAfter(attempt{username: "Cornell_1", client: "10.0.0.2"}); d != 0 {
+		t.Errorf("user locked out for %v on another client", d)
+	}
+
+	// up to maxLockout, also once the doubling overflows
+	for i := 0; i < 100; i++ {
+		l.fail(a)
+		if d := l.retryAfter(a); d > maxLockout {
+			t.Fatalf("locked out for %v after %d more failures, over %v", d, i+1, maxLockout)
+		}
+	}
+	if d := l.retryAfter(a); d < maxLockout-time.Second {
+		t.Errorf("locked out for %v, want %v", d, maxLockout)
+	}
+
+	l.reset(a)
+	if d := l.retryAfter(a); d != 0 {
+		t.Errorf("locked out for %v after reset", d)
+	}
+	l.fail(a)
+	if d := l.retryAfter(a); d != 0 {
+		t.Errorf("reset did not forget the failures, locked out for %v", d)
+	}
+}
+
+func TestLockoutForget(t *testing.T) {
+	var l lockout
+	clients := []string{"10.0.0.1", "10.0.0.2"}
+	for _, c := range clients {
+		for i := 0; i < maxFailures; i++ {
+			l.fail(attempt{username: "Cornell_1", client: c})
+		}
+	}
+	other := attempt{username: "Cornell_2", client: "10.0.0.1"}
+	for i := 0; i < maxFailures; i++ {
+		l.fail(other)
+	}
+
+	l.forget("Cornell_1")
+	for _, c := range clients {
+		if d := l.retryAfter(attempt{username: "Cornell_1", client: c}); d != 0 {
+			t.Errorf("forgotten user locked out for %v on %v", d, c)
+		}
+	}
+	if d := l.retryAfter(other); d == 0 {
+		t.Error("forgetting a user unlocked another one")
+	}
+}
+
+func TestLockoutPrune(t *testing.T) {
+	l := lockout{failures: map[attempt]*failures{
+		{username: "Cornell_1"}: {count: maxFailures, last: time.Now().Add(-2 * maxLockout), until: time.Now().Add(-maxLockout)},
+		{username: "Cornell_2"}: {count: 1, last: time.Now()},
+	}}
+	l.prune()
+	if _, ok := l.failures[attempt{username: "Cornell_1"}]; ok {
+		t.Error("kept failures that stopped long ago")
+	}
+	if _, ok := l.failures[attempt{username: "Cornell_2"}]; !ok {
+		t.Error("pruned recent failures")
+	}
+}
+
+// from returns the context of a call the frontend made for a client.
+func from(client string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{auth.ClientKey: client}))
+}
+
+func TestAuthenticateLockout(t *testing.T) {
+	s, _ := newAccountServer(t, map[string]string{"Cornell_1": "1111111111"})
+	ctx := from("10.0.0.1")
+
+	for i := 1; i < maxFailures; i++ {
+		s.authenticate(ctx, "Cornell_1", "wrong")
+	}
+	// a correct password resets the count
+	if _, correct, _ := s.authenticate(ctx, "Cornell_1", "1111111111"); !correct {
+		t.Fatal("correct password rejected")
+	}
+	for i := 1; i < maxFailures; i++ {
+		s.authenticate(ctx, "Cornell_1", "wrong")
+	}
+	if _, _, retryAfter := s.authenticate(ctx, "Cornell_1", "wrong"); retryAfter != 1 {
+		t.Errorf("retry after %v seconds, want 1", retryAfter)
+	}
+	// while locked out even the correct password is rejected
+	if _, correct, retryAfter := s.authenticate(ctx, "Cornell_1", "1111111111"); correct || retryAfter != 1 {
+		t.Errorf("locked out user got %v, retry after %v", correct, retryAfter)
+	}
+}
+
+func TestAuthenticateLockoutPerClient(t *testing.T) {
+	s, _ := newAccountServer(t, map[string]string{"Cornell_1": "1111111111"})
+
+	for i := 0; i < maxFailures; i++ {
+		s.authenticate(from("10.0.0.66"), "Cornell_1", "wrong")
+	}
+	if _, correct, _ := s.authenticate(from("10.0.0.66"), "Cornell_1", "1111111111"); correct {
+		t.Error("guessing client not locked out")
+	}
+	// the user still logs in from elsewhere
+	if _, correct, retryAfter := s.authenticate(from("10.0.0.1"), "Cornell_1", "1111111111"); !correct {
+		t.Errorf("user locked out on another client, retry after %v", retryAfter)
+	}
+}
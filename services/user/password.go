@@ -0,0 +1,60 @@
+package user
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcryptCost is the work factor of new password hashes, see SetBcryptCost.
+var bcryptCost = bcrypt.DefaultCost
+
+// dummyHash is compared against when a user does not exist, so unknown and
+// known usernames take as long to check.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("dummy"), bcryptCost)
+
+// SetBcryptCost sets the work factor of the password hashes made after. Every
+// CheckUser compares a hash, so the cost bounds the logins a user replica
+// serves: each step up doubles the time of a compare. Benchmarks lower it
+// so they do not measure bcrypt alone.
+func SetBcryptCost(cost int) error {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return fmt.Errorf("bcrypt cost %d is not between %d and %d", cost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte("dummy"), cost)
+	if err != nil {
+		return err
+	}
+	bcryptCost, dummyHash = cost, hash
+	return nil
+}
+
+// HashPassword returns the salted bcrypt hash stored for a password.
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// checkPassword returns whether password matches the stored hash, and whether
+// the hash is a legacy unsalted SHA-256 digest that should be upgraded.
+func checkPassword(hash, password string) (correct, legacy bool) {
+	if !isLegacyHash(hash) {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil, false
+	}
+
+	sum := sha256.Sum256([]byte(password))
+	digest := fmt.Sprintf("%x", sum)
+	return subtle.ConstantTimeCompare([]byte(digest), []byte(hash)) == 1, true
+}
+
+// isLegacyHash returns whether hash is not a bcrypt hash, which all start
+// with $2.
+func isLegacyHash(hash string) bool {
+	return !strings.HasPrefix(hash, "$2")
+}
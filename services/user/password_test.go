@@ -0,0 +1,99 @@
+package user
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestMain(m *testing.M) {
+	// the tests hash many passwords
+	SetBcryptCost(bcrypt.MinCost)
+	os.Exit(m.Run())
+}
+
+func TestCheckPassword(t *testing.T) {
+	hash, err := HashPassword("1111111111")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// how the seed data stored passwords before bcrypt
+	legacy := fmt.Sprintf("%x", sha256.Sum256([]byte("1111111111")))
+
+	tests := []struct {
+		name            string
+		hash, password  string
+		correct, legacy bool
+	}{
+		{"bcrypt", hash, "1111111111", true, false},
+		{"bcrypt wrong", hash, "111111111", false, false},
+		{"legacy", legacy, "1111111111", true, true},
+		{"legacy wrong", legacy, "111111111", false, true},
+		{"legacy prefix", legacy[:32], "1111111111", false, true},
+		{"legacy upper case", fmt.Sprintf("%X", sha256.Sum256([]byte("1111111111"))), "1111111111", false, true},
+		{"legacy digest as password", legacy, legacy, false, true},
+	}
+	for _, tt := range tests {
+		correct, legacy := checkPassword(tt.hash, tt.password)
+		if correct != tt.correct || legacy != tt.legacy {
+			t.Errorf("%v: checkPassword = %v, %v, want %v, %v", tt.name, correct, legacy, tt.correct, tt.legacy)
+		}
+	}
+}
+
+func TestIsLegacyHash(t *testing.T) {
+	tests := map[string]bool{
+		"$2a$04$9pJQ0cQyhA0Yc3wK6tSsReqWgtcG1xkYtmC5bDOUu7WF3u8bWvMbC": false,
+		"$2b$10$9pJQ0cQyhA0Yc3wK6tSsReqWgtcG1xkYtmC5bDOUu7WF3u8bWvMbC": false,
+		fmt.Sprintf("%x", sha256.Sum256([]byte("x"))):                  true,
+		"": true,
+	}
+	for hash, want := range tests {
+		if got := isLegacyHash(hash); got != want {
+			t.Errorf("isLegacyHash(%q) = %v, want %v", hash, got, want)
+		}
+	}
+}
+
+func TestSetBcryptCost(t *testing.T) {
+	t.Cleanup(func() { SetBcryptCost(bcrypt.MinCost) })
+	for _, cost := range []int{bcrypt.MinCost - 1, bcrypt.MaxCost + 1} {
+		if err := SetBcryptCost(cost); err == nil {
+			t.Errorf("SetBcryptCost(%d) succeeded", cost)
+		}
+	}
+	if err := SetBcryptCost(6); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := HashPassword("1111111111")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost, err := bcrypt.Cost([]byte(hash)); err != nil || cost != 6 {
+		t.Errorf("cost of the hash = %v, %v, want 6", cost, err)
+	}
+	if cost, err := bcrypt.Cost(dummyHash); err != nil || cost != 6 {
+		t.Errorf("cost of the dummy hash = %v, %v, want 6", cost, err)
+	}
+}
+
+// BenchmarkCheckPassword measures a CheckUser compare at the default cost and
+// at the one of bcrypt.
+func BenchmarkCheckPassword(b *testing.B) {
+	for _, cost := range []int{bcrypt.MinCost, bcrypt.DefaultCost} {
+		hash, err := bcrypt.GenerateFromPassword([]byte("1111111111"), cost)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("cost=%d", cost), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if correct, _ := checkPassword(string(hash), "1111111111"); !correct {
+					b.Fatal("password not correct")
+				}
+			}
+		})
+	}
+}
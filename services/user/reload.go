@@ -0,0 +1,36 @@
+package user
+
+import (
+	"context"
+
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/rs/zerolog/log"
+)
+
+// ReloadUsers reloads the users from mongodb.
+func (s *Server) ReloadUsers(ctx context.Context, req *pb.ReloadUsersRequest) (*pb.ReloadUsersResult, context.Context, error) {
+	n, err := s.reload()
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("Failed to reload users: %v", err)
+		return nil, ctx, err
+	}
+	return &pb.ReloadUsersResult{Count: int32(n)}, ctx, nil
+}
+
+// reload swaps in the users currently in mongodb.
+func (s *Server) reload() (int, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	users, err := loadUsers(s.store)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	s.users = users
+	s.mu.Unlock()
+
+	log.Debug().Msgf("Reloaded %d users", len(users))
+	return len(users), nil
+}
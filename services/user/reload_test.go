@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+)
+
+// TestReloadWhileCheckingUsers runs logins and password changes while the
+// users are reloaded, for go test -race.
+func TestReloadWhileCheckingUsers(t *testing.T) {
+	s, _ := newAccountServer(t, map[string]string{"Cornell_1": "1111111111", "Cornell_2": "0"})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			res, _, err := s.CheckUser(ctx, &pb.CheckUserRequest{Username: "Cornell_1", Password: "1111111111"})
+			if err != nil || !res.Correct {
+				t.Errorf("CheckUser = %v, %v during a reload", res.GetCorrect(), err)
+				return
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			req := &pb.ChangePasswordRequest{Username: "Cornell_2", Password: fmt.Sprint(i), NewPassword: fmt.Sprint(i + 1)}
+			res, _, err := s.ChangePassword(ctx, req)
+			if err != nil || !res.Changed {
+				t.Errorf("ChangePassword = %v, %v during a reload", res.GetChanged(), err)
+				return
+			}
+		}
+	}()
+	for i := 0; i < 50; i++ {
+		if _, err := s.reload(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wg.Wait()
+
+	// no reload dropped the last change
+	if _, correct, _ := s.authenticate(ctx, "Cornell_2", "50"); !correct {
+		t.Error("last password change lost")
+	}
+}
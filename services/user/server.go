@@ -1,19 +1,28 @@
 package user
 
 import (
-	"crypto/sha256"
+	"slices"
 	"strconv"
+	"sync"
 
 	// "encoding/json"
 	"fmt"
 
 	"context"
 
-	"github.com/appnet-org/arpc/pkg/rpc"
-	"github.com/appnet-org/arpc/pkg/serializer"
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnetorg/hotel-reservation-arpc/auth"
+	"github.com/appnetorg/hotel-reservation-arpc/logs"
 	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/grpcpb"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/rawpb"
+	"github.com/appnetorg/hotel-reservation-arpc/reload"
+	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 
@@ -24,12 +33,18 @@ const _ = "srv-user"
 
 // Server implements the user service
 type Server struct {
-	users map[string]string
+	users   map[string]User
+	mu      sync.RWMutex
+	writeMu sync.Mutex // orders writes so a reload cannot drop a concurrent one
+	lockout lockout
+	store   store
 
 	Tracer       opentracing.Tracer
 	Port         int
 	IpAddr       string
 	MongoSession *mgo.Session
+	AuthKey      []byte            // verifies callers of methods that need a role
+	Transport    *transport.Config // picks aRPC or gRPC per hop
 	uuid         string
 }
 
@@ -39,22 +54,53 @@ func (s *Server) Run() error {
 		return fmt.Errorf("server port must be set")
 	}
 
+	if s.store == nil {
+		s.store = mongoStore{session: s.MongoSession}
+	}
 	if s.users == nil {
-		s.users = loadUsers(s.MongoSession)
+		users, err := loadUsers(s.store)
+		if err != nil {
+			log.Error().Msgf("Failed get users data: %s", err.Error())
+		}
+		s.users = users
 	}
 
+	reload.Watch("users", reload.Interval(), func() error {
+		_, err := s.reload()
+		return err
+	})
+
 	s.uuid = uuid.New().String()
 
-	serializer := &serializer.SymphonySerializer{}
-	server, err := rpc.NewServer(s.IpAddr+":"+strconv.Itoa(s.Port), serializer, nil)
+	elements := slices.Concat(tracing.ServerElements(s.Tracer), logs.ServerElements(), auth.Elements(s.AuthKey))
+	server, err := s.Transport.NewServer("user", s.IpAddr+":"+strconv.Itoa(s.Port), elements)
 
 	if err != nil {
 		log.Error().Msgf("Failed to start aRPC server: %v", err)
 		return err
 	}
 
-	pb.RegisterUserServer(server, s)
+	if s.Transport.ServesRaw("user") {
+		if err := rawpb.RegisterUser(server, s); err != nil {
+			return err
+		}
+	} else {
+		pb.RegisterUserServer(server, s)
+	}
 
+	if s.Transport.ServesGRPC("user") {
+		err := transport.ServeGRPC(s.IpAddr+":"+strconv.Itoa(s.Port), elements, func(g grpc.ServiceRegistrar) {
+			grpcpb.RegisterUser(g, s)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// finish the spans of calls failing in their handler
+	if err := transport.WrapHandlers(server, tracing.WrapHandler); err != nil {
+		return err
+	}
 	server.Start()
 
 	return nil
@@ -66,10 +112,17 @@ func (s *Server) Shutdown() {
 
 // CheckUser returns whether the username and password are correct.
 func (s *Server) CheckUser(ctx context.Context, req *pb.CheckUserRequest) (*pb.CheckUserResult, context.Context, error) {
-	res := new(pb.CheckUserResult)
+	return s.checkUser(ctx, req.Username, req.Password)
+}
 
-	sum := sha256.Sum256([]byte(req.Password))
-	pass := fmt.Sprintf("%x", sum)
+// CheckUserRaw is CheckUser reading the credentials straight from the request
+// payload.
+func (s *Server) CheckUserRaw(ctx context.Context, req pb.CheckUserRequestRaw) (*pb.CheckUserResult, context.Context, error) {
+	return s.checkUser(ctx, req.GetUsername(), req.GetPassword())
+}
+
+func (s *Server) checkUser(ctx context.Context, username, password string) (*pb.CheckUserResult, context.Context, error) {
+	res := new(pb.CheckUserResult)
 
 	// session, err := mgo.Dial("mongodb-user")
 	// if err != nil {
@@ -84,41 +137,101 @@ func (s *Server) CheckUser(ctx context.Context, req *pb.CheckUserRequest) (*pb.C
 	// if err != nil {
 	// 	panic(err)
 	// }
-	res.Correct = false
-	if true_pass, found := s.users[req.Username]; found {
-		res.Correct = pass == true_pass
+	user, correct, retryAfter := s.authenticate(ctx, username, password)
+	res.Correct, res.RetryAfter = correct, retryAfter
+	if correct {
+		res.Role, res.Hotels, res.PasswordChanged = user.Role, user.Hotels, user.PasswordChanged
 	}
 
 	return res, ctx, nil
 }
 
-// loadUsers loads hotel users from mongodb.
-func loadUsers(session *mgo.Session) map[string]string {
+// authenticate checks the password of a user, counting failures towards a
+// lockout of the client the frontend got the password from. It returns how
+// many seconds are left when the user is locked out.
+func (s *Server) authenticate(ctx context.Context, username, password string) (User, bool, int32) {
+	a := attempt{username: username, client: metadata.FromIncomingContext(ctx)[auth.ClientKey]}
+	if d := s.lockout.retryAfter(a); d > 0 {
+		return User{}, false, seconds(d)
+	}
+
+	s.mu.RLock()
+	user, found := s.users[username]
+	s.mu.RUnlock()
+
+	if !found {
+		// take as long as a known user
+		bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return User{}, false, 0
+	}
+
+	correct, legacy := checkPassword(user.Password, password)
+	if !correct {
+		s.lockout.fail(a)
+		return User{}, false, seconds(s.lockout.retryAfter(a))
+	}
+	s.lockout.reset(a)
+
+	if legacy {
+		s.upgradePassword(username, user.Password, password)
+	}
+	return user, true, 0
+}
+
+// upgradePassword replaces a legacy hash with a bcrypt one after a successful
+// login. Failures are only logged, the legacy hash keeps working.
+func (s *Server) upgradePassword(username, oldHash, password string) {
+	hash, err := HashPassword(password)
+	if err != nil {
+		log.Error().Msgf("Failed to hash password of user [%v]: %v", username, err)
+		return
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	// only replace the hash that was checked, a concurrent change wins
+	err = s.store.update(bson.M{"username": username, "password": oldHash}, bson.M{"password": hash})
+	if err == mgo.ErrNotFound {
+		return
+	} else if err != nil {
+		log.Error().Msgf("Failed to upgrade password of user [%v]: %v", username, err)
+		return
+	}
+
+	s.mu.Lock()
+	if user, ok := s.users[username]; ok && user.Password == oldHash {
+		user.Password = hash
+		s.users[username] = user
+	}
+	s.mu.Unlock()
+}
+
+// loadUsers loads hotel users from the store.
+func loadUsers(st store) (map[string]User, error) {
 	// session, err := mgo.Dial("mongodb-user")
 	// if err != nil {
 	// 	panic(err)
 	// }
 	// defer session.Close()
-	s := session.Copy()
-	defer s.Close()
-	c := s.DB("user-db").C("user")
-
-	// unmarshal json profiles
-	var users []User
-	err := c.Find(bson.M{}).All(&users)
-	if err != nil {
-		log.Error().Msgf("Failed get users data: %s", err.Error())
-	}
+	users, err := st.all()
 
-	res := make(map[string]string)
+	res := make(map[string]User)
 	for _, user := range users {
-		res[user.Username] = user.Password
+		res[user.Username] = user
 	}
 
-	return res
+	return res, err
 }
 
 type User struct {
 	Username string `bson:"username"`
 	Password string `bson:"password"`
+	// Role is empty for guests, see auth.Role.
+	Role string `bson:"role,omitempty"`
+	// Hotels a hotel manager manages.
+	Hotels []string `bson:"hotels,omitempty"`
+	// PasswordChanged is when the password was set, in Unix nanoseconds, or
+	// zero for the seeded users.
+	PasswordChanged int64 `bson:"passwordChanged,omitempty"`
 }
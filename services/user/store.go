@@ -0,0 +1,64 @@
+package user
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// store keeps the users. It is mongodb outside of tests.
+type store interface {
+	// insert adds a user unless the username is taken.
+	insert(user User) (bool, error)
+	// update sets fields of the user matching query, or returns
+	// mgo.ErrNotFound when none does.
+	update(query, set bson.M) error
+	// remove deletes a user, also when there is none.
+	remove(username string) error
+	// all returns every user.
+	all() ([]User, error)
+}
+
+type mongoStore struct {
+	session *mgo.Session
+}
+
+func (m mongoStore) insert(user User) (bool, error) {
+	session := m.session.Copy()
+	defer session.Close()
+	c := session.DB("user-db").C("user")
+
+	// only inserts when no user has the name, so concurrent registrations
+	// of the same name cannot both succeed
+	info, err := c.Upsert(&bson.M{"username": user.Username}, &bson.M{"$setOnInsert": &user})
+	if err != nil {
+		return false, err
+	}
+	return info.UpsertedId != nil, nil
+}
+
+func (m mongoStore) update(query, set bson.M) error {
+	session := m.session.Copy()
+	defer session.Close()
+	c := session.DB("user-db").C("user")
+
+	return c.Update(query, &bson.M{"$set": set})
+}
+
+func (m mongoStore) remove(username string) error {
+	session := m.session.Copy()
+	defer session.Close()
+	c := session.DB("user-db").C("user")
+
+	_, err := c.RemoveAll(&bson.M{"username": username})
+	return err
+}
+
+func (m mongoStore) all() ([]User, error) {
+	session := m.session.Copy()
+	defer session.Close()
+	c := session.DB("user-db").C("user")
+
+	var users []User
+	err := c.Find(bson.M{}).All(&users)
+	return users, err
+}
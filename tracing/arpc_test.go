@@ -0,0 +1,147 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/appnet-org/arpc/pkg/rpc"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"github.com/appnetorg/hotel-reservation-arpc/transport"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/mocktracer"
+)
+
+type geoServer struct{}
+
+func (geoServer) NearbyGeo(ctx context.Context, req *pb.NearbyRequest) (*pb.NearbyResult, context.Context, error) {
+	return &pb.NearbyResult{HotelIds: []string{"1"}}, ctx, nil
+}
+
+func (geoServer) SetLocation(ctx context.Context, req *pb.SetLocationRequest) (*pb.SetLocationResult, context.Context, error) {
+	return &pb.SetLocationResult{}, ctx, nil
+}
+
+// failingGeoServer fails every call.
+type failingGeoServer struct {
+	geoServer
+}
+
+func (failingGeoServer) NearbyGeo(ctx context.Context, req *pb.NearbyRequest) (*pb.NearbyResult, context.Context, error) {
+	return nil, ctx, errors.New("no hotels")
+}
+
+// searchServer answers Nearby by calling geo.
+type searchServer struct {
+	geo pb.GeoClient
+}
+
+func (s searchServer) Nearby(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResult, context.Context, error) {
+	res, err := s.geo.NearbyGeo(ctx, &pb.NearbyRequest{Lat: req.Lat, Lon: req.Lon})
+	if err != nil {
+		return nil, ctx, err
+	}
+	return &pb.SearchResult{HotelIds: res.HotelIds}, ctx, nil
+}
+
+// serve starts the traced aRPC server of service on a free local port and
+// returns a traced client of it.
+func serve(t *testing.T, tracer opentracing.Tracer, caller, service string, register func(*rpc.Server)) *rpc.Client {
+	t.Helper()
+	var c *transport.Config
+	server, err := c.NewServer(service, "127.0.0.1:0", ServerElements(tracer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	register(server)
+	if err := transport.WrapHandlers(server, WrapHandler); err != nil {
+		t.Fatal(err)
+	}
+	// the aRPC server cannot be stopped, it serves until the test binary exits
+	go server.Start()
+	client, err := c.NewClient(caller, service, server.GetTransport().LocalAddr().String(), ClientElements(tracer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestConnectedTraceOverARPC(t *testing.T) {
+	tracer := mocktracer.New()
+	geo := serve(t, tracer, "search", "geo", func(s *rpc.Server) { pb.RegisterGeoServer(s, geoServer{}) })
+	search := serve(t, tracer, "frontend", "search", func(s *rpc.Server) {
+		pb.RegisterSearchServer(s, searchServer{geo: pb.NewGeoClient(geo)})
+	})
+
+	root := tracer.StartSpan("HTTP GET /hotels")
+	ctx := opentracing.ContextWithSpan(context.Background(), root)
+	done := make(chan error, 1)
+	go func() {
+		_, err := pb.NewSearchClient(search).Nearby(ctx, &pb.SearchRequest{Lat: 37.7867, Lon: -122.4112})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Nearby: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("call timed out")
+	}
+	root.Finish()
+
+	// a client and a server span for each of the two hops
+	spans := tracer.FinishedSpans()
+	if len(spans) != 5 {
+		t.Fatalf("finished %d spans, want 5", len(spans))
+	}
+	byID := make(map[int]*mocktracer.MockSpan)
+	for _, s := range spans {
+		byID[s.SpanContext.SpanID] = s
+	}
+	for _, s := range spans {
+		if s.SpanContext.TraceID != root.Context().(mocktracer.MockSpanContext).TraceID {
+			t.Errorf("%v (%v) is in another trace", s.OperationName, s.Tag("span.kind"))
+		}
+		if s != root && byID[s.ParentID] == nil {
+			t.Errorf("parent of %v (%v) is not in the trace", s.OperationName, s.Tag("span.kind"))
+		}
+	}
+}
+
+// TestFailedCallOverARPC checks that the server span of a call failing in
+// its handler is finished, though the aRPC server skips the response elements.
+func TestFailedCallOverARPC(t *testing.T) {
+	tracer := mocktracer.New()
+	geo := serve(t, tracer, "search", "geo", func(s *rpc.Server) { pb.RegisterGeoServer(s, failingGeoServer{}) })
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := pb.NewGeoClient(geo).NearbyGeo(context.Background(), &pb.NearbyRequest{Lat: 37.7867, Lon: -122.4112})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("NearbyGeo succeeded, want an error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("call timed out")
+	}
+
+	var server []*mocktracer.MockSpan
+	for _, s := range tracer.FinishedSpans() {
+		if s.Tag("span.kind") == ext.SpanKindRPCServerEnum {
+			server = append(server, s)
+		}
+	}
+	if len(server) != 1 {
+		t.Fatalf("finished %d server spans, want 1", len(server))
+	}
+	if server[0].Tag("error") != true {
+		t.Error("server span of the failed call not marked as error")
+	}
+}
@@ -0,0 +1,159 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnet-org/arpc/pkg/rpc"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/rs/zerolog/log"
+)
+
+// ClientElement starts a client span for every outgoing aRPC call, as a child
+// of the span in the context of the call, and injects it into the metadata
+// of the call.
+type ClientElement struct {
+	tracer opentracing.Tracer
+}
+
+// ServerElement starts a server span for every incoming aRPC call, continuing
+// the trace whose span context the caller put in the metadata. Handlers find
+// the span in their context.
+type ServerElement struct {
+	tracer opentracing.Tracer
+}
+
+// serverSpan is the span of a served call. It is finished once, by the
+// response elements or by the handler wrapper, whichever sees the call end
+// first.
+type serverSpan struct {
+	once sync.Once
+	span opentracing.Span
+}
+
+type serverSpanKey struct{}
+
+func (s *serverSpan) finish(err error) {
+	s.once.Do(func() {
+		if s.span == nil {
+			return
+		}
+		if err != nil {
+			ext.LogError(s.span, err)
+		}
+		s.span.Finish()
+	})
+}
+
+// ClientElements returns the client elements tracing the calls a service
+// makes.
+func ClientElements(tracer opentracing.Tracer) []element.RPCElement {
+	return []element.RPCElement{&ClientElement{tracer: orNoop(tracer)}}
+}
+
+// ServerElements returns the server elements tracing the calls a service
+// serves.
+func ServerElements(tracer opentracing.Tracer) []element.RPCElement {
+	return []element.RPCElement{&ServerElement{tracer: orNoop(tracer)}}
+}
+
+func orNoop(tracer opentracing.Tracer) opentracing.Tracer {
+	if tracer == nil {
+		return opentracing.NoopTracer{}
+	}
+	return tracer
+}
+
+// ProcessRequest starts the client span and adds it to the outgoing metadata.
+func (e *ClientElement) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	var opts []opentracing.StartSpanOption
+	if parent := opentracing.SpanFromContext(ctx); parent != nil {
+		opts = append(opts, opentracing.ChildOf(parent.Context()))
+	}
+	span := e.tracer.StartSpan(req.ServiceName+"."+req.Method, append(opts, ext.SpanKindRPCClient)...)
+	ext.Component.Set(span, "arpc")
+
+	carrier := opentracing.TextMapCarrier{}
+	if err := e.tracer.Inject(span.Context(), opentracing.TextMap, carrier); err != nil {
+		log.Warn().Msgf("Failed to inject span context into call to %v.%v: %v", req.ServiceName, req.Method, err)
+	}
+	var kv []string
+	for k, v := range carrier {
+		kv = append(kv, k, v)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, kv...)
+
+	return req, opentracing.ContextWithSpan(ctx, span), nil
+}
+
+// ProcessResponse finishes the client span.
+func (e *ClientElement) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	finish(ctx, resp)
+	return resp, ctx, nil
+}
+
+// Name returns the name of the element.
+func (e *ClientElement) Name() string {
+	return "tracing-client"
+}
+
+// ProcessRequest extracts the span context of the caller and starts the
+// server span.
+func (e *ServerElement) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	carrier := opentracing.TextMapCarrier(metadata.FromIncomingContext(ctx))
+	caller, err := e.tracer.Extract(opentracing.TextMap, carrier)
+	if err != nil && err != opentracing.ErrSpanContextNotFound {
+		log.Warn().Msgf("Failed to extract span context from call to %v.%v: %v", req.ServiceName, req.Method, err)
+	}
+	span := e.tracer.StartSpan(req.ServiceName+"."+req.Method, ext.RPCServerOption(caller))
+	ext.Component.Set(span, "arpc")
+
+	s, ok := ctx.Value(serverSpanKey{}).(*serverSpan)
+	if !ok {
+		s = &serverSpan{}
+		ctx = context.WithValue(ctx, serverSpanKey{}, s)
+	}
+	s.span = span
+	return req, opentracing.ContextWithSpan(ctx, span), nil
+}
+
+// ProcessResponse finishes the server span.
+func (e *ServerElement) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	if s, ok := ctx.Value(serverSpanKey{}).(*serverSpan); ok {
+		s.finish(resp.Error)
+	}
+	return resp, ctx, nil
+}
+
+// WrapHandler wraps an aRPC method handler so the server span of the call is
+// finished when the handler returns. The aRPC server skips the response
+// elements of calls failing in the handler, so without it their spans are
+// never finished. Wrap the handlers with transport.WrapHandlers once the
+// services are registered.
+func WrapHandler(handler rpc.MethodHandler) rpc.MethodHandler {
+	return func(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (resp *element.RPCResponse, _ context.Context, err error) {
+		s := &serverSpan{}
+		defer func() { s.finish(err) }()
+		return handler(srv, context.WithValue(ctx, serverSpanKey{}, s), dec, req, chain)
+	}
+}
+
+// Name returns the name of the element.
+func (e *ServerElement) Name() string {
+	return "tracing-server"
+}
+
+// finish finishes the span of ctx, marking it failed when the call failed.
+func finish(ctx context.Context, resp *element.RPCResponse) {
+	span := opentracing.SpanFromContext(ctx)
+	if span == nil {
+		return
+	}
+	if resp != nil && resp.Error != nil {
+		ext.LogError(span, resp.Error)
+	}
+	span.Finish()
+}
@@ -0,0 +1,142 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+)
+
+// hop makes a call from the context of a caller through the client and
+// server elements, handing the metadata over like the wire does, and runs
+// handler in the context of the callee.
+func hop(t *testing.T, tracer opentracing.Tracer, ctx context.Context, service, method string, handler func(context.Context) error) {
+	t.Helper()
+	client := element.NewRPCElementChain(ClientElements(tracer)...)
+	server := element.NewRPCElementChain(ServerElements(tracer)...)
+
+	req, cctx, err := client.ProcessRequest(ctx, &element.RPCRequest{ServiceName: service, Method: method})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sctx := metadata.NewIncomingContext(context.Background(), metadata.FromOutgoingContext(cctx))
+	_, sctx, err = server.ProcessRequest(sctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	herr := handler(sctx)
+	server.ProcessResponse(sctx, &element.RPCResponse{Error: herr})
+	client.ProcessResponse(cctx, &element.RPCResponse{Error: herr})
+}
+
+func TestConnectedTrace(t *testing.T) {
+	tracer := mocktracer.New()
+	root := tracer.StartSpan("HTTP GET /hotels")
+	ctx := opentracing.ContextWithSpan(context.Background(), root)
+
+	hop(t, tracer, ctx, "Search", "Nearby", func(ctx context.Context) error {
+		hop(t, tracer, ctx, "Geo", "NearbyGeo", func(context.Context) error { return nil })
+		hop(t, tracer, ctx, "Rate", "GetRates", func(context.Context) error { return errors.New("no rates") })
+		return nil
+	})
+	root.Finish()
+
+	spans := tracer.FinishedSpans()
+	if len(spans) != 7 {
+		t.Fatalf("finished %d spans, want 7", len(spans))
+	}
+	byID := make(map[int]*mocktracer.MockSpan)
+	for _, s := range spans {
+		byID[s.SpanContext.SpanID] = s
+	}
+	for _, s := range spans {
+		if s.SpanContext.TraceID != root.Context().(mocktracer.MockSpanContext).TraceID {
+			t.Errorf("%v is in another trace", s.OperationName)
+		}
+		if s != root && byID[s.ParentID] == nil {
+			t.Errorf("parent of %v is not in the trace", s.OperationName)
+		}
+	}
+
+	for _, s := range spans {
+		if s.OperationName != "Rate.GetRates" {
+			continue
+		}
+		if s.Tag("error") != true {
+			t.Errorf("failed %v span (%v) not marked as error", s.OperationName, s.Tag("span.kind"))
+		}
+	}
+}
+
+func TestNoCallerSpan(t *testing.T) {
+	tracer := mocktracer.New()
+	hop(t, tracer, context.Background(), "Geo", "NearbyGeo", func(ctx context.Context) error {
+		if opentracing.SpanFromContext(ctx) == nil {
+			t.Error("handler context has no span")
+		}
+		return nil
+	})
+	if got := len(tracer.FinishedSpans()); got != 2 {
+		t.Errorf("finished %d spans, want 2", got)
+	}
+}
+
+// TestWrapHandler checks that each server span is finished once, whether the
+// call fails in an element, fails in the handler or succeeds.
+func TestWrapHandler(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		elements []element.RPCElement
+		err      error
+	}{
+		{"success", nil, nil},
+		{"handler error", nil, errors.New("no rates")},
+		{"element error", []element.RPCElement{denyElement{errors.New("denied")}}, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tracer := mocktracer.New()
+			chain := element.NewRPCElementChain(append(ServerElements(tracer), tt.elements...)...)
+			handler := WrapHandler(func(srv any, ctx context.Context, dec func(any) error, req *element.RPCRequest, chain *element.RPCElementChain) (*element.RPCResponse, context.Context, error) {
+				req, ctx, err := chain.ProcessRequest(ctx, req)
+				if err != nil {
+					return nil, ctx, err
+				}
+				if tt.err != nil {
+					return nil, ctx, tt.err
+				}
+				return chain.ProcessResponse(ctx, &element.RPCResponse{ID: req.ID})
+			})
+
+			_, _, err := handler(nil, context.Background(), nil, &element.RPCRequest{ServiceName: "Rate", Method: "GetRates"}, chain)
+			spans := tracer.FinishedSpans()
+			if len(spans) != 1 {
+				t.Fatalf("finished %d spans, want 1", len(spans))
+			}
+			if failed := spans[0].Tag("error") == true; failed != (err != nil) {
+				t.Errorf("span marked as error = %v, call error = %v", failed, err)
+			}
+		})
+	}
+}
+
+// denyElement fails every call.
+type denyElement struct {
+	err error
+}
+
+func (e denyElement) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	return nil, ctx, e.err
+}
+
+func (e denyElement) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	return resp, ctx, nil
+}
+
+func (e denyElement) Name() string {
+	return "deny"
+}
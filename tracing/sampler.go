@@ -0,0 +1,156 @@
+package tracing
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	defaultSampleRatio float64 = 0.01
+	defaultTraceRate   float64 = 10
+)
+
+// samplerFromEnv returns the sampler named by OTEL_TRACES_SAMPLER, with its
+// argument from OTEL_TRACES_SAMPLER_ARG. Besides the samplers of the SDK,
+// ratelimiting and parentbased_ratelimiting sample up to the argument traces
+// per second. Without a sampler, a hundredth of the traces started here are
+// sampled and the others follow their caller. JAEGER_SAMPLE_RATIO still sets
+// the ratio of traceidratio samplers when OTEL_TRACES_SAMPLER_ARG is unset.
+func samplerFromEnv() (sdktrace.Sampler, error) {
+	name := strings.ToLower(envOr("OTEL_TRACES_SAMPLER", "parentbased_traceidratio"))
+	arg := strings.TrimSpace(os.Getenv("OTEL_TRACES_SAMPLER_ARG"))
+	hasArg := arg != ""
+
+	parentBased := strings.HasPrefix(name, "parentbased_")
+	var root sdktrace.Sampler
+	switch strings.TrimPrefix(name, "parentbased_") {
+	case "always_on":
+		root = sdktrace.AlwaysSample()
+	case "always_off":
+		root = sdktrace.NeverSample()
+	case "traceidratio":
+		ratio := defaultSampleRatio
+		if hasArg {
+			v, err := strconv.ParseFloat(arg, 64)
+			if err != nil || v < 0 || v > 1 {
+				return nil, fmt.Errorf("invalid OTEL_TRACES_SAMPLER_ARG %q, want a ratio in [0, 1]", arg)
+			}
+			ratio = v
+		} else if val, ok := os.LookupEnv("JAEGER_SAMPLE_RATIO"); ok {
+			v, err := strconv.ParseFloat(val, 64)
+			if err != nil || v < 0 {
+				log.Warn().Msgf("Invalid JAEGER_SAMPLE_RATIO %q, using %v", val, ratio)
+			} else {
+				ratio = min(v, 1)
+			}
+		}
+		root = sdktrace.TraceIDRatioBased(ratio)
+	case "ratelimiting":
+		rate := defaultTraceRate
+		if hasArg {
+			v, err := strconv.ParseFloat(arg, 64)
+			if err != nil || v < 0 {
+				return nil, fmt.Errorf("invalid OTEL_TRACES_SAMPLER_ARG %q, want traces per second", arg)
+			}
+			rate = v
+		}
+		root = RateLimiting(rate)
+	default:
+		return nil, fmt.Errorf("unknown OTEL_TRACES_SAMPLER %q", name)
+	}
+	rootSampler.swap(root)
+	if parentBased {
+		return sdktrace.ParentBased(rootSampler), nil
+	}
+	return rootSampler, nil
+}
+
+// rootSampler samples the traces the tracer starts, without a parent or
+// regardless of it. SetSampleRatio replaces it at runtime.
+var rootSampler = &swapSampler{}
+
+// swapSampler delegates to a sampler that can be replaced while tracing.
+type swapSampler struct {
+	sampler atomic.Pointer[sdktrace.Sampler]
+}
+
+func (s *swapSampler) swap(sampler sdktrace.Sampler) {
+	s.sampler.Store(&sampler)
+}
+
+func (s *swapSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return (*s.sampler.Load()).ShouldSample(p)
+}
+
+func (s *swapSampler) Description() string {
+	return (*s.sampler.Load()).Description()
+}
+
+// SetSampleRatio makes the tracer sample ratio of the traces it starts,
+// replacing the sampler picked by OTEL_TRACES_SAMPLER. Parent-based samplers
+// still follow the caller.
+func SetSampleRatio(ratio float64) error {
+	if ratio < 0 || ratio > 1 {
+		return fmt.Errorf("sample ratio %v is not in [0, 1]", ratio)
+	}
+	rootSampler.swap(sdktrace.TraceIDRatioBased(ratio))
+	return nil
+}
+
+// rateLimiting samples up to rate traces per second with a token bucket
+// holding up to a second of traces, and at least one so rates below one per
+// second still sample.
+type rateLimiting struct {
+	rate float64
+	now  func() time.Time
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// RateLimiting returns a sampler sampling up to rate traces per second.
+func RateLimiting(rate float64) sdktrace.Sampler {
+	return newRateLimiting(rate, time.Now)
+}
+
+func newRateLimiting(rate float64, now func() time.Time) *rateLimiting {
+	return &rateLimiting{rate: rate, now: now, tokens: rate, last: now()}
+}
+
+func (s *rateLimiting) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	decision := sdktrace.Drop
+	if s.take() {
+		decision = sdktrace.RecordAndSample
+	}
+	return sdktrace.SamplingResult{
+		Decision:   decision,
+		Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+	}
+}
+
+func (s *rateLimiting) take() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.tokens = min(max(s.rate, 1), s.tokens+now.Sub(s.last).Seconds()*s.rate)
+	s.last = now
+	if s.tokens < 1 {
+		return false
+	}
+	s.tokens--
+	return true
+}
+
+func (s *rateLimiting) Description() string {
+	return fmt.Sprintf("RateLimiting{%g}", s.rate)
+}
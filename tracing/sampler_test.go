@@ -0,0 +1,121 @@
+package tracing
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestRateLimiting(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := newRateLimiting(2, func() time.Time { return now })
+
+	sampled := func() (n int) {
+		for i := 0; i < 10; i++ {
+			if s.ShouldSample(sdktrace.SamplingParameters{}).Decision == sdktrace.RecordAndSample {
+				n++
+			}
+		}
+		return n
+	}
+	if n := sampled(); n != 2 {
+		t.Errorf("sampled %d traces at once, want 2", n)
+	}
+	now = now.Add(500 * time.Millisecond)
+	if n := sampled(); n != 1 {
+		t.Errorf("sampled %d traces after half a second, want 1", n)
+	}
+	now = now.Add(time.Minute)
+	if n := sampled(); n != 2 {
+		t.Errorf("sampled %d traces after a minute, want 2", n)
+	}
+}
+
+func TestRateLimitingFractional(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := newRateLimiting(0.5, func() time.Time { return now })
+
+	sampled := 0
+	for i := 0; i < 60; i++ {
+		now = now.Add(100 * time.Millisecond)
+		if s.ShouldSample(sdktrace.SamplingParameters{}).Decision == sdktrace.RecordAndSample {
+			sampled++
+		}
+	}
+	// 6 seconds at one trace every 2 seconds
+	if sampled != 3 {
+		t.Errorf("sampled %d traces in 6s at 0.5/s, want 3", sampled)
+	}
+
+	now = now.Add(time.Minute)
+	if !s.take() || s.take() {
+		t.Error("bucket of a rate below 1/s should hold one trace")
+	}
+}
+
+func TestSamplerFromEnv(t *testing.T) {
+	for _, tt := range []struct {
+		sampler, arg, jaeger string
+		want                 string
+		wantErr              bool
+	}{
+		{"", "", "", "ParentBased{root:TraceIDRatioBased{0.01},", false},
+		{"", "", "0.2", "ParentBased{root:TraceIDRatioBased{0.2},", false},
+		{"", "", "3", "ParentBased{root:AlwaysOnSampler,", false},
+		{"", "", "half", "ParentBased{root:TraceIDRatioBased{0.01},", false},
+		{"traceidratio", "0.5", "0.2", "TraceIDRatioBased{0.5}", false},
+		{"always_on", "", "", "AlwaysOnSampler", false},
+		{"traceidratio", "0.5", "", "TraceIDRatioBased{0.5}", false},
+		{"parentbased_ratelimiting", "5", "", "ParentBased{root:RateLimiting{5},", false},
+		{"ratelimiting", "", "", "RateLimiting{10}", false},
+		{"traceidratio", "2", "", "", true},
+		{"jaeger_remote", "", "", "", true},
+	} {
+		t.Setenv("OTEL_TRACES_SAMPLER", tt.sampler)
+		t.Setenv("OTEL_TRACES_SAMPLER_ARG", tt.arg)
+		t.Setenv("JAEGER_SAMPLE_RATIO", tt.jaeger)
+		if tt.jaeger == "" {
+			os.Unsetenv("JAEGER_SAMPLE_RATIO")
+		}
+		s, err := samplerFromEnv()
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%q %q: got %v, want error", tt.sampler, tt.arg, s.Description())
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q %q: %v", tt.sampler, tt.arg, err)
+			continue
+		}
+		if got := s.Description(); !strings.HasPrefix(got, tt.want) {
+			t.Errorf("%q %q: got %v, want %v...", tt.sampler, tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestSetSampleRatio(t *testing.T) {
+	t.Setenv("OTEL_TRACES_SAMPLER", "parentbased_always_off")
+	s, err := samplerFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SetSampleRatio(1); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.ShouldSample(sdktrace.SamplingParameters{}).Decision; got != sdktrace.RecordAndSample {
+		t.Errorf("root trace at ratio 1: got %v", got)
+	}
+	if err := SetSampleRatio(0.5); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(s.Description(), "ParentBased{root:TraceIDRatioBased{0.5},") {
+		t.Errorf("got %v", s.Description())
+	}
+	if err := SetSampleRatio(1.5); err == nil {
+		t.Error("ratio 1.5 accepted")
+	}
+}
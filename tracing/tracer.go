@@ -1,52 +1,152 @@
 package tracing
 
 import (
+	"context"
+	"fmt"
 	"os"
-	"strconv"
-	"time"
+	"strings"
 
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/rs/zerolog/log"
-	"github.com/uber/jaeger-client-go/config"
+	"go.opentelemetry.io/otel"
+	otelbridge "go.opentelemetry.io/otel/bridge/opentracing"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
 )
 
-var (
-	defaultSampleRatio float64 = 0.01
-)
+// Init returns a tracer recording the spans of serviceName with the
+// OpenTelemetry SDK. Services keep tracing through the opentracing API, the
+// tracer bridges it to OpenTelemetry, so span names stay the same.
+//
+// The exporter is picked by OTEL_TRACES_EXPORTER:
+//   - otlp (default) sends the spans to endpoint, or OTEL_EXPORTER_OTLP_ENDPOINT
+//     if set, over the OTEL_EXPORTER_OTLP_PROTOCOL, grpc or http/protobuf
+//   - console writes them to stdout
+//   - file writes them to TRACES_FILE, one JSON span per line
+//   - none drops them, span contexts are still propagated
+//
+// The sampler is picked by OTEL_TRACES_SAMPLER, see samplerFromEnv.
+func Init(serviceName, endpoint string) (opentracing.Tracer, error) {
+	otel.SetErrorHandler(errorHandler{})
 
-// Init returns a newly configured tracer
-func Init(serviceName, host string) (opentracing.Tracer, error) {
-	ratio := defaultSampleRatio
-	if val, ok := os.LookupEnv("JAEGER_SAMPLE_RATIO"); ok {
-		ratio, _ = strconv.ParseFloat(val, 64)
-		if ratio > 1 {
-			ratio = 1.0
-		}
-	}
-	
-	log.Info().Msgf("Jaeger client: adjusted sample ratio %f", ratio)
-	tempCfg := &config.Configuration{
-		ServiceName: serviceName,
-		Sampler: &config.SamplerConfig{
-			Type:  "probabilistic",
-			Param: ratio,
-		},
-		Reporter: &config.ReporterConfig{
-			LogSpans:            false,
-			BufferFlushInterval: 1 * time.Second,
-			LocalAgentHostPort:  host,
-		},
-	}
-
-	log.Info().Msg("Overriding Jaeger config with env variables")
-	cfg, err := tempCfg.FromEnv()
+	sampler, err := samplerFromEnv()
 	if err != nil {
 		return nil, err
 	}
 
-	tracer, _, err := cfg.NewTracer()
+	res, err := resource.New(context.Background(),
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+		resource.WithFromEnv(),
+	)
 	if err != nil {
 		return nil, err
 	}
-	return tracer, nil
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sampler),
+	}
+	exporter := strings.ToLower(envOr("OTEL_TRACES_EXPORTER", "otlp"))
+	switch exporter {
+	case "otlp":
+		exp, err := otlpExporter(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	case "console":
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithSyncer(exp))
+	case "file":
+		path := envOr("TRACES_FILE", serviceName+"-traces.json")
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, err
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithSyncer(exp))
+	case "none":
+	default:
+		return nil, fmt.Errorf("unknown OTEL_TRACES_EXPORTER %q", exporter)
+	}
+	log.Info().Msgf("Tracing: exporter %v, sampler %v", exporter, sampler.Description())
+	tracerSampler = sampler
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	propagator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	bridge, wrapper := otelbridge.NewTracerPair(provider.Tracer("github.com/appnetorg/hotel-reservation-arpc/tracing"))
+	bridge.SetTextMapPropagator(propagator)
+	otel.SetTracerProvider(wrapper)
+	otel.SetTextMapPropagator(propagator)
+	return bridge, nil
+}
+
+// tracerSampler is the sampler of the tracer returned by Init.
+var tracerSampler sdktrace.Sampler
+
+// Sampler describes the sampler of the tracer, or returns "" before Init.
+func Sampler() string {
+	if tracerSampler == nil {
+		return ""
+	}
+	return tracerSampler.Description()
+}
+
+// otlpExporter returns the OTLP exporter. The OTEL_EXPORTER_OTLP_* env vars
+// configure it, endpoint is only used when they name no endpoint, without
+// TLS like the collector in the cluster.
+func otlpExporter(endpoint string) (sdktrace.SpanExporter, error) {
+	_, hasEndpoint := os.LookupEnv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	if _, ok := os.LookupEnv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"); ok {
+		hasEndpoint = true
+	}
+	protocol := envOr("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", envOr("OTEL_EXPORTER_OTLP_PROTOCOL", "grpc"))
+
+	ctx := context.Background()
+	switch protocol {
+	case "grpc":
+		var opts []otlptracegrpc.Option
+		if !hasEndpoint {
+			opts = append(opts, otlptracegrpc.WithEndpoint(endpoint), otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case "http/protobuf":
+		var opts []otlptracehttp.Option
+		if !hasEndpoint {
+			opts = append(opts, otlptracehttp.WithEndpoint(endpoint), otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unknown OTLP protocol %q", protocol)
+	}
+}
+
+func envOr(key, def string) string {
+	if val, ok := os.LookupEnv(key); ok && val != "" {
+		return strings.TrimSpace(val)
+	}
+	return def
+}
+
+// errorHandler logs the errors of the OpenTelemetry SDK, e.g. failed exports.
+type errorHandler struct{}
+
+func (errorHandler) Handle(err error) {
+	// the SDK parses OTEL_TRACES_SAMPLER too and does not know the samplers
+	// added here, samplerFromEnv reports bad values
+	if msg := err.Error(); strings.HasPrefix(msg, "unsupported sampler") || strings.HasPrefix(msg, "parsing sampler argument") {
+		return
+	}
+	log.Warn().Msgf("OpenTelemetry: %v", err)
 }
@@ -0,0 +1,45 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+func TestInitFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	t.Setenv("OTEL_TRACES_EXPORTER", "file")
+	t.Setenv("TRACES_FILE", path)
+	t.Setenv("OTEL_TRACES_SAMPLER", "always_on")
+	tracer, err := Init("test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root := tracer.StartSpan("memcached_get_multi_rate")
+	ctx := opentracing.ContextWithSpan(context.Background(), root)
+	var traceparent string
+	hop(t, tracer, ctx, "Geo", "NearbyGeo", func(ctx context.Context) error {
+		traceparent = metadata.FromIncomingContext(ctx)["traceparent"]
+		return nil
+	})
+	root.Finish()
+
+	if traceparent == "" {
+		t.Error("call metadata has no traceparent")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"memcached_get_multi_rate", "Geo.NearbyGeo"} {
+		if !strings.Contains(string(data), `"Name":"`+name+`"`) {
+			t.Errorf("%v span not exported", name)
+		}
+	}
+}
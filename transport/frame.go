@@ -0,0 +1,202 @@
+package transport
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnet-org/arpc/pkg/rpc"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	"github.com/appnet-org/arpc/pkg/serializer"
+)
+
+// headerSize is the size of the header aRPC expects in front of every
+// payload. The client writes the service and method of a call to bytes 5 to
+// 13 and the server reads them back, and the UDP transport reads the offset
+// of the private segment from bytes 1 to 5 to fragment payloads larger than
+// a packet.
+const headerSize = 13
+
+// framed is a serializer whose payloads aRPC can carry. Symphony messages
+// start with the header aRPC expects. Messages of the other serializers get
+// one in front, shaped like the one of a Symphony message with an empty
+// public segment, so the whole message counts as private.
+//
+// aRPC does not send the metadata of calls either, so requests end with it:
+//
+//	request:  [header][message][metadata][length of metadata, 2 bytes]
+//	response: [header][message]
+//
+// The metadata is encoded like aRPC encodes headers. Symphony readers follow
+// the offsets of the message, so they skip it.
+type framed struct {
+	name   string
+	inner  serializer.Serializer
+	server bool // decodes requests and encodes responses
+
+	mu      sync.Mutex
+	payload any               // the request decoded last
+	md      metadata.Metadata // and its metadata
+}
+
+func newFramed(name string, server bool) *framed {
+	s, _ := NewSerializer(name)
+	return &framed{name: name, inner: s, server: server}
+}
+
+// call is a request with the metadata it is sent with, as the outgoing
+// element hands it to the client serializer.
+type call struct {
+	md  metadata.Metadata
+	msg any
+}
+
+// headed reports whether payloads need a header in front of the message.
+func (f *framed) headed() bool {
+	return f.name != "symphony"
+}
+
+func (f *framed) Marshal(msg any) ([]byte, error) {
+	var trailer []byte
+	if !f.server {
+		var md metadata.Metadata
+		if c, ok := msg.(*call); ok {
+			md, msg = c.md, c.msg
+		}
+		var err error
+		if trailer, err = encodeMetadata(md); err != nil {
+			return nil, err
+		}
+	}
+	b, err := f.inner.Marshal(msg)
+	if err != nil || (!f.headed() && trailer == nil) {
+		return b, err
+	}
+
+	data := make([]byte, 0, headerSize+len(b)+len(trailer))
+	if f.headed() {
+		data = append(data, 0x01) // version of the public segment
+		data = binary.LittleEndian.AppendUint32(data, headerSize)
+		data = append(data, make([]byte, headerSize-5)...)
+	}
+	data = append(data, b...)
+	return append(data, trailer...), nil
+}
+
+func (f *framed) Unmarshal(data []byte, out any) error {
+	var md metadata.Metadata
+	if f.server {
+		n := len(data) - 2
+		if n < 0 {
+			return fmt.Errorf("invalid request: no metadata")
+		}
+		size := int(binary.LittleEndian.Uint16(data[n:]))
+		if size > n {
+			return fmt.Errorf("invalid request: metadata of %d bytes in %d", size, n)
+		}
+		var err error
+		if md, err = (metadata.MetadataCodec{}).DecodeHeaders(data[n-size : n]); err != nil {
+			return fmt.Errorf("invalid request metadata: %v", err)
+		}
+		data = data[:n-size]
+	}
+	if f.headed() {
+		if len(data) < headerSize {
+			return fmt.Errorf("invalid %v payload: too short for the header", f.name)
+		}
+		data = data[headerSize:]
+	}
+	if err := f.inner.Unmarshal(data, out); err != nil {
+		return err
+	}
+
+	if f.server {
+		f.mu.Lock()
+		f.payload, f.md = out, md
+		f.mu.Unlock()
+	}
+	return nil
+}
+
+// encodeMetadata returns the trailer of a request sent with md.
+func encodeMetadata(md metadata.Metadata) ([]byte, error) {
+	size := 2
+	for k, v := range md {
+		if len(k) > 0xffff || len(v) > 0xffff {
+			return nil, fmt.Errorf("metadata %q too long", k)
+		}
+		size += 4 + len(k) + len(v)
+	}
+	if size > 0xffff {
+		return nil, fmt.Errorf("metadata of %d bytes too long", size)
+	}
+	b, _ := metadata.MetadataCodec{}.EncodeHeaders(md, nil)
+	return binary.LittleEndian.AppendUint16(b, uint16(len(b))), nil
+}
+
+// metadataOf returns the metadata of the request decoded into payload. The
+// aRPC server decodes a call and runs its elements before it decodes the
+// next one, so only the request decoded last is kept; the metadata of any
+// other one is nil.
+func (f *framed) metadataOf(payload any) metadata.Metadata {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.payload != payload {
+		return nil
+	}
+	md := f.md
+	f.payload, f.md = nil, nil
+	return md
+}
+
+// incoming gives the context of a call the metadata it was sent with.
+type incoming struct {
+	codec *framed
+}
+
+func (e incoming) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	if md := e.codec.metadataOf(req.Payload); md != nil {
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	return req, ctx, nil
+}
+
+func (e incoming) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	return resp, ctx, nil
+}
+
+func (e incoming) Name() string {
+	return "metadata-server"
+}
+
+// outgoing hands the metadata of the context of a call to the serializer.
+type outgoing struct{}
+
+func (outgoing) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	req.Payload = &call{md: metadata.FromOutgoingContext(ctx), msg: req.Payload}
+	return req, ctx, nil
+}
+
+func (outgoing) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	return resp, ctx, nil
+}
+
+func (outgoing) Name() string {
+	return "metadata-client"
+}
+
+// NewServer returns the aRPC server of service on addr. Its elements run
+// with the metadata the calls were sent with in their context.
+func (c *Config) NewServer(service, addr string, elements []element.RPCElement) (*rpc.Server, error) {
+	codec := c.ServerSerializer(service).(*framed)
+	return rpc.NewServer(addr, codec, slices.Concat([]element.RPCElement{incoming{codec}}, elements))
+}
+
+// NewClient returns the aRPC client caller calls callee at addr with. Calls
+// are sent with the outgoing metadata of their context once elements ran.
+func (c *Config) NewClient(caller, callee, addr string, elements []element.RPCElement) (*rpc.Client, error) {
+	return rpc.NewClientWithLocalAddr(c.ClientSerializer(caller, callee), addr, "0.0.0.0:0", slices.Concat(elements, []element.RPCElement{outgoing{}}))
+}
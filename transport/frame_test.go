@@ -0,0 +1,211 @@
+package transport
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/appnet-org/arpc/pkg/metadata"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// geoServer records the locations set and answers NearbyGeo with every hotel
+// set so far.
+type geoServer struct {
+	located chan *pb.SetLocationRequest
+	ids     []string
+}
+
+func (g *geoServer) NearbyGeo(ctx context.Context, req *pb.NearbyRequest) (*pb.NearbyResult, context.Context, error) {
+	return &pb.NearbyResult{HotelIds: g.ids}, ctx, nil
+}
+
+func (g *geoServer) SetLocation(ctx context.Context, req *pb.SetLocationRequest) (*pb.SetLocationResult, context.Context, error) {
+	g.located <- req
+	return &pb.SetLocationResult{}, ctx, nil
+}
+
+// serveGeo serves srv over aRPC on a free local port the way c sets for geo,
+// running elements, and returns its address.
+func serveGeo(t *testing.T, c *Config, srv pb.GeoServer, elements ...element.RPCElement) string {
+	t.Helper()
+	server, err := c.NewServer("geo", "127.0.0.1:0", elements)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pb.RegisterGeoServer(server, srv)
+	// the aRPC server cannot be stopped, it serves until the test binary exits
+	go server.Start()
+	return server.GetTransport().LocalAddr().String()
+}
+
+// within runs call, failing the test if it does not return in time, as aRPC
+// clients wait for lost calls forever.
+func within(t *testing.T, call func() error) error {
+	t.Helper()
+	done := make(chan error, 1)
+	go func() { done <- call() }()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("call timed out")
+		return nil
+	}
+}
+
+func TestSerializersOverARPC(t *testing.T) {
+	for _, name := range serializerNames() {
+		t.Run(name, func(t *testing.T) {
+			c, err := Parse("", "", "geo="+name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			srv := &geoServer{located: make(chan *pb.SetLocationRequest, 1)}
+			// enough hotels for the result to span several packets
+			for i := 0; i < 300; i++ {
+				srv.ids = append(srv.ids, fmt.Sprintf("hotel-%06d", i))
+			}
+			client, err := c.NewClient("search", "geo", serveGeo(t, c, srv), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { client.Close() })
+			geo := pb.NewGeoClient(client)
+
+			want := &pb.SetLocationRequest{HotelId: "hotel-123456", Lat: 37.7867, Lon: -122.4112}
+			if err := within(t, func() error {
+				_, err := geo.SetLocation(context.Background(), want)
+				return err
+			}); err != nil {
+				t.Fatalf("SetLocation: %v", err)
+			}
+			if got := <-srv.located; !proto.Equal(got, want) {
+				t.Errorf("server got %v, want %v", got, want)
+			}
+
+			// a short request, and a response larger than a packet
+			var res *pb.NearbyResult
+			if err := within(t, func() error {
+				res, err = geo.NearbyGeo(context.Background(), &pb.NearbyRequest{})
+				return err
+			}); err != nil {
+				t.Fatalf("NearbyGeo: %v", err)
+			}
+			if !proto.Equal(res, &pb.NearbyResult{HotelIds: srv.ids}) {
+				t.Errorf("NearbyGeo returned %d hotels, want %d", len(res.GetHotelIds()), len(srv.ids))
+			}
+		})
+	}
+}
+
+// recorder records the metadata of the calls it sees.
+type recorder struct {
+	seen chan metadata.Metadata
+}
+
+func (r recorder) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	r.seen <- metadata.FromIncomingContext(ctx)
+	return req, ctx, nil
+}
+
+func (r recorder) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	return resp, ctx, nil
+}
+
+func (r recorder) Name() string {
+	return "recorder"
+}
+
+// stamper adds a key to the metadata of the calls it sees.
+type stamper struct{}
+
+func (stamper) ProcessRequest(ctx context.Context, req *element.RPCRequest) (*element.RPCRequest, context.Context, error) {
+	return req, metadata.AppendToOutgoingContext(ctx, "stamped-by", "client element"), nil
+}
+
+func (stamper) ProcessResponse(ctx context.Context, resp *element.RPCResponse) (*element.RPCResponse, context.Context, error) {
+	return resp, ctx, nil
+}
+
+func (stamper) Name() string {
+	return "stamper"
+}
+
+func TestMetadataOverARPC(t *testing.T) {
+	for _, name := range serializerNames() {
+		t.Run(name, func(t *testing.T) {
+			c, err := Parse("", "", "geo="+name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rec := recorder{seen: make(chan metadata.Metadata, 1)}
+			srv := &geoServer{located: make(chan *pb.SetLocationRequest, 1)}
+			client, err := c.NewClient("search", "geo", serveGeo(t, c, srv, rec), []element.RPCElement{stamper{}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { client.Close() })
+
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "f00d")
+			if err := within(t, func() error {
+				_, err := pb.NewGeoClient(client).NearbyGeo(ctx, &pb.NearbyRequest{Lat: 37.7867, Lon: -122.4112})
+				return err
+			}); err != nil {
+				t.Fatalf("NearbyGeo: %v", err)
+			}
+			want := metadata.Metadata{"x-request-id": "f00d", "stamped-by": "client element"}
+			if got := <-rec.seen; !maps.Equal(got, want) {
+				t.Errorf("server got metadata %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestFramedInvalid(t *testing.T) {
+	body, err := (&pb.NearbyRequest{Lat: 1}).MarshalSymphony()
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, _ := encodeMetadata(metadata.Metadata{"k": "v"})
+	truncated := md[:len(md)-3]
+	empty, _ := encodeMetadata(nil)
+
+	server := newFramed("symphony", true)
+	tests := []struct {
+		name string
+		s    *framed
+		data []byte
+	}{
+		{"empty", server, nil},
+		{"metadata beyond the start", server, append(slices.Clone(body), 0xff, 0xff)},
+		{"truncated metadata", server, binary.LittleEndian.AppendUint16(append(slices.Clone(body), truncated...), uint16(len(truncated)))},
+		{"invalid message", server, append([]byte{0x02}, empty...)},
+		{"protobuf without a header", newFramed("protobuf", true), empty},
+	}
+	for _, tt := range tests {
+		if err := tt.s.Unmarshal(tt.data, new(pb.NearbyRequest)); err == nil {
+			t.Errorf("%v: decoded", tt.name)
+		}
+	}
+	if md := server.metadataOf(new(pb.NearbyRequest)); md != nil {
+		t.Errorf("metadata %v kept for an invalid request", md)
+	}
+
+	out := new(pb.NearbyRequest)
+	if err := server.Unmarshal(append(slices.Clone(body), md...), out); err != nil || out.Lat != 1 {
+		t.Fatalf("Unmarshal = %v, %v", out, err)
+	}
+	if md := server.metadataOf(new(pb.NearbyRequest)); md != nil {
+		t.Error("metadata handed to another request")
+	}
+	if md := server.metadataOf(out); md.Get("k") != "v" {
+		t.Errorf("metadata of the request decoded last = %v", md)
+	}
+}
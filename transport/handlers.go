@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/appnet-org/arpc/pkg/rpc"
+)
+
+// Services returns the services registered on s by id. The aRPC server does
+// not export them, so they are read by reflection. Changing a description
+// changes how s serves the service.
+func Services(s *rpc.Server) (map[uint32]*rpc.ServiceDesc, error) {
+	services := reflect.ValueOf(s).Elem().FieldByName("servicesByID")
+	if services.Kind() != reflect.Map || services.Type() != reflect.TypeOf(map[uint32]*rpc.ServiceDesc(nil)) {
+		return nil, fmt.Errorf("rpc.Server has no servicesByID map")
+	}
+	return *(*map[uint32]*rpc.ServiceDesc)(unsafe.Pointer(services.UnsafeAddr())), nil
+}
+
+// WrapHandlers wraps the handlers of the methods registered on s so far.
+func WrapHandlers(s *rpc.Server, wrap func(rpc.MethodHandler) rpc.MethodHandler) error {
+	services, err := Services(s)
+	if err != nil {
+		return err
+	}
+	for _, desc := range services {
+		for id, m := range desc.MethodsByID {
+			desc.MethodsByID[id] = &rpc.MethodDesc{MethodName: m.MethodName, MethodID: m.MethodID, Handler: wrap(m.Handler)}
+		}
+	}
+	return nil
+}
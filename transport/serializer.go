@@ -0,0 +1,97 @@
+package transport
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/appnet-org/arpc/pkg/serializer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultSerializer encodes aRPC payloads unless Serializers says otherwise.
+const DefaultSerializer = "symphony"
+
+// serializers are the names used in Serializers.
+var serializers = map[string]func() serializer.Serializer{
+	"symphony": func() serializer.Serializer { return &serializer.SymphonySerializer{} },
+	"protobuf": func() serializer.Serializer { return &serializer.ProtoSerializer{} },
+	"json":     func() serializer.Serializer { return &jsonSerializer{} },
+}
+
+// jsonSerializer encodes payloads as protobuf JSON, which is slow but readable
+// on the wire.
+type jsonSerializer struct{}
+
+func (j *jsonSerializer) Marshal(msg any) ([]byte, error) {
+	return protojson.Marshal(msg.(proto.Message))
+}
+
+func (j *jsonSerializer) Unmarshal(data []byte, out any) error {
+	return protojson.Unmarshal(data, out.(proto.Message))
+}
+
+// NewSerializer returns the serializer called name.
+func NewSerializer(name string) (serializer.Serializer, error) {
+	newSerializer, ok := serializers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown serializer %q", name)
+	}
+	return newSerializer(), nil
+}
+
+// parseSerializers reads service=serializer and caller.callee=serializer
+// entries.
+func parseSerializers(list string) (map[string]string, error) {
+	names := make(map[string]string)
+	for _, entry := range split(list) {
+		key, name, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid entry %q in Serializers, want service=serializer", entry)
+		}
+		if _, ok := serializers[name]; !ok {
+			return nil, fmt.Errorf("unknown serializer %q in Serializers", name)
+		}
+		caller, callee, isHop := strings.Cut(key, ".")
+		if !isHop {
+			caller, callee = "*", key
+		}
+		if (caller != "*" && !services[caller]) || (callee != "*" && !services[callee]) {
+			return nil, fmt.Errorf("unknown service in %q in Serializers", entry)
+		}
+		names[key] = name
+	}
+	return names, nil
+}
+
+// serializerName returns the serializer set for key, falling back to the
+// default.
+func (c *Config) serializerName(keys ...string) string {
+	if c != nil {
+		for _, key := range keys {
+			if name, ok := c.serializers[key]; ok {
+				return name
+			}
+		}
+	}
+	return DefaultSerializer
+}
+
+// ServerSerializer returns the serializer service decodes aRPC calls with,
+// framed for aRPC.
+func (c *Config) ServerSerializer(service string) serializer.Serializer {
+	return newFramed(c.serializerName(service, "*"), true)
+}
+
+// ClientSerializer returns the serializer caller encodes its aRPC calls to
+// callee with, framed for aRPC: the one set for the hop, else the one of
+// callee. Unless a proxy on the hop translates, both must be the same.
+func (c *Config) ClientSerializer(caller, callee string) serializer.Serializer {
+	return newFramed(c.serializerName(caller+"."+callee, caller+".*", "*."+callee, callee, "*"), false)
+}
+
+// ServesRaw reports whether service decodes aRPC calls with Symphony, so its
+// hot methods can read the Raw view of requests instead of unmarshaling them.
+func (c *Config) ServesRaw(service string) bool {
+	return c.serializerName(service, "*") == "symphony"
+}
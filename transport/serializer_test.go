@@ -0,0 +1,185 @@
+package transport
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/appnet-org/arpc/pkg/serializer"
+	pb "github.com/appnetorg/hotel-reservation-arpc/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func readData(tb testing.TB, name string, v any) {
+	tb.Helper()
+	b, err := os.ReadFile("../data/" + name)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		tb.Fatalf("%v: %v", name, err)
+	}
+}
+
+// payloads are the messages of the services, filled from data/*.json the way
+// the services answer for the seed data.
+func payloads(tb testing.TB) map[string]proto.Message {
+	tb.Helper()
+
+	var hotels []*pb.Hotel
+	readData(tb, "hotels.json", &hotels)
+
+	var inventory []struct {
+		*pb.RatePlan
+		RoomType struct {
+			*pb.RoomType
+			Description string `json:"description"`
+		} `json:"roomType"`
+	}
+	readData(tb, "inventory.json", &inventory)
+	var plans []*pb.RatePlan
+	for _, p := range inventory {
+		p.RatePlan.RoomType = p.RoomType.RoomType
+		p.RatePlan.RoomType.RoomDescription = p.RoomType.Description
+		plans = append(plans, p.RatePlan)
+	}
+
+	var geo []struct {
+		HotelId string  `json:"hotelId"`
+		Lat     float64 `json:"lat"`
+		Lon     float64 `json:"lon"`
+	}
+	readData(tb, "geo.json", &geo)
+	var ids []string
+	var scores []float64
+	for i, p := range geo {
+		ids = append(ids, p.HotelId)
+		scores = append(scores, 1/float64(i+1))
+	}
+	sort.Strings(ids)
+
+	return map[string]proto.Message{
+		"NearbyRequest":             &pb.NearbyRequest{Lat: float32(geo[0].Lat), Lon: float32(geo[0].Lon)},
+		"NearbyResult":              &pb.NearbyResult{HotelIds: ids},
+		"SearchRequest":             &pb.SearchRequest{Lat: float32(geo[0].Lat), Lon: float32(geo[0].Lon), InDate: "2015-04-09", OutDate: "2015-04-10"},
+		"SearchResult":              &pb.SearchResult{HotelIds: ids},
+		"GetProfilesRequest":        &pb.GetProfilesRequest{HotelIds: ids, Locale: "en", Fields: []string{"name", "phoneNumber", "address.lat", "address.lon"}},
+		"GetProfilesResult":         &pb.GetProfilesResult{Hotels: hotels},
+		"GetRatesRequest":           &pb.GetRatesRequest{HotelIds: ids, InDate: "2015-04-09", OutDate: "2015-04-10"},
+		"GetRatesResult":            &pb.GetRatesResult{RatePlans: plans},
+		"GetRecommendationsRequest": &pb.GetRecommendationsRequest{Require: "mixed", Lat: geo[0].Lat, Lon: geo[0].Lon, K: 5, DisWeight: 1, RateWeight: 1, PriceWeight: 1, Username: "Cornell_1"},
+		"GetRecommendationsResult":  &pb.GetRecommendationsResult{HotelIds: ids, Scores: scores},
+		"ReservationRequest":        &pb.ReservationRequest{CustomerName: "Cornell_1", HotelId: ids[:1], InDate: "2015-04-09", OutDate: "2015-04-10", RoomNumber: 1},
+		"ReservationResult":         &pb.ReservationResult{HotelId: ids},
+		"CheckUserRequest":          &pb.CheckUserRequest{Username: "Cornell_1", Password: "1111111111"},
+		"CheckUserResult":           &pb.CheckUserResult{Correct: true, Role: "guest"},
+		"HotelRequest":              &pb.HotelRequest{Hotel: hotels[0], RatePlans: plans[:1], Rate: 4.5, Price: 109, Capacity: 200},
+	}
+}
+
+func serializerNames() []string {
+	var names []string
+	for name := range serializers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestSerializersRoundTrip(t *testing.T) {
+	for msgName, msg := range payloads(t) {
+		for _, name := range serializerNames() {
+			s, _ := NewSerializer(name)
+			b, err := s.Marshal(msg)
+			if err != nil {
+				t.Fatalf("%v %v: %v", name, msgName, err)
+			}
+			out := msg.ProtoReflect().New().Interface()
+			if err := s.Unmarshal(b, out); err != nil {
+				t.Fatalf("%v %v: %v", name, msgName, err)
+			}
+			if !proto.Equal(msg, out) {
+				t.Errorf("%v %v: got %v, want %v", name, msgName, out, msg)
+			}
+		}
+	}
+}
+
+func TestConfigSerializers(t *testing.T) {
+	c, err := Parse("", "", "geo=protobuf, search.rate=json, *=symphony")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		got  serializer.Serializer
+		want string
+	}{
+		{c.ServerSerializer("geo"), "protobuf"},
+		{c.ServerSerializer("rate"), "symphony"},
+		{c.ClientSerializer("search", "geo"), "protobuf"},
+		{c.ClientSerializer("search", "rate"), "json"},
+		{c.ClientSerializer("frontend", "user"), "symphony"},
+	}
+	for i, tt := range tests {
+		if got := tt.got.(*framed).name; got != tt.want {
+			t.Errorf("%d: serializer = %v, want %v", i, got, tt.want)
+		}
+	}
+
+	if c.ServesRaw("geo") || !c.ServesRaw("rate") {
+		t.Error("Raw views served with the wrong serializer")
+	}
+	var none *Config
+	if !none.ServesRaw("geo") {
+		t.Error("a nil Config does not serve Raw views")
+	}
+
+	if _, err := Parse("", "", "geo=xml"); err == nil {
+		t.Error("unknown serializer accepted")
+	}
+}
+
+// BenchmarkSerializers measures marshaling and unmarshaling every message
+// with every serializer, e.g.
+//
+//	go test ./transport -run '^$' -bench 'Serializers/GetProfilesResult' -benchmem
+func BenchmarkSerializers(b *testing.B) {
+	msgs := payloads(b)
+	var msgNames []string
+	for name := range msgs {
+		msgNames = append(msgNames, name)
+	}
+	sort.Strings(msgNames)
+
+	for _, msgName := range msgNames {
+		msg := msgs[msgName]
+		for _, name := range serializerNames() {
+			s, _ := NewSerializer(name)
+			data, err := s.Marshal(msg)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.Run(msgName+"/"+name+"/marshal", func(b *testing.B) {
+				b.SetBytes(int64(len(data)))
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if _, err := s.Marshal(msg); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+			b.Run(msgName+"/"+name+"/unmarshal", func(b *testing.B) {
+				b.SetBytes(int64(len(data)))
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					out := msg.ProtoReflect().New().Interface()
+					if err := s.Unmarshal(data, out); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Package transport picks aRPC or gRPC for each service and for each hop
+// between services.
+//
+// Every service serves aRPC over UDP. Services listed in GRPCServers of
+// config.json also serve gRPC on the TCP port with the same number, and the
+// hops listed in GRPCHops call their callee over gRPC instead of aRPC.
+// Serializers picks how aRPC payloads are encoded, per service or per hop.
+package transport
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/appnet-org/arpc/pkg/rpc"
+	"github.com/appnet-org/arpc/pkg/rpc/element"
+	"github.com/appnetorg/hotel-reservation-arpc/proto/grpcpb"
+	"github.com/appnetorg/hotel-reservation-arpc/tls"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+)
+
+// services are the names used in GRPCServers and GRPCHops.
+var services = map[string]bool{
+	"frontend":       true,
+	"geo":            true,
+	"profile":        true,
+	"rate":           true,
+	"recommendation": true,
+	"reservation":    true,
+	"search":         true,
+	"user":           true,
+}
+
+// Config says which services serve gRPC, which hops dial it and how aRPC
+// payloads are encoded. The zero value, like a nil Config, uses aRPC with the
+// default serializer everywhere.
+type Config struct {
+	servers     map[string]bool
+	hops        map[string]bool
+	serializers map[string]string
+}
+
+// Parse reads the comma separated GRPCServers, GRPCHops and Serializers
+// settings. Servers are service names, hops are caller.callee pairs and
+// serializers are service=name or caller.callee=name entries. "*" matches
+// every service, in hops on either side.
+func Parse(servers, hops, serializers string) (*Config, error) {
+	c := &Config{servers: make(map[string]bool), hops: make(map[string]bool)}
+	for _, name := range split(servers) {
+		if name != "*" && !services[name] {
+			return nil, fmt.Errorf("unknown service %q in GRPCServers", name)
+		}
+		c.servers[name] = true
+	}
+	for _, hop := range split(hops) {
+		caller, callee, ok := strings.Cut(hop, ".")
+		if hop == "*" {
+			caller, callee, ok = "*", "*", true
+		}
+		if !ok || (caller != "*" && !services[caller]) || (callee != "*" && !services[callee]) {
+			return nil, fmt.Errorf("invalid hop %q in GRPCHops, want caller.callee", hop)
+		}
+		c.hops[caller+"."+callee] = true
+	}
+	for hop := range c.hops {
+		if _, callee, _ := strings.Cut(hop, "."); callee != "*" && !c.ServesGRPC(callee) {
+			log.Warn().Msgf("Hop %v dials gRPC but %v does not serve it", hop, callee)
+		}
+	}
+
+	var err error
+	if c.serializers, err = parseSerializers(serializers); err != nil {
+		return nil, err
+	}
+	for key, name := range c.serializers {
+		caller, callee, isHop := strings.Cut(key, ".")
+		if isHop && caller != "*" && callee != "*" && name != c.serializerName(callee, "*") {
+			log.Warn().Msgf("Hop %v encodes with %v but %v decodes with %v", key, name, callee, c.serializerName(callee, "*"))
+		}
+	}
+	return c, nil
+}
+
+func split(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ServesGRPC reports whether service serves gRPC besides aRPC.
+func (c *Config) ServesGRPC(service string) bool {
+	return c != nil && (c.servers["*"] || c.servers[service])
+}
+
+// DialsGRPC reports whether caller calls callee over gRPC.
+func (c *Config) DialsGRPC(caller, callee string) bool {
+	if c == nil {
+		return false
+	}
+	return c.hops[caller+"."+callee] || c.hops[caller+".*"] || c.hops["*."+callee] || c.hops["*.*"]
+}
+
+// ServeGRPC serves the services added by register over gRPC on the TCP port
+// of addr, running elements on every call like the aRPC server does. It
+// returns once the port is bound and serves in the background.
+func ServeGRPC(addr string, elements []element.RPCElement, register func(grpc.ServiceRegistrar)) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen for gRPC on %v: %v", addr, err)
+	}
+
+	opts := []grpc.ServerOption{grpc.UnaryInterceptor(grpcpb.UnaryInterceptor(elements))}
+	if opt := tls.GetServerOpt(); opt != nil {
+		opts = append(opts, opt)
+	}
+	srv := grpc.NewServer(opts...)
+	register(srv)
+	// lets tools like grpcurl list and call the services
+	reflection.Register(srv)
+
+	log.Info().Msgf("Serving gRPC on %v", lis.Addr())
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			log.Error().Msgf("gRPC server stopped: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Dial returns the client caller calls callee at addr with: over gRPC,
+// wrapped by newGRPC, when the hop dials it, else over aRPC, wrapped by
+// newARPC. Both run elements on every call.
+func Dial[T any](c *Config, caller, callee, addr string, elements []element.RPCElement, newARPC func(*rpc.Client) T, newGRPC func(grpc.ClientConnInterface) T) (T, error) {
+	if c.DialsGRPC(caller, callee) {
+		conn, err := DialGRPC(addr, elements)
+		if err != nil {
+			var zero T
+			return zero, fmt.Errorf("failed to create %v gRPC client: %v", callee, err)
+		}
+		return newGRPC(conn), nil
+	}
+
+	client, err := c.NewClient(caller, callee, addr, elements)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("failed to create %v aRPC client: %v", callee, err)
+	}
+	return newARPC(client), nil
+}
+
+// DialGRPC returns a gRPC connection to addr, running elements on every call
+// like the aRPC client does.
+func DialGRPC(addr string, elements []element.RPCElement) (*grpc.ClientConn, error) {
+	opt := tls.GetDialOpt()
+	if opt == nil {
+		opt = grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+	return grpc.NewClient(addr, opt, grpc.WithUnaryInterceptor(grpcpb.UnaryClientInterceptor(elements)))
+}
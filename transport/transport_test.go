@@ -0,0 +1,78 @@
+package transport
+
+import (
+	"testing"
+
+	"github.com/appnet-org/arpc/pkg/rpc"
+	"google.golang.org/grpc"
+)
+
+func TestParse(t *testing.T) {
+	c, err := Parse("geo, rate", "frontend.search,search.*,*.user", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for service, want := range map[string]bool{"geo": true, "rate": true, "user": false} {
+		if got := c.ServesGRPC(service); got != want {
+			t.Errorf("ServesGRPC(%q) = %v, want %v", service, got, want)
+		}
+	}
+
+	hops := []struct {
+		caller, callee string
+		want           bool
+	}{
+		{"frontend", "search", true},
+		{"frontend", "profile", false},
+		{"search", "geo", true},
+		{"profile", "user", true},
+		{"profile", "geo", false},
+	}
+	for _, h := range hops {
+		if got := c.DialsGRPC(h.caller, h.callee); got != h.want {
+			t.Errorf("DialsGRPC(%q, %q) = %v, want %v", h.caller, h.callee, got, h.want)
+		}
+	}
+
+	var none *Config
+	if none.ServesGRPC("geo") || none.DialsGRPC("search", "geo") {
+		t.Error("a nil Config uses gRPC")
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, tt := range []struct{ servers, hops string }{
+		{"hotel", ""},
+		{"", "frontend"},
+		{"", "frontend.hotel"},
+	} {
+		if _, err := Parse(tt.servers, tt.hops, ""); err == nil {
+			t.Errorf("Parse(%q, %q) succeeded", tt.servers, tt.hops)
+		}
+	}
+}
+
+func TestDial(t *testing.T) {
+	c, err := Parse("search", "frontend.search", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newARPC := func(*rpc.Client) string { return "aRPC" }
+	newGRPC := func(grpc.ClientConnInterface) string { return "gRPC" }
+
+	for _, tt := range []struct {
+		caller, callee, want string
+	}{
+		{"frontend", "search", "gRPC"},
+		{"frontend", "profile", "aRPC"},
+	} {
+		got, err := Dial(c, tt.caller, tt.callee, "127.0.0.1:11002", nil, newARPC, newGRPC)
+		if err != nil {
+			t.Fatalf("Dial(%q, %q): %v", tt.caller, tt.callee, err)
+		}
+		if got != tt.want {
+			t.Errorf("%v calls %v over %v, want %v", tt.caller, tt.callee, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package tune
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/appnetorg/hotel-reservation-arpc/tracing"
+	"github.com/rs/zerolog/log"
+)
+
+// Settings are the settings the tuning endpoint shows and changes. The trace
+// sampler is changed by setting trace_sample_ratio.
+type Settings struct {
+	GCPercent        int    `json:"gc_percent"`
+	LogLevel         string `json:"log_level"`
+	MemCTimeout      string `json:"memc_timeout"`
+	MemCMaxIdleConns int    `json:"memc_max_idle_conns"`
+	TraceSampler     string `json:"trace_sampler"`
+}
+
+// Current returns the current settings.
+func Current() Settings {
+	mu.Lock()
+	defer mu.Unlock()
+	return Settings{
+		GCPercent:        gcPercent,
+		LogLevel:         logLevel,
+		MemCTimeout:      getMemCTimeout().String(),
+		MemCMaxIdleConns: memcMaxIdleConns,
+		TraceSampler:     tracing.Sampler(),
+	}
+}
+
+// tunable is a setting changed through the endpoint.
+type tunable struct {
+	name  string
+	get   func(Settings) any
+	parse func(string) (func() error, error)
+}
+
+// intSetting parses numbers from min up.
+func intSetting(min int, set func(int) error) func(string) (func() error, error) {
+	return func(val string) (func() error, error) {
+		n, err := strconv.Atoi(val)
+		if err != nil || n < min {
+			return nil, fmt.Errorf("%q is not a number from %d up", val, min)
+		}
+		return func() error { return set(n) }, nil
+	}
+}
+
+var tunables = []tunable{
+	{"gc_percent", func(s Settings) any { return s.GCPercent },
+		intSetting(-1, func(n int) error { SetGCPercent(n); return nil })},
+	{"log_level", func(s Settings) any { return s.LogLevel },
+		func(val string) (func() error, error) {
+			if _, ok := logLevels[strings.ToLower(val)]; !ok {
+				return nil, fmt.Errorf("unknown log level %q", val)
+			}
+			return func() error { return SetLogLevel(val) }, nil
+		}},
+	{"memc_timeout", func(s Settings) any { return s.MemCTimeout },
+		func(val string) (func() error, error) {
+			timeout, err := ParseMemCTimeout(val)
+			if err != nil {
+				return nil, err
+			}
+			return func() error { return SetMemCTimeout(timeout) }, nil
+		}},
+	{"memc_max_idle_conns", func(s Settings) any { return s.MemCMaxIdleConns }, intSetting(1, SetMemCMaxIdleConns)},
+	{"trace_sample_ratio", func(s Settings) any { return s.TraceSampler },
+		func(val string) (func() error, error) {
+			ratio, err := strconv.ParseFloat(val, 64)
+			if err != nil || ratio < 0 || ratio > 1 {
+				return nil, fmt.Errorf("%q is not a ratio in [0, 1]", val)
+			}
+			return func() error { return tracing.SetSampleRatio(ratio) }, nil
+		}},
+}
+
+// Handler serves the settings as JSON. POST changes the settings given as
+// form values. All values are checked before any is applied, so an invalid
+// one changes nothing. Should a setting still fail to apply, the ones applied
+// before it stay changed and the error names them. E.g.
+//
+//	curl -d gc_percent=200 -d log_level=debug http://geo:9000/tune
+//
+// Changes are logged with audit=tune.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			if err := change(r); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Current())
+	})
+}
+
+func change(r *http.Request) error {
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+	known := make(map[string]bool)
+	var changes []func() error
+	var names []string
+	for _, t := range tunables {
+		known[t.name] = true
+		val := r.Form.Get(t.name)
+		if val == "" {
+			continue
+		}
+		apply, err := t.parse(val)
+		if err != nil {
+			return fmt.Errorf("invalid %v: %v", t.name, err)
+		}
+		name, get := t.name, t.get
+		names = append(names, name)
+		changes = append(changes, func() error {
+			old := get(Current())
+			if err := apply(); err != nil {
+				return fmt.Errorf("invalid %v: %v", name, err)
+			}
+			// logged at any log level
+			log.Log().
+				Str("audit", "tune").
+				Str("setting", name).
+				Str("remote", r.RemoteAddr).
+				Msgf("Tune: changed %v from %v to %v", name, old, get(Current()))
+			return nil
+		})
+	}
+	for k := range r.Form {
+		if !known[k] {
+			return fmt.Errorf("unknown setting %q", k)
+		}
+	}
+	var applied []string
+	for i, c := range changes {
+		if err := c(); err != nil {
+			if len(applied) > 0 {
+				return fmt.Errorf("%v, already changed %v", err, strings.Join(applied, ", "))
+			}
+			return err
+		}
+		applied = append(applied, names[i])
+	}
+	return nil
+}
+
+// mux serves the admin endpoints, /tune and the ones added by Handle.
+var mux = http.NewServeMux()
+
+func init() {
+	mux.Handle("/tune", Handler())
+}
+
+// Handle adds an admin endpoint served next to /tune.
+func Handle(pattern string, handler http.Handler) {
+	mux.Handle(pattern, handler)
+}
+
+// Serve serves the tuning endpoint on /tune, and the ones added by Handle,
+// at port in the background, unless port is 0. It is meant for experiments
+// inside the cluster, the endpoints have no authentication.
+func Serve(port int) {
+	if port == 0 {
+		return
+	}
+	go func() {
+		log.Info().Msgf("Tune: serving /tune on port %d", port)
+		if err := http.ListenAndServe(fmt.Sprintf(":%d", port), mux); err != nil {
+			log.Error().Msgf("Tune: failed to serve /tune: %v", err)
+		}
+	}()
+}
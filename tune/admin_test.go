@@ -0,0 +1,74 @@
+package tune
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func post(form url.Values) *httptest.ResponseRecorder {
+	r := httptest.NewRequest("POST", "/tune", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, r)
+	return w
+}
+
+func TestChangeSettings(t *testing.T) {
+	memc_client, err := NewMemCClient("localhost:11211")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer SetGCPercent(Current().GCPercent)
+	defer SetLogLevel(Current().LogLevel)
+
+	w := post(url.Values{"gc_percent": {"250"}, "log_level": {"DEBUG"}, "memc_timeout": {"750ms"}, "memc_max_idle_conns": {"64"}})
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %v: %v", w.Code, w.Body)
+	}
+	s := Current()
+	if s.GCPercent != 250 || s.LogLevel != "debug" || s.MemCTimeout != "750ms" || s.MemCMaxIdleConns != 64 {
+		t.Errorf("got %+v", s)
+	}
+	if c := memc_client.Client(); c.Timeout != 750*time.Millisecond || c.MaxIdleConns != 64 {
+		t.Errorf("memcached client has timeout %v and %d idle conns", c.Timeout, c.MaxIdleConns)
+	}
+	if !strings.Contains(w.Body.String(), `"gc_percent":250`) {
+		t.Errorf("response %v does not show the settings", w.Body)
+	}
+}
+
+func TestRejectInvalid(t *testing.T) {
+	before := Current()
+	for _, form := range []url.Values{
+		{"gc_percent": {"300"}, "memc_timeout": {"0"}},
+		{"gc_percent": {"300"}, "log_level": {"loud"}},
+		{"gc_percent": {"300"}, "trace_sample_ratio": {"2"}},
+		{"gc_percent": {"300"}, "threads": {"4"}},
+	} {
+		if w := post(form); w.Code != http.StatusBadRequest {
+			t.Errorf("%v: got %v", form, w.Code)
+		}
+	}
+	if after := Current(); after != before {
+		t.Errorf("settings changed from %+v to %+v", before, after)
+	}
+}
+
+func TestApplyFails(t *testing.T) {
+	defer SetGCPercent(Current().GCPercent)
+	defer func(saved []tunable) { tunables = saved }(tunables)
+	tunables = append(tunables[:len(tunables):len(tunables)], tunable{"broken", func(Settings) any { return nil },
+		func(string) (func() error, error) {
+			return func() error { return fmt.Errorf("cannot apply") }, nil
+		}})
+
+	w := post(url.Values{"gc_percent": {"300"}, "broken": {"1"}})
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "already changed gc_percent") {
+		t.Errorf("got %v: %v, want the applied settings named", w.Code, w.Body)
+	}
+}
@@ -0,0 +1,122 @@
+package tune
+
+import (
+	"io/fs"
+	"math"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	defaultMemoryLimitRatio float64 = 0.9
+)
+
+// cgroupFS is the cgroup filesystem of the container. The cgroup namespace
+// of the container makes its own cgroup the root.
+var cgroupFS fs.FS = os.DirFS("/sys/fs/cgroup")
+
+// unlimited is the value above which cgroup v1 memory limits mean no limit.
+const unlimited = 1 << 62
+
+// readCgroup returns the fields of a cgroup file.
+func readCgroup(fsys fs.FS, name string) ([]string, bool) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, false
+	}
+	return strings.Fields(string(data)), true
+}
+
+// cpuQuota returns the CPUs the cgroup may use, from cpu.max with cgroup v2
+// or cpu.cfs_quota_us with v1, and false without a quota.
+func cpuQuota(fsys fs.FS) (float64, bool) {
+	if f, ok := readCgroup(fsys, "cpu.max"); ok {
+		if len(f) != 2 || f[0] == "max" {
+			return 0, false
+		}
+		quota, err1 := strconv.ParseFloat(f[0], 64)
+		period, err2 := strconv.ParseFloat(f[1], 64)
+		if err1 != nil || err2 != nil || quota <= 0 || period <= 0 {
+			return 0, false
+		}
+		return quota / period, true
+	}
+
+	q, ok1 := readCgroup(fsys, "cpu/cpu.cfs_quota_us")
+	p, ok2 := readCgroup(fsys, "cpu/cpu.cfs_period_us")
+	if !ok1 || !ok2 || len(q) != 1 || len(p) != 1 {
+		return 0, false
+	}
+	quota, err1 := strconv.ParseFloat(q[0], 64)
+	period, err2 := strconv.ParseFloat(p[0], 64)
+	if err1 != nil || err2 != nil || quota <= 0 || period <= 0 {
+		return 0, false
+	}
+	return quota / period, true
+}
+
+// memoryLimit returns the memory limit of the cgroup in bytes, from
+// memory.max with cgroup v2 or memory.limit_in_bytes with v1, and false
+// without a limit.
+func memoryLimit(fsys fs.FS) (int64, bool) {
+	f, ok := readCgroup(fsys, "memory.max")
+	if !ok {
+		f, ok = readCgroup(fsys, "memory/memory.limit_in_bytes")
+	}
+	if !ok || len(f) != 1 || f[0] == "max" {
+		return 0, false
+	}
+	limit, err := strconv.ParseInt(f[0], 10, 64)
+	if err != nil || limit <= 0 || limit >= unlimited {
+		return 0, false
+	}
+	return limit, true
+}
+
+// setMaxProcs sets GOMAXPROCS to the CPU quota of the container, rounded
+// down but at least 1, so a pod limited to 1000m does not run a thread per
+// CPU of the node and get throttled. The GOMAXPROCS env var overrides it.
+// The runtime does this itself from Go 1.25, for modules declaring it.
+func setMaxProcs(fsys fs.FS) {
+	if val, ok := os.LookupEnv("GOMAXPROCS"); ok {
+		log.Info().Msgf("Tune: GOMAXPROCS %d from env %q", runtime.GOMAXPROCS(0), val)
+		return
+	}
+	if quota, ok := cpuQuota(fsys); ok {
+		runtime.GOMAXPROCS(max(1, int(math.Floor(quota))))
+		log.Info().Msgf("Tune: GOMAXPROCS %d for CPU quota %g", runtime.GOMAXPROCS(0), quota)
+		return
+	}
+	log.Info().Msgf("Tune: GOMAXPROCS %d, no CPU quota", runtime.GOMAXPROCS(0))
+}
+
+// setMemoryLimit sets the soft memory limit of the runtime to
+// MEMORY_LIMIT_RATIO, 0.9 by default, of the memory limit of the container,
+// so the GC works harder before the container is killed. The GOMEMLIMIT env
+// var overrides it.
+func setMemoryLimit(fsys fs.FS) {
+	if val, ok := os.LookupEnv("GOMEMLIMIT"); ok {
+		log.Info().Msgf("Tune: memory limit %d from env %q", debug.SetMemoryLimit(-1), val)
+		return
+	}
+	ratio := defaultMemoryLimitRatio
+	if val, ok := os.LookupEnv("MEMORY_LIMIT_RATIO"); ok {
+		r, err := strconv.ParseFloat(val, 64)
+		if err != nil || r <= 0 || r > 1 {
+			log.Warn().Msgf("Invalid MEMORY_LIMIT_RATIO %q, using %v", val, ratio)
+		} else {
+			ratio = r
+		}
+	}
+	if limit, ok := memoryLimit(fsys); ok {
+		debug.SetMemoryLimit(int64(float64(limit) * ratio))
+		log.Info().Msgf("Tune: memory limit %d, %v of container limit %d", debug.SetMemoryLimit(-1), ratio, limit)
+		return
+	}
+	log.Info().Msg("Tune: no memory limit, no container limit")
+}
@@ -0,0 +1,97 @@
+package tune
+
+import (
+	"os"
+	"runtime"
+	"runtime/debug"
+	"testing"
+	"testing/fstest"
+)
+
+var (
+	cgroupV2 = fstest.MapFS{
+		"cpu.max":    {Data: []byte("250000 100000\n")},
+		"memory.max": {Data: []byte("1073741824\n")},
+	}
+	cgroupV1 = fstest.MapFS{
+		"cpu/cpu.cfs_quota_us":         {Data: []byte("50000\n")},
+		"cpu/cpu.cfs_period_us":        {Data: []byte("100000\n")},
+		"memory/memory.limit_in_bytes": {Data: []byte("536870912\n")},
+	}
+	unlimitedV2 = fstest.MapFS{
+		"cpu.max":    {Data: []byte("max 100000\n")},
+		"memory.max": {Data: []byte("max\n")},
+	}
+	unlimitedV1 = fstest.MapFS{
+		"cpu/cpu.cfs_quota_us":         {Data: []byte("-1\n")},
+		"cpu/cpu.cfs_period_us":        {Data: []byte("100000\n")},
+		"memory/memory.limit_in_bytes": {Data: []byte("9223372036854771712\n")},
+	}
+)
+
+func TestCgroupLimits(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		fsys   fstest.MapFS
+		cpus   float64
+		memory int64
+	}{
+		{"v2", cgroupV2, 2.5, 1 << 30},
+		{"v1", cgroupV1, 0.5, 512 << 20},
+		{"unlimited v2", unlimitedV2, 0, 0},
+		{"unlimited v1", unlimitedV1, 0, 0},
+		{"no cgroup", fstest.MapFS{}, 0, 0},
+	} {
+		if cpus, ok := cpuQuota(tt.fsys); cpus != tt.cpus || ok != (tt.cpus != 0) {
+			t.Errorf("%v: CPU quota %v, %v, want %v", tt.name, cpus, ok, tt.cpus)
+		}
+		if memory, ok := memoryLimit(tt.fsys); memory != tt.memory || ok != (tt.memory != 0) {
+			t.Errorf("%v: memory limit %v, %v, want %v", tt.name, memory, ok, tt.memory)
+		}
+	}
+}
+
+// unsetenv unsets key for the test.
+func unsetenv(t *testing.T, key string) {
+	t.Setenv(key, "")
+	os.Unsetenv(key)
+}
+
+func TestSetLimits(t *testing.T) {
+	unsetenv(t, "GOMAXPROCS")
+	unsetenv(t, "GOMEMLIMIT")
+	t.Setenv("MEMORY_LIMIT_RATIO", "0.5")
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+	defer debug.SetMemoryLimit(debug.SetMemoryLimit(-1))
+
+	setMaxProcs(cgroupV2)
+	if got := runtime.GOMAXPROCS(0); got != 2 {
+		t.Errorf("GOMAXPROCS %d for quota 2.5, want 2", got)
+	}
+	setMaxProcs(cgroupV1)
+	if got := runtime.GOMAXPROCS(0); got != 1 {
+		t.Errorf("GOMAXPROCS %d for quota 0.5, want 1", got)
+	}
+
+	setMemoryLimit(cgroupV2)
+	if got := debug.SetMemoryLimit(-1); got != 512<<20 {
+		t.Errorf("memory limit %d for half of 1GiB, want %d", got, 512<<20)
+	}
+}
+
+func TestEnvOverridesLimits(t *testing.T) {
+	t.Setenv("GOMAXPROCS", "3")
+	t.Setenv("GOMEMLIMIT", "100MiB")
+	// the runtime reads the env vars at startup, set what it would have
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(3))
+	defer debug.SetMemoryLimit(debug.SetMemoryLimit(100 << 20))
+
+	setMaxProcs(cgroupV2)
+	setMemoryLimit(cgroupV2)
+	if got := runtime.GOMAXPROCS(0); got != 3 {
+		t.Errorf("GOMAXPROCS %d, want 3 from env", got)
+	}
+	if got := debug.SetMemoryLimit(-1); got != 100<<20 {
+		t.Errorf("memory limit %d, want 100MiB from env", got)
+	}
+}
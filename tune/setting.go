@@ -1,10 +1,15 @@
 package tune
 
 import (
+	"fmt"
+	"hash/crc32"
+	"net"
 	"os"
 	"runtime/debug"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bradfitz/gomemcache/memcache"
@@ -13,87 +18,227 @@ import (
 )
 
 var (
-	defaultGCPercent        int    = 100
-	defaultMemCTimeout      int    = 2
-	defaultMemCMaxIdleConns int    = 512
-	defaultLogLevel         string = "info"
+	defaultGCPercent        int           = 100
+	defaultMemCTimeout      time.Duration = 2 * time.Second
+	defaultMemCMaxIdleConns int           = 512
+	defaultLogLevel         string        = "info"
 )
 
+// The current settings, which Set* functions change at runtime.
+var (
+	mu               sync.Mutex
+	gcPercent                      = defaultGCPercent
+	logLevel                       = defaultLogLevel
+	memcTimeout      time.Duration = -1 // read from MEMC_TIMEOUT on first use
+	memcMaxIdleConns               = defaultMemCMaxIdleConns
+	memcClients      []*MemCClient
+)
+
+// logLevels maps the values of LOG_LEVEL to zerolog levels. If the env is
+// unset, the level is error.
+var logLevels = map[string]zerolog.Level{
+	"":        zerolog.ErrorLevel,
+	"error":   zerolog.ErrorLevel,
+	"warning": zerolog.WarnLevel,
+	"debug":   zerolog.DebugLevel,
+	"info":    zerolog.InfoLevel,
+	"trace":   zerolog.TraceLevel,
+}
+
 func setGCPercent() {
 	ratio := defaultGCPercent
 	if val, ok := os.LookupEnv("GC"); ok {
 		ratio, _ = strconv.Atoi(val)
 	}
 
-	debug.SetGCPercent(ratio)
+	SetGCPercent(ratio)
 	log.Info().Msgf("Tune: setGCPercent to %d", ratio)
 }
 
 func setLogLevel() {
-	logLevel := defaultLogLevel
+	level := defaultLogLevel
 	if val, ok := os.LookupEnv("LOG_LEVEL"); ok {
-		logLevel = val
+		level = val
 	}
-	switch logLevel {
-	case "", "ERROR", "error": // If env is unset, set level to ERROR.
-		zerolog.SetGlobalLevel(zerolog.ErrorLevel)
-	case "WARNING", "warning":
-		zerolog.SetGlobalLevel(zerolog.WarnLevel)
-	case "DEBUG", "debug":
-		zerolog.SetGlobalLevel(zerolog.DebugLevel)
-	case "INFO", "info":
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
-	case "TRACE", "trace":
-		zerolog.SetGlobalLevel(zerolog.TraceLevel)
-	default: // Set default log level to info
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	if err := SetLogLevel(level); err != nil {
+		// Set default log level to info
+		SetLogLevel(defaultLogLevel)
 	}
 
-	log.Info().Msgf("Set global log level: %s", logLevel)
+	log.Info().Msgf("Set global log level: %s", level)
 }
 
-func GetMemCTimeout() int {
-	timeout := defaultMemCTimeout
-	if val, ok := os.LookupEnv("MEMC_TIMEOUT"); ok {
-		timeout, _ = strconv.Atoi(val)
+// SetGCPercent sets the GC percent, like GC does at startup.
+func SetGCPercent(percent int) {
+	mu.Lock()
+	defer mu.Unlock()
+	debug.SetGCPercent(percent)
+	gcPercent = percent
+}
+
+// SetLogLevel sets the level of the global logger, like LOG_LEVEL does at
+// startup.
+func SetLogLevel(level string) error {
+	l, ok := logLevels[strings.ToLower(level)]
+	if !ok {
+		return fmt.Errorf("unknown log level %q", level)
 	}
-	log.Info().Msgf("Tune: GetMemCTimeout %d", timeout)
-	return timeout
+	mu.Lock()
+	defer mu.Unlock()
+	zerolog.SetGlobalLevel(l)
+	logLevel = strings.ToLower(level)
+	return nil
 }
 
-// Hack of memcache.New to avoid 'no server error' during running
-func NewMemCClient(server ...string) *memcache.Client {
-	ss := new(memcache.ServerList)
-	err := ss.SetServers(server...)
-	if err != nil {
-		// Hack: panic early to avoid pod restart during running
-		panic(err)
-		//return nil, err
-	} else {
-		memc_client := memcache.NewFromSelector(ss)
-		memc_client.Timeout = time.Second * time.Duration(GetMemCTimeout())
-		memc_client.MaxIdleConns = defaultMemCMaxIdleConns
-		return memc_client
+// GetMemCTimeout returns the timeout of the memcached clients.
+func GetMemCTimeout() time.Duration {
+	mu.Lock()
+	defer mu.Unlock()
+	return getMemCTimeout()
+}
+
+// getMemCTimeout returns the memcached timeout, reading MEMC_TIMEOUT unless
+// it is known. mu must be held.
+func getMemCTimeout() time.Duration {
+	if memcTimeout < 0 {
+		memcTimeout = defaultMemCTimeout
+		if val, ok := os.LookupEnv("MEMC_TIMEOUT"); ok {
+			if timeout, err := ParseMemCTimeout(val); err != nil {
+				log.Warn().Msgf("Invalid MEMC_TIMEOUT %q, using %v", val, memcTimeout)
+			} else {
+				memcTimeout = timeout
+			}
+		}
+		log.Info().Msgf("Tune: GetMemCTimeout %v", memcTimeout)
 	}
+	return memcTimeout
 }
 
-func NewMemCClient2(servers string) *memcache.Client {
-	ss := new(memcache.ServerList)
-	server_list := strings.Split(servers, ",")
-	err := ss.SetServers(server_list...)
+// ParseMemCTimeout parses a memcached timeout, a duration like 500ms or a
+// number of seconds.
+func ParseMemCTimeout(val string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(val); err == nil {
+		val = strconv.Itoa(seconds) + "s"
+	}
+	timeout, err := time.ParseDuration(val)
 	if err != nil {
-		// Hack: panic early to avoid pod restart during running
-		panic(err)
-		//return nil, err
-	} else {
-		memc_client := memcache.NewFromSelector(ss)
-		memc_client.Timeout = time.Second * time.Duration(GetMemCTimeout())
-		memc_client.MaxIdleConns = defaultMemCMaxIdleConns
-		return memc_client
+		return 0, err
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("memcached timeout %v is not positive", timeout)
+	}
+	return timeout, nil
+}
+
+// SetMemCTimeout sets the timeout of the memcached clients.
+func SetMemCTimeout(timeout time.Duration) error {
+	if timeout <= 0 {
+		return fmt.Errorf("memcached timeout %v is not positive", timeout)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	memcTimeout = timeout
+	renewMemCClients()
+	return nil
+}
+
+// SetMemCMaxIdleConns sets the idle connections the memcached clients keep
+// per server.
+func SetMemCMaxIdleConns(conns int) error {
+	if conns <= 0 {
+		return fmt.Errorf("memcached max idle conns %d is not positive", conns)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	memcMaxIdleConns = conns
+	renewMemCClients()
+	return nil
+}
+
+// MemCClient is a memcached client with the tuned timeout and idle conns.
+// gomemcache reads them without a lock, so a change replaces the client
+// instead of changing it.
+type MemCClient struct {
+	servers memcache.ServerSelector
+	current atomic.Pointer[memcache.Client]
+}
+
+// Client returns the client to make a call with.
+func (c *MemCClient) Client() *memcache.Client {
+	return c.current.Load()
+}
+
+// renew replaces the client by one with the current settings and closes the
+// idle conns of the old one. Calls still running on it finish there. mu must
+// be held.
+func (c *MemCClient) renew() {
+	client := memcache.NewFromSelector(c.servers)
+	client.Timeout = getMemCTimeout()
+	client.MaxIdleConns = memcMaxIdleConns
+	if old := c.current.Swap(client); old != nil {
+		old.Close()
+	}
+}
+
+// renewMemCClients applies changed settings to all clients. mu must be held.
+func renewMemCClients() {
+	for _, c := range memcClients {
+		c.renew()
+	}
+}
+
+// NewMemCClient returns a memcached client of servers with the tuned
+// timeout and idle conns, which follow changes at runtime. Server names are
+// resolved when connecting, so a server that is not up yet fails calls, not
+// the start of the service.
+func NewMemCClient(servers ...string) (*MemCClient, error) {
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("no memcached servers")
+	}
+	ss := make(serverList, len(servers))
+	for i, server := range servers {
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			return nil, err
+		}
+		ss[i] = serverAddr(server)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	c := &MemCClient{servers: ss}
+	c.renew()
+	memcClients = append(memcClients, c)
+	return c, nil
+}
+
+// serverAddr is the address of a memcached server, resolved when dialing.
+type serverAddr string
+
+func (a serverAddr) Network() string { return "tcp" }
+func (a serverAddr) String() string  { return string(a) }
+
+// serverList picks the server of a key like memcache.ServerList does.
+type serverList []net.Addr
+
+func (ss serverList) PickServer(key string) (net.Addr, error) {
+	if len(ss) == 1 {
+		return ss[0], nil
+	}
+	return ss[crc32.ChecksumIEEE([]byte(key))%uint32(len(ss))], nil
+}
+
+func (ss serverList) Each(f func(net.Addr) error) error {
+	for _, a := range ss {
+		if err := f(a); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func Init() {
 	setLogLevel()
 	setGCPercent()
+	setMaxProcs(cgroupFS)
+	setMemoryLimit(cgroupFS)
 }
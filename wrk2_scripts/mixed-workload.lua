@@ -103,10 +103,11 @@ end
 local function user_login()
   local user_name, password = get_user()
   local method = "POST"
-  local path = url .. "/user?username=" .. user_name .. "&password=" .. password
+  local path = url .. "/user"
   local headers = {}
-  -- headers["Content-Type"] = "application/x-www-form-urlencoded"
-  return wrk.format(method, path, headers, nil)
+  headers["Content-Type"] = "application/x-www-form-urlencoded"
+  local body = "username=" .. user_name .. "&password=" .. password
+  return wrk.format(method, path, headers, body)
 end
 
 request = function()